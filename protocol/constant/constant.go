@@ -69,6 +69,7 @@ const (
 	UserCommandUpdateNotification = 1307
 
 	UserSubscribeOnlineStatusNotification = 1308
+	UserFavoriteChangedNotification       = 1309
 
 	UserNotificationEnd = 1399
 	OANotification      = 1400
@@ -95,6 +96,8 @@ const (
 	GroupMemberSetToOrdinaryUserNotification = 1518
 	GroupInfoSetAnnouncementNotification     = 1519
 	GroupInfoSetNameNotification             = 1520
+	GroupAnnouncementRemindNotification      = 1521
+	GroupAnnouncementConfirmedNotification   = 1522
 
 	//SignalingNotificationBegin = 1600
 	//SignalingNotification      = 1601
@@ -137,6 +140,22 @@ const (
 	WriteGroupChatType   = 2
 	ReadGroupChatType    = 3
 	NotificationChatType = 4
+
+	// Message retention policy scopes, from least to most specific.
+	// A conversation is governed by its most specific matching policy.
+	MsgRetentionScopeSessionType = 1
+	MsgRetentionScopeUser        = 2
+	MsgRetentionScopeGroup       = 3
+
+	// Legal hold targets.
+	LegalHoldTargetUser         = 1
+	LegalHoldTargetConversation = 2
+
+	// Compliance export job status.
+	ComplianceExportRunning  = 1
+	ComplianceExportFinished = 2
+	ComplianceExportFailed   = 3
+
 	// token.
 	NormalToken  = 0
 	InValidToken = 1
@@ -169,6 +188,11 @@ const (
 	NotReceiveMessage       = 1
 	ReceiveNotNotifyMessage = 2
 
+	// LastSeenPrivacy.
+	LastSeenEveryone = 0
+	LastSeenFriends  = 1
+	LastSeenNobody   = 2
+
 	// OptionsKey.
 	IsHistory                  = "history"
 	IsPersistent               = "persistent"
@@ -203,6 +227,7 @@ const (
 	JoinByInvitation = 2
 	JoinBySearch     = 3
 	JoinByQRCode     = 4
+	JoinByInviteLink = 5
 
 	// Minio.
 	MinioDurationTimes = 3600
@@ -270,6 +295,13 @@ const (
 	GroupNotification = 4
 )
 
+// Unread state matched by a smart conversation folder.
+const (
+	FolderUnreadAny  = 0
+	FolderUnreadOnly = 1
+	FolderUnreadAtMe = 2
+)
+
 var ContentType2PushContent = map[int64]string{
 	Picture:   "[PICTURE]",
 	Voice:     "[VOICE]",
@@ -304,9 +336,30 @@ const (
 	GroupAdmin         = 60
 	GroupOrdinaryUsers = 20
 
+	// Group admin permission bits, stored per admin on the group member. An admin holds
+	// only the bits set, a member promoted to admin starts with GroupPermissionAll.
+	// GroupPermissionPinMsg guards the announcements pinned to the group.
+	GroupPermissionKick        = 1 << 0
+	GroupPermissionMute        = 1 << 1
+	GroupPermissionApproveJoin = 1 << 2
+	GroupPermissionEditInfo    = 1 << 3
+	GroupPermissionPinMsg      = 1 << 4
+	GroupPermissionRevokeMsg   = 1 << 5
+	GroupPermissionAll         = GroupPermissionKick | GroupPermissionMute | GroupPermissionApproveJoin |
+		GroupPermissionEditInfo | GroupPermissionPinMsg | GroupPermissionRevokeMsg
+
 	GroupResponseAgree  = 1
 	GroupResponseRefuse = -1
 
+	// Group member import job status.
+	GroupImportJobRunning  = 1
+	GroupImportJobFinished = 2 // all rows processed, some may have failed
+	GroupImportJobFailed   = 3 // the roster could not be read
+
+	// Group member roster formats.
+	GroupRosterCSV  = "csv"
+	GroupRosterJSON = "json"
+
 	FriendResponseNotHandle = 0
 	FriendResponseAgree     = 1
 	FriendResponseRefuse    = -1
@@ -224,3 +224,69 @@ func (x *ClearUserConversationMsgReq) Check() error {
 	}
 	return nil
 }
+
+func (x *CreateConversationFolderReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *UpdateConversationFolderReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	if x.Name != nil && x.Name.Value == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *DeleteConversationFolderReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	return nil
+}
+
+func (x *GetConversationFoldersReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *AddConversationsToFolderReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	if len(x.ConversationIDs) == 0 {
+		return errors.New("conversationIDs is empty")
+	}
+	return nil
+}
+
+func (x *RemoveConversationsFromFolderReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	if len(x.ConversationIDs) == 0 {
+		return errors.New("conversationIDs is empty")
+	}
+	return nil
+}
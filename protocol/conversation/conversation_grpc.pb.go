@@ -58,6 +58,12 @@ const (
 	Conversation_GetPinnedConversationIDs_FullMethodName                = "/openim.conversation.conversation/GetPinnedConversationIDs"
 	Conversation_ClearUserConversationMsg_FullMethodName                = "/openim.conversation.conversation/ClearUserConversationMsg"
 	Conversation_GetConversationReadCursors_FullMethodName              = "/openim.conversation.conversation/GetConversationReadCursors"
+	Conversation_CreateConversationFolder_FullMethodName                = "/openim.conversation.conversation/CreateConversationFolder"
+	Conversation_UpdateConversationFolder_FullMethodName                = "/openim.conversation.conversation/UpdateConversationFolder"
+	Conversation_DeleteConversationFolder_FullMethodName                = "/openim.conversation.conversation/DeleteConversationFolder"
+	Conversation_GetConversationFolders_FullMethodName                  = "/openim.conversation.conversation/GetConversationFolders"
+	Conversation_AddConversationsToFolder_FullMethodName                = "/openim.conversation.conversation/AddConversationsToFolder"
+	Conversation_RemoveConversationsFromFolder_FullMethodName           = "/openim.conversation.conversation/RemoveConversationsFromFolder"
 )
 
 // ConversationClient is the client API for Conversation service.
@@ -89,6 +95,13 @@ type ConversationClient interface {
 	GetPinnedConversationIDs(ctx context.Context, in *GetPinnedConversationIDsReq, opts ...grpc.CallOption) (*GetPinnedConversationIDsResp, error)
 	ClearUserConversationMsg(ctx context.Context, in *ClearUserConversationMsgReq, opts ...grpc.CallOption) (*ClearUserConversationMsgResp, error)
 	GetConversationReadCursors(ctx context.Context, in *GetConversationReadCursorsReq, opts ...grpc.CallOption) (*GetConversationReadCursorsResp, error)
+	// 会话分组 分组变更通过会话版本日志同步
+	CreateConversationFolder(ctx context.Context, in *CreateConversationFolderReq, opts ...grpc.CallOption) (*CreateConversationFolderResp, error)
+	UpdateConversationFolder(ctx context.Context, in *UpdateConversationFolderReq, opts ...grpc.CallOption) (*UpdateConversationFolderResp, error)
+	DeleteConversationFolder(ctx context.Context, in *DeleteConversationFolderReq, opts ...grpc.CallOption) (*DeleteConversationFolderResp, error)
+	GetConversationFolders(ctx context.Context, in *GetConversationFoldersReq, opts ...grpc.CallOption) (*GetConversationFoldersResp, error)
+	AddConversationsToFolder(ctx context.Context, in *AddConversationsToFolderReq, opts ...grpc.CallOption) (*AddConversationsToFolderResp, error)
+	RemoveConversationsFromFolder(ctx context.Context, in *RemoveConversationsFromFolderReq, opts ...grpc.CallOption) (*RemoveConversationsFromFolderResp, error)
 }
 
 type conversationClient struct {
@@ -349,6 +362,66 @@ func (c *conversationClient) GetConversationReadCursors(ctx context.Context, in
 	return out, nil
 }
 
+func (c *conversationClient) CreateConversationFolder(ctx context.Context, in *CreateConversationFolderReq, opts ...grpc.CallOption) (*CreateConversationFolderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateConversationFolderResp)
+	err := c.cc.Invoke(ctx, Conversation_CreateConversationFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) UpdateConversationFolder(ctx context.Context, in *UpdateConversationFolderReq, opts ...grpc.CallOption) (*UpdateConversationFolderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateConversationFolderResp)
+	err := c.cc.Invoke(ctx, Conversation_UpdateConversationFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) DeleteConversationFolder(ctx context.Context, in *DeleteConversationFolderReq, opts ...grpc.CallOption) (*DeleteConversationFolderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteConversationFolderResp)
+	err := c.cc.Invoke(ctx, Conversation_DeleteConversationFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) GetConversationFolders(ctx context.Context, in *GetConversationFoldersReq, opts ...grpc.CallOption) (*GetConversationFoldersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationFoldersResp)
+	err := c.cc.Invoke(ctx, Conversation_GetConversationFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) AddConversationsToFolder(ctx context.Context, in *AddConversationsToFolderReq, opts ...grpc.CallOption) (*AddConversationsToFolderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddConversationsToFolderResp)
+	err := c.cc.Invoke(ctx, Conversation_AddConversationsToFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) RemoveConversationsFromFolder(ctx context.Context, in *RemoveConversationsFromFolderReq, opts ...grpc.CallOption) (*RemoveConversationsFromFolderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveConversationsFromFolderResp)
+	err := c.cc.Invoke(ctx, Conversation_RemoveConversationsFromFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConversationServer is the server API for Conversation service.
 // All implementations must embed UnimplementedConversationServer
 // for forward compatibility.
@@ -378,6 +451,13 @@ type ConversationServer interface {
 	GetPinnedConversationIDs(context.Context, *GetPinnedConversationIDsReq) (*GetPinnedConversationIDsResp, error)
 	ClearUserConversationMsg(context.Context, *ClearUserConversationMsgReq) (*ClearUserConversationMsgResp, error)
 	GetConversationReadCursors(context.Context, *GetConversationReadCursorsReq) (*GetConversationReadCursorsResp, error)
+	// 会话分组 分组变更通过会话版本日志同步
+	CreateConversationFolder(context.Context, *CreateConversationFolderReq) (*CreateConversationFolderResp, error)
+	UpdateConversationFolder(context.Context, *UpdateConversationFolderReq) (*UpdateConversationFolderResp, error)
+	DeleteConversationFolder(context.Context, *DeleteConversationFolderReq) (*DeleteConversationFolderResp, error)
+	GetConversationFolders(context.Context, *GetConversationFoldersReq) (*GetConversationFoldersResp, error)
+	AddConversationsToFolder(context.Context, *AddConversationsToFolderReq) (*AddConversationsToFolderResp, error)
+	RemoveConversationsFromFolder(context.Context, *RemoveConversationsFromFolderReq) (*RemoveConversationsFromFolderResp, error)
 	mustEmbedUnimplementedConversationServer()
 }
 
@@ -463,6 +543,24 @@ func (UnimplementedConversationServer) ClearUserConversationMsg(context.Context,
 func (UnimplementedConversationServer) GetConversationReadCursors(context.Context, *GetConversationReadCursorsReq) (*GetConversationReadCursorsResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetConversationReadCursors not implemented")
 }
+func (UnimplementedConversationServer) CreateConversationFolder(context.Context, *CreateConversationFolderReq) (*CreateConversationFolderResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateConversationFolder not implemented")
+}
+func (UnimplementedConversationServer) UpdateConversationFolder(context.Context, *UpdateConversationFolderReq) (*UpdateConversationFolderResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateConversationFolder not implemented")
+}
+func (UnimplementedConversationServer) DeleteConversationFolder(context.Context, *DeleteConversationFolderReq) (*DeleteConversationFolderResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteConversationFolder not implemented")
+}
+func (UnimplementedConversationServer) GetConversationFolders(context.Context, *GetConversationFoldersReq) (*GetConversationFoldersResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConversationFolders not implemented")
+}
+func (UnimplementedConversationServer) AddConversationsToFolder(context.Context, *AddConversationsToFolderReq) (*AddConversationsToFolderResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddConversationsToFolder not implemented")
+}
+func (UnimplementedConversationServer) RemoveConversationsFromFolder(context.Context, *RemoveConversationsFromFolderReq) (*RemoveConversationsFromFolderResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveConversationsFromFolder not implemented")
+}
 func (UnimplementedConversationServer) mustEmbedUnimplementedConversationServer() {}
 func (UnimplementedConversationServer) testEmbeddedByValue()                      {}
 
@@ -934,6 +1032,114 @@ func _Conversation_GetConversationReadCursors_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Conversation_CreateConversationFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConversationFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).CreateConversationFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_CreateConversationFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).CreateConversationFolder(ctx, req.(*CreateConversationFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_UpdateConversationFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConversationFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).UpdateConversationFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_UpdateConversationFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).UpdateConversationFolder(ctx, req.(*UpdateConversationFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_DeleteConversationFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConversationFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).DeleteConversationFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_DeleteConversationFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).DeleteConversationFolder(ctx, req.(*DeleteConversationFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_GetConversationFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationFoldersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).GetConversationFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_GetConversationFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).GetConversationFolders(ctx, req.(*GetConversationFoldersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_AddConversationsToFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConversationsToFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).AddConversationsToFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_AddConversationsToFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).AddConversationsToFolder(ctx, req.(*AddConversationsToFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_RemoveConversationsFromFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveConversationsFromFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).RemoveConversationsFromFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_RemoveConversationsFromFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).RemoveConversationsFromFolder(ctx, req.(*RemoveConversationsFromFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Conversation_ServiceDesc is the grpc.ServiceDesc for Conversation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1041,6 +1247,30 @@ var Conversation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConversationReadCursors",
 			Handler:    _Conversation_GetConversationReadCursors_Handler,
 		},
+		{
+			MethodName: "CreateConversationFolder",
+			Handler:    _Conversation_CreateConversationFolder_Handler,
+		},
+		{
+			MethodName: "UpdateConversationFolder",
+			Handler:    _Conversation_UpdateConversationFolder_Handler,
+		},
+		{
+			MethodName: "DeleteConversationFolder",
+			Handler:    _Conversation_DeleteConversationFolder_Handler,
+		},
+		{
+			MethodName: "GetConversationFolders",
+			Handler:    _Conversation_GetConversationFolders_Handler,
+		},
+		{
+			MethodName: "AddConversationsToFolder",
+			Handler:    _Conversation_AddConversationsToFolder_Handler,
+		},
+		{
+			MethodName: "RemoveConversationsFromFolder",
+			Handler:    _Conversation_RemoveConversationsFromFolder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "conversation/conversation.proto",
@@ -402,6 +402,172 @@ func (x *GetFullJoinGroupIDsReq) Check() error {
 	return nil
 }
 
+func (x *CreateGroupInviteReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.MaxUses < 0 {
+		return errors.New("maxUses is invalid")
+	}
+	if x.ExpireTime < 0 {
+		return errors.New("expireTime is invalid")
+	}
+	return nil
+}
+
+func (x *GetGroupInvitesReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *RevokeGroupInviteReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Token == "" {
+		return errors.New("token is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupInviteInfoReq) Check() error {
+	if x.Token == "" {
+		return errors.New("token is empty")
+	}
+	return nil
+}
+
+func (x *JoinGroupByInviteReq) Check() error {
+	if x.Token == "" {
+		return errors.New("token is empty")
+	}
+	if x.JoinSource != 0 && x.JoinSource != constant.JoinByQRCode && x.JoinSource != constant.JoinByInviteLink {
+		return errors.New("joinSource is invalid")
+	}
+	return nil
+}
+
+func (x *PublishGroupAnnouncementReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Content == "" {
+		return errors.New("content is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupAnnouncementsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *ConfirmGroupAnnouncementReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.AnnouncementID == "" {
+		return errors.New("announcementID is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupAnnouncementConfirmsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.AnnouncementID == "" {
+		return errors.New("announcementID is empty")
+	}
+	return nil
+}
+
+func (x *RemindGroupAnnouncementReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.AnnouncementID == "" {
+		return errors.New("announcementID is empty")
+	}
+	return nil
+}
+
+func (x *SetGroupMemberLimitReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.MemberLimit < 0 {
+		return errors.New("memberLimit is invalid")
+	}
+	return nil
+}
+
+func (x *GetGroupMemberLimitReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *ImportGroupMembersReq) Check() error {
+	if x.ObjectName == "" {
+		return errors.New("objectName is empty")
+	}
+	if x.Format != "" && x.Format != constant.GroupRosterCSV && x.Format != constant.GroupRosterJSON {
+		return errors.New("format is invalid")
+	}
+	return nil
+}
+
+func (x *GetGroupImportJobReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+func (x *ExportGroupMembersReq) Check() error {
+	if len(x.GroupIDs) == 0 {
+		return errors.New("groupIDs is empty")
+	}
+	if len(x.GroupIDs) > 100 {
+		return errors.New("too many groupIDs, at most 100")
+	}
+	if x.Format != "" && x.Format != constant.GroupRosterCSV && x.Format != constant.GroupRosterJSON {
+		return errors.New("format is invalid")
+	}
+	return nil
+}
+
+func (x *SetGroupAdminPermissionsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Permissions < 0 || x.Permissions&^constant.GroupPermissionAll != 0 {
+		return errors.New("permissions is invalid")
+	}
+	return nil
+}
+
 func (x *BatchGetIncrementalGroupMemberResp) Format() any {
 	if len(x.RespList) > 50 {
 		return fmt.Sprintf("len is %v", len(x.RespList))
@@ -71,6 +71,22 @@ const (
 	Group_GetIncrementalJoinGroup_FullMethodName          = "/openim.group.group/getIncrementalJoinGroup"
 	Group_GetFullGroupMemberUserIDs_FullMethodName        = "/openim.group.group/GetFullGroupMemberUserIDs"
 	Group_GetFullJoinGroupIDs_FullMethodName              = "/openim.group.group/GetFullJoinGroupIDs"
+	Group_CreateGroupInvite_FullMethodName                = "/openim.group.group/createGroupInvite"
+	Group_GetGroupInvites_FullMethodName                  = "/openim.group.group/getGroupInvites"
+	Group_RevokeGroupInvite_FullMethodName                = "/openim.group.group/revokeGroupInvite"
+	Group_GetGroupInviteInfo_FullMethodName               = "/openim.group.group/getGroupInviteInfo"
+	Group_JoinGroupByInvite_FullMethodName                = "/openim.group.group/joinGroupByInvite"
+	Group_SetGroupAdminPermissions_FullMethodName         = "/openim.group.group/setGroupAdminPermissions"
+	Group_PublishGroupAnnouncement_FullMethodName         = "/openim.group.group/publishGroupAnnouncement"
+	Group_GetGroupAnnouncements_FullMethodName            = "/openim.group.group/getGroupAnnouncements"
+	Group_ConfirmGroupAnnouncement_FullMethodName         = "/openim.group.group/confirmGroupAnnouncement"
+	Group_GetGroupAnnouncementConfirms_FullMethodName     = "/openim.group.group/getGroupAnnouncementConfirms"
+	Group_RemindGroupAnnouncement_FullMethodName          = "/openim.group.group/remindGroupAnnouncement"
+	Group_SetGroupMemberLimit_FullMethodName              = "/openim.group.group/setGroupMemberLimit"
+	Group_GetGroupMemberLimit_FullMethodName              = "/openim.group.group/getGroupMemberLimit"
+	Group_ImportGroupMembers_FullMethodName               = "/openim.group.group/importGroupMembers"
+	Group_GetGroupImportJob_FullMethodName                = "/openim.group.group/getGroupImportJob"
+	Group_ExportGroupMembers_FullMethodName               = "/openim.group.group/exportGroupMembers"
 )
 
 // GroupClient is the client API for Group service.
@@ -140,6 +156,38 @@ type GroupClient interface {
 	GetIncrementalJoinGroup(ctx context.Context, in *GetIncrementalJoinGroupReq, opts ...grpc.CallOption) (*GetIncrementalJoinGroupResp, error)
 	GetFullGroupMemberUserIDs(ctx context.Context, in *GetFullGroupMemberUserIDsReq, opts ...grpc.CallOption) (*GetFullGroupMemberUserIDsResp, error)
 	GetFullJoinGroupIDs(ctx context.Context, in *GetFullJoinGroupIDsReq, opts ...grpc.CallOption) (*GetFullJoinGroupIDsResp, error)
+	// 创建群邀请链接/二维码
+	CreateGroupInvite(ctx context.Context, in *CreateGroupInviteReq, opts ...grpc.CallOption) (*CreateGroupInviteResp, error)
+	// 获取群的邀请链接列表
+	GetGroupInvites(ctx context.Context, in *GetGroupInvitesReq, opts ...grpc.CallOption) (*GetGroupInvitesResp, error)
+	// 撤销群邀请链接
+	RevokeGroupInvite(ctx context.Context, in *RevokeGroupInviteReq, opts ...grpc.CallOption) (*RevokeGroupInviteResp, error)
+	// 通过邀请码获取群信息
+	GetGroupInviteInfo(ctx context.Context, in *GetGroupInviteInfoReq, opts ...grpc.CallOption) (*GetGroupInviteInfoResp, error)
+	// 通过邀请链接/二维码加群
+	JoinGroupByInvite(ctx context.Context, in *JoinGroupByInviteReq, opts ...grpc.CallOption) (*JoinGroupByInviteResp, error)
+	// 设置群管理员权限
+	SetGroupAdminPermissions(ctx context.Context, in *SetGroupAdminPermissionsReq, opts ...grpc.CallOption) (*SetGroupAdminPermissionsResp, error)
+	// 发布群公告
+	PublishGroupAnnouncement(ctx context.Context, in *PublishGroupAnnouncementReq, opts ...grpc.CallOption) (*PublishGroupAnnouncementResp, error)
+	// 获取群公告历史
+	GetGroupAnnouncements(ctx context.Context, in *GetGroupAnnouncementsReq, opts ...grpc.CallOption) (*GetGroupAnnouncementsResp, error)
+	// 确认群公告
+	ConfirmGroupAnnouncement(ctx context.Context, in *ConfirmGroupAnnouncementReq, opts ...grpc.CallOption) (*ConfirmGroupAnnouncementResp, error)
+	// 获取群公告确认情况
+	GetGroupAnnouncementConfirms(ctx context.Context, in *GetGroupAnnouncementConfirmsReq, opts ...grpc.CallOption) (*GetGroupAnnouncementConfirmsResp, error)
+	// 提醒未确认成员
+	RemindGroupAnnouncement(ctx context.Context, in *RemindGroupAnnouncementReq, opts ...grpc.CallOption) (*RemindGroupAnnouncementResp, error)
+	// 设置群成员上限
+	SetGroupMemberLimit(ctx context.Context, in *SetGroupMemberLimitReq, opts ...grpc.CallOption) (*SetGroupMemberLimitResp, error)
+	// 获取群成员上限
+	GetGroupMemberLimit(ctx context.Context, in *GetGroupMemberLimitReq, opts ...grpc.CallOption) (*GetGroupMemberLimitResp, error)
+	// 导入群成员名单
+	ImportGroupMembers(ctx context.Context, in *ImportGroupMembersReq, opts ...grpc.CallOption) (*ImportGroupMembersResp, error)
+	// 获取群成员导入任务
+	GetGroupImportJob(ctx context.Context, in *GetGroupImportJobReq, opts ...grpc.CallOption) (*GetGroupImportJobResp, error)
+	// 导出群成员名单
+	ExportGroupMembers(ctx context.Context, in *ExportGroupMembersReq, opts ...grpc.CallOption) (*ExportGroupMembersResp, error)
 }
 
 type groupClient struct {
@@ -530,6 +578,166 @@ func (c *groupClient) GetFullJoinGroupIDs(ctx context.Context, in *GetFullJoinGr
 	return out, nil
 }
 
+func (c *groupClient) CreateGroupInvite(ctx context.Context, in *CreateGroupInviteReq, opts ...grpc.CallOption) (*CreateGroupInviteResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupInviteResp)
+	err := c.cc.Invoke(ctx, Group_CreateGroupInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupInvites(ctx context.Context, in *GetGroupInvitesReq, opts ...grpc.CallOption) (*GetGroupInvitesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupInvitesResp)
+	err := c.cc.Invoke(ctx, Group_GetGroupInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) RevokeGroupInvite(ctx context.Context, in *RevokeGroupInviteReq, opts ...grpc.CallOption) (*RevokeGroupInviteResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeGroupInviteResp)
+	err := c.cc.Invoke(ctx, Group_RevokeGroupInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupInviteInfo(ctx context.Context, in *GetGroupInviteInfoReq, opts ...grpc.CallOption) (*GetGroupInviteInfoResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupInviteInfoResp)
+	err := c.cc.Invoke(ctx, Group_GetGroupInviteInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) JoinGroupByInvite(ctx context.Context, in *JoinGroupByInviteReq, opts ...grpc.CallOption) (*JoinGroupByInviteResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinGroupByInviteResp)
+	err := c.cc.Invoke(ctx, Group_JoinGroupByInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) SetGroupAdminPermissions(ctx context.Context, in *SetGroupAdminPermissionsReq, opts ...grpc.CallOption) (*SetGroupAdminPermissionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupAdminPermissionsResp)
+	err := c.cc.Invoke(ctx, Group_SetGroupAdminPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) PublishGroupAnnouncement(ctx context.Context, in *PublishGroupAnnouncementReq, opts ...grpc.CallOption) (*PublishGroupAnnouncementResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishGroupAnnouncementResp)
+	err := c.cc.Invoke(ctx, Group_PublishGroupAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupAnnouncements(ctx context.Context, in *GetGroupAnnouncementsReq, opts ...grpc.CallOption) (*GetGroupAnnouncementsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupAnnouncementsResp)
+	err := c.cc.Invoke(ctx, Group_GetGroupAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) ConfirmGroupAnnouncement(ctx context.Context, in *ConfirmGroupAnnouncementReq, opts ...grpc.CallOption) (*ConfirmGroupAnnouncementResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmGroupAnnouncementResp)
+	err := c.cc.Invoke(ctx, Group_ConfirmGroupAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupAnnouncementConfirms(ctx context.Context, in *GetGroupAnnouncementConfirmsReq, opts ...grpc.CallOption) (*GetGroupAnnouncementConfirmsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupAnnouncementConfirmsResp)
+	err := c.cc.Invoke(ctx, Group_GetGroupAnnouncementConfirms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) RemindGroupAnnouncement(ctx context.Context, in *RemindGroupAnnouncementReq, opts ...grpc.CallOption) (*RemindGroupAnnouncementResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemindGroupAnnouncementResp)
+	err := c.cc.Invoke(ctx, Group_RemindGroupAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) SetGroupMemberLimit(ctx context.Context, in *SetGroupMemberLimitReq, opts ...grpc.CallOption) (*SetGroupMemberLimitResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupMemberLimitResp)
+	err := c.cc.Invoke(ctx, Group_SetGroupMemberLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupMemberLimit(ctx context.Context, in *GetGroupMemberLimitReq, opts ...grpc.CallOption) (*GetGroupMemberLimitResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupMemberLimitResp)
+	err := c.cc.Invoke(ctx, Group_GetGroupMemberLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) ImportGroupMembers(ctx context.Context, in *ImportGroupMembersReq, opts ...grpc.CallOption) (*ImportGroupMembersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportGroupMembersResp)
+	err := c.cc.Invoke(ctx, Group_ImportGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupImportJob(ctx context.Context, in *GetGroupImportJobReq, opts ...grpc.CallOption) (*GetGroupImportJobResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupImportJobResp)
+	err := c.cc.Invoke(ctx, Group_GetGroupImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) ExportGroupMembers(ctx context.Context, in *ExportGroupMembersReq, opts ...grpc.CallOption) (*ExportGroupMembersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportGroupMembersResp)
+	err := c.cc.Invoke(ctx, Group_ExportGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServer is the server API for Group service.
 // All implementations must embed UnimplementedGroupServer
 // for forward compatibility.
@@ -597,6 +805,38 @@ type GroupServer interface {
 	GetIncrementalJoinGroup(context.Context, *GetIncrementalJoinGroupReq) (*GetIncrementalJoinGroupResp, error)
 	GetFullGroupMemberUserIDs(context.Context, *GetFullGroupMemberUserIDsReq) (*GetFullGroupMemberUserIDsResp, error)
 	GetFullJoinGroupIDs(context.Context, *GetFullJoinGroupIDsReq) (*GetFullJoinGroupIDsResp, error)
+	// 创建群邀请链接/二维码
+	CreateGroupInvite(context.Context, *CreateGroupInviteReq) (*CreateGroupInviteResp, error)
+	// 获取群的邀请链接列表
+	GetGroupInvites(context.Context, *GetGroupInvitesReq) (*GetGroupInvitesResp, error)
+	// 撤销群邀请链接
+	RevokeGroupInvite(context.Context, *RevokeGroupInviteReq) (*RevokeGroupInviteResp, error)
+	// 通过邀请码获取群信息
+	GetGroupInviteInfo(context.Context, *GetGroupInviteInfoReq) (*GetGroupInviteInfoResp, error)
+	// 通过邀请链接/二维码加群
+	JoinGroupByInvite(context.Context, *JoinGroupByInviteReq) (*JoinGroupByInviteResp, error)
+	// 设置群管理员权限
+	SetGroupAdminPermissions(context.Context, *SetGroupAdminPermissionsReq) (*SetGroupAdminPermissionsResp, error)
+	// 发布群公告
+	PublishGroupAnnouncement(context.Context, *PublishGroupAnnouncementReq) (*PublishGroupAnnouncementResp, error)
+	// 获取群公告历史
+	GetGroupAnnouncements(context.Context, *GetGroupAnnouncementsReq) (*GetGroupAnnouncementsResp, error)
+	// 确认群公告
+	ConfirmGroupAnnouncement(context.Context, *ConfirmGroupAnnouncementReq) (*ConfirmGroupAnnouncementResp, error)
+	// 获取群公告确认情况
+	GetGroupAnnouncementConfirms(context.Context, *GetGroupAnnouncementConfirmsReq) (*GetGroupAnnouncementConfirmsResp, error)
+	// 提醒未确认成员
+	RemindGroupAnnouncement(context.Context, *RemindGroupAnnouncementReq) (*RemindGroupAnnouncementResp, error)
+	// 设置群成员上限
+	SetGroupMemberLimit(context.Context, *SetGroupMemberLimitReq) (*SetGroupMemberLimitResp, error)
+	// 获取群成员上限
+	GetGroupMemberLimit(context.Context, *GetGroupMemberLimitReq) (*GetGroupMemberLimitResp, error)
+	// 导入群成员名单
+	ImportGroupMembers(context.Context, *ImportGroupMembersReq) (*ImportGroupMembersResp, error)
+	// 获取群成员导入任务
+	GetGroupImportJob(context.Context, *GetGroupImportJobReq) (*GetGroupImportJobResp, error)
+	// 导出群成员名单
+	ExportGroupMembers(context.Context, *ExportGroupMembersReq) (*ExportGroupMembersResp, error)
 	mustEmbedUnimplementedGroupServer()
 }
 
@@ -721,6 +961,54 @@ func (UnimplementedGroupServer) GetFullGroupMemberUserIDs(context.Context, *GetF
 func (UnimplementedGroupServer) GetFullJoinGroupIDs(context.Context, *GetFullJoinGroupIDsReq) (*GetFullJoinGroupIDsResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFullJoinGroupIDs not implemented")
 }
+func (UnimplementedGroupServer) CreateGroupInvite(context.Context, *CreateGroupInviteReq) (*CreateGroupInviteResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGroupInvite not implemented")
+}
+func (UnimplementedGroupServer) GetGroupInvites(context.Context, *GetGroupInvitesReq) (*GetGroupInvitesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupInvites not implemented")
+}
+func (UnimplementedGroupServer) RevokeGroupInvite(context.Context, *RevokeGroupInviteReq) (*RevokeGroupInviteResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeGroupInvite not implemented")
+}
+func (UnimplementedGroupServer) GetGroupInviteInfo(context.Context, *GetGroupInviteInfoReq) (*GetGroupInviteInfoResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupInviteInfo not implemented")
+}
+func (UnimplementedGroupServer) JoinGroupByInvite(context.Context, *JoinGroupByInviteReq) (*JoinGroupByInviteResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinGroupByInvite not implemented")
+}
+func (UnimplementedGroupServer) SetGroupAdminPermissions(context.Context, *SetGroupAdminPermissionsReq) (*SetGroupAdminPermissionsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGroupAdminPermissions not implemented")
+}
+func (UnimplementedGroupServer) PublishGroupAnnouncement(context.Context, *PublishGroupAnnouncementReq) (*PublishGroupAnnouncementResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishGroupAnnouncement not implemented")
+}
+func (UnimplementedGroupServer) GetGroupAnnouncements(context.Context, *GetGroupAnnouncementsReq) (*GetGroupAnnouncementsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupAnnouncements not implemented")
+}
+func (UnimplementedGroupServer) ConfirmGroupAnnouncement(context.Context, *ConfirmGroupAnnouncementReq) (*ConfirmGroupAnnouncementResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmGroupAnnouncement not implemented")
+}
+func (UnimplementedGroupServer) GetGroupAnnouncementConfirms(context.Context, *GetGroupAnnouncementConfirmsReq) (*GetGroupAnnouncementConfirmsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupAnnouncementConfirms not implemented")
+}
+func (UnimplementedGroupServer) RemindGroupAnnouncement(context.Context, *RemindGroupAnnouncementReq) (*RemindGroupAnnouncementResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemindGroupAnnouncement not implemented")
+}
+func (UnimplementedGroupServer) SetGroupMemberLimit(context.Context, *SetGroupMemberLimitReq) (*SetGroupMemberLimitResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGroupMemberLimit not implemented")
+}
+func (UnimplementedGroupServer) GetGroupMemberLimit(context.Context, *GetGroupMemberLimitReq) (*GetGroupMemberLimitResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupMemberLimit not implemented")
+}
+func (UnimplementedGroupServer) ImportGroupMembers(context.Context, *ImportGroupMembersReq) (*ImportGroupMembersResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportGroupMembers not implemented")
+}
+func (UnimplementedGroupServer) GetGroupImportJob(context.Context, *GetGroupImportJobReq) (*GetGroupImportJobResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupImportJob not implemented")
+}
+func (UnimplementedGroupServer) ExportGroupMembers(context.Context, *ExportGroupMembersReq) (*ExportGroupMembersResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportGroupMembers not implemented")
+}
 func (UnimplementedGroupServer) mustEmbedUnimplementedGroupServer() {}
 func (UnimplementedGroupServer) testEmbeddedByValue()               {}
 
@@ -1426,6 +1714,294 @@ func _Group_GetFullJoinGroupIDs_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Group_CreateGroupInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).CreateGroupInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_CreateGroupInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).CreateGroupInvite(ctx, req.(*CreateGroupInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupInvitesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_GetGroupInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupInvites(ctx, req.(*GetGroupInvitesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_RevokeGroupInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGroupInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).RevokeGroupInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_RevokeGroupInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).RevokeGroupInvite(ctx, req.(*RevokeGroupInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupInviteInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupInviteInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupInviteInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_GetGroupInviteInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupInviteInfo(ctx, req.(*GetGroupInviteInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_JoinGroupByInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGroupByInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).JoinGroupByInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_JoinGroupByInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).JoinGroupByInvite(ctx, req.(*JoinGroupByInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_SetGroupAdminPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupAdminPermissionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SetGroupAdminPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_SetGroupAdminPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SetGroupAdminPermissions(ctx, req.(*SetGroupAdminPermissionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_PublishGroupAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishGroupAnnouncementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).PublishGroupAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_PublishGroupAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).PublishGroupAnnouncement(ctx, req.(*PublishGroupAnnouncementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupAnnouncementsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_GetGroupAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupAnnouncements(ctx, req.(*GetGroupAnnouncementsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_ConfirmGroupAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmGroupAnnouncementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).ConfirmGroupAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_ConfirmGroupAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).ConfirmGroupAnnouncement(ctx, req.(*ConfirmGroupAnnouncementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupAnnouncementConfirms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupAnnouncementConfirmsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupAnnouncementConfirms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_GetGroupAnnouncementConfirms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupAnnouncementConfirms(ctx, req.(*GetGroupAnnouncementConfirmsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_RemindGroupAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemindGroupAnnouncementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).RemindGroupAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_RemindGroupAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).RemindGroupAnnouncement(ctx, req.(*RemindGroupAnnouncementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_SetGroupMemberLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupMemberLimitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SetGroupMemberLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_SetGroupMemberLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SetGroupMemberLimit(ctx, req.(*SetGroupMemberLimitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupMemberLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMemberLimitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupMemberLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_GetGroupMemberLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupMemberLimit(ctx, req.(*GetGroupMemberLimitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_ImportGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGroupMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).ImportGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_ImportGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).ImportGroupMembers(ctx, req.(*ImportGroupMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupImportJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_GetGroupImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupImportJob(ctx, req.(*GetGroupImportJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_ExportGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGroupMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).ExportGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_ExportGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).ExportGroupMembers(ctx, req.(*ExportGroupMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Group_ServiceDesc is the grpc.ServiceDesc for Group service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1585,6 +2161,70 @@ var Group_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFullJoinGroupIDs",
 			Handler:    _Group_GetFullJoinGroupIDs_Handler,
 		},
+		{
+			MethodName: "createGroupInvite",
+			Handler:    _Group_CreateGroupInvite_Handler,
+		},
+		{
+			MethodName: "getGroupInvites",
+			Handler:    _Group_GetGroupInvites_Handler,
+		},
+		{
+			MethodName: "revokeGroupInvite",
+			Handler:    _Group_RevokeGroupInvite_Handler,
+		},
+		{
+			MethodName: "getGroupInviteInfo",
+			Handler:    _Group_GetGroupInviteInfo_Handler,
+		},
+		{
+			MethodName: "joinGroupByInvite",
+			Handler:    _Group_JoinGroupByInvite_Handler,
+		},
+		{
+			MethodName: "setGroupAdminPermissions",
+			Handler:    _Group_SetGroupAdminPermissions_Handler,
+		},
+		{
+			MethodName: "publishGroupAnnouncement",
+			Handler:    _Group_PublishGroupAnnouncement_Handler,
+		},
+		{
+			MethodName: "getGroupAnnouncements",
+			Handler:    _Group_GetGroupAnnouncements_Handler,
+		},
+		{
+			MethodName: "confirmGroupAnnouncement",
+			Handler:    _Group_ConfirmGroupAnnouncement_Handler,
+		},
+		{
+			MethodName: "getGroupAnnouncementConfirms",
+			Handler:    _Group_GetGroupAnnouncementConfirms_Handler,
+		},
+		{
+			MethodName: "remindGroupAnnouncement",
+			Handler:    _Group_RemindGroupAnnouncement_Handler,
+		},
+		{
+			MethodName: "setGroupMemberLimit",
+			Handler:    _Group_SetGroupMemberLimit_Handler,
+		},
+		{
+			MethodName: "getGroupMemberLimit",
+			Handler:    _Group_GetGroupMemberLimit_Handler,
+		},
+		{
+			MethodName: "importGroupMembers",
+			Handler:    _Group_ImportGroupMembers_Handler,
+		},
+		{
+			MethodName: "getGroupImportJob",
+			Handler:    _Group_GetGroupImportJob_Handler,
+		},
+		{
+			MethodName: "exportGroupMembers",
+			Handler:    _Group_ExportGroupMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "group/group.proto",
@@ -17,7 +17,10 @@ package msg
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
+
+	"github.com/openimsdk/protocol/constant"
 )
 
 func (x *GetMaxAndMinSeqReq) Check() error {
@@ -250,3 +253,197 @@ func (x *DestructMsgsReq) Check() error {
 	}
 	return nil
 }
+
+func (x *ArchiveMsgsReq) Check() error {
+	if x.Timestamp > time.Now().UnixMilli() {
+		return errors.New("request millisecond timestamp error")
+	}
+	if x.Limit <= 0 {
+		return errors.New("request limit error")
+	}
+	return nil
+}
+
+func (x *MsgRetentionPolicy) Check() error {
+	if x.RetainDays <= 0 {
+		return errors.New("retainDays must be greater than 0")
+	}
+	if len(x.ScopeIDs) == 0 {
+		return errors.New("scopeIDs is empty")
+	}
+	if len(x.ScopeIDs) > 1000 {
+		return errors.New("scopeIDs is too long")
+	}
+	switch x.Scope {
+	case constant.MsgRetentionScopeSessionType:
+		for _, id := range x.ScopeIDs {
+			sessionType, err := strconv.Atoi(id)
+			if err != nil || sessionType < constant.SingleChatType || sessionType > constant.NotificationChatType {
+				return fmt.Errorf("invalid session type %q", id)
+			}
+		}
+	case constant.MsgRetentionScopeUser, constant.MsgRetentionScopeGroup:
+		for _, id := range x.ScopeIDs {
+			if id == "" {
+				return errors.New("scopeIDs contains empty id")
+			}
+		}
+	default:
+		return errors.New("invalid scope")
+	}
+	return nil
+}
+
+func (x *SetMsgRetentionPolicyReq) Check() error {
+	if x.Policy == nil {
+		return errors.New("policy is empty")
+	}
+	return x.Policy.Check()
+}
+
+func (x *DeleteMsgRetentionPoliciesReq) Check() error {
+	if len(x.PolicyIDs) == 0 {
+		return errors.New("policyIDs is empty")
+	}
+	return nil
+}
+
+func (x *GetMsgRetentionPoliciesReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func checkLegalHoldTarget(targetType int32, targetIDs []string) error {
+	if targetType != constant.LegalHoldTargetUser && targetType != constant.LegalHoldTargetConversation {
+		return errors.New("invalid targetType")
+	}
+	if len(targetIDs) == 0 {
+		return errors.New("targetIDs is empty")
+	}
+	if len(targetIDs) > 1000 {
+		return errors.New("targetIDs is too long")
+	}
+	for _, id := range targetIDs {
+		if id == "" {
+			return errors.New("targetIDs contains empty id")
+		}
+	}
+	return nil
+}
+
+func (x *SetLegalHoldReq) Check() error {
+	return checkLegalHoldTarget(x.TargetType, x.TargetIDs)
+}
+
+func (x *RemoveLegalHoldReq) Check() error {
+	return checkLegalHoldTarget(x.TargetType, x.TargetIDs)
+}
+
+func (x *GetLegalHoldsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *StartComplianceExportReq) Check() error {
+	if (x.UserID == "") == (len(x.ConversationIDs) == 0) {
+		return errors.New("exactly one of userID and conversationIDs is required")
+	}
+	if len(x.ConversationIDs) > 1000 {
+		return errors.New("conversationIDs is too long")
+	}
+	return nil
+}
+
+func (x *GetComplianceExportReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+func (x *AddFavoriteReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *DeleteFavoritesReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if len(x.FavoriteIDs) == 0 {
+		return errors.New("favoriteIDs is empty")
+	}
+	return nil
+}
+
+func (x *UpdateFavoriteReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FavoriteID == "" {
+		return errors.New("favoriteID is empty")
+	}
+	return nil
+}
+
+func (x *SearchFavoritesReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *GetIncrementalFavoritesReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetFullFavoriteIDsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *ForwardMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if len(x.Seqs) == 0 {
+		return errors.New("seqs is empty")
+	}
+	if len(x.Targets) == 0 {
+		return errors.New("targets is empty")
+	}
+	return nil
+}
+
+func (x *SendNotificationsReq) Check() error {
+	if x.MsgData == nil {
+		return errors.New("msgData is empty")
+	}
+	if len(x.RecvIDs) == 0 {
+		return errors.New("recvIDs is empty")
+	}
+	return nil
+}
@@ -43,6 +43,7 @@ const (
 	Msg_GetSeqMessage_FullMethodName                    = "/openim.msg.msg/GetSeqMessage"
 	Msg_SearchMessage_FullMethodName                    = "/openim.msg.msg/SearchMessage"
 	Msg_SendMsg_FullMethodName                          = "/openim.msg.msg/SendMsg"
+	Msg_SendNotifications_FullMethodName                = "/openim.msg.msg/SendNotifications"
 	Msg_SetUserConversationsMinSeq_FullMethodName       = "/openim.msg.msg/SetUserConversationsMinSeq"
 	Msg_ClearConversationsMsg_FullMethodName            = "/openim.msg.msg/ClearConversationsMsg"
 	Msg_UserClearAllMsg_FullMethodName                  = "/openim.msg.msg/UserClearAllMsg"
@@ -61,6 +62,23 @@ const (
 	Msg_GetServerTime_FullMethodName                    = "/openim.msg.msg/GetServerTime"
 	Msg_ClearMsg_FullMethodName                         = "/openim.msg.msg/ClearMsg"
 	Msg_DestructMsgs_FullMethodName                     = "/openim.msg.msg/DestructMsgs"
+	Msg_ArchiveMsgs_FullMethodName                      = "/openim.msg.msg/ArchiveMsgs"
+	Msg_SetMsgRetentionPolicy_FullMethodName            = "/openim.msg.msg/SetMsgRetentionPolicy"
+	Msg_DeleteMsgRetentionPolicies_FullMethodName       = "/openim.msg.msg/DeleteMsgRetentionPolicies"
+	Msg_GetMsgRetentionPolicies_FullMethodName          = "/openim.msg.msg/GetMsgRetentionPolicies"
+	Msg_SetLegalHold_FullMethodName                     = "/openim.msg.msg/SetLegalHold"
+	Msg_RemoveLegalHold_FullMethodName                  = "/openim.msg.msg/RemoveLegalHold"
+	Msg_GetLegalHolds_FullMethodName                    = "/openim.msg.msg/GetLegalHolds"
+	Msg_GetLegalHoldUserIDs_FullMethodName              = "/openim.msg.msg/GetLegalHoldUserIDs"
+	Msg_StartComplianceExport_FullMethodName            = "/openim.msg.msg/StartComplianceExport"
+	Msg_GetComplianceExport_FullMethodName              = "/openim.msg.msg/GetComplianceExport"
+	Msg_AddFavorite_FullMethodName                      = "/openim.msg.msg/AddFavorite"
+	Msg_DeleteFavorites_FullMethodName                  = "/openim.msg.msg/DeleteFavorites"
+	Msg_UpdateFavorite_FullMethodName                   = "/openim.msg.msg/UpdateFavorite"
+	Msg_SearchFavorites_FullMethodName                  = "/openim.msg.msg/SearchFavorites"
+	Msg_GetIncrementalFavorites_FullMethodName          = "/openim.msg.msg/GetIncrementalFavorites"
+	Msg_GetFullFavoriteIDs_FullMethodName               = "/openim.msg.msg/GetFullFavoriteIDs"
+	Msg_ForwardMsgs_FullMethodName                      = "/openim.msg.msg/ForwardMsgs"
 	Msg_GetActiveConversation_FullMethodName            = "/openim.msg.msg/GetActiveConversation"
 	Msg_AppendStreamMsg_FullMethodName                  = "/openim.msg.msg/AppendStreamMsg"
 	Msg_GetStreamMsg_FullMethodName                     = "/openim.msg.msg/GetStreamMsg"
@@ -90,6 +108,8 @@ type MsgClient interface {
 	SearchMessage(ctx context.Context, in *SearchMessageReq, opts ...grpc.CallOption) (*SearchMessageResp, error)
 	// 发送消息
 	SendMsg(ctx context.Context, in *SendMsgReq, opts ...grpc.CallOption) (*SendMsgResp, error)
+	// 批量发送同一条通知给多个用户
+	SendNotifications(ctx context.Context, in *SendNotificationsReq, opts ...grpc.CallOption) (*SendNotificationsResp, error)
 	SetUserConversationsMinSeq(ctx context.Context, in *SetUserConversationsMinSeqReq, opts ...grpc.CallOption) (*SetUserConversationsMinSeqResp, error)
 	// 全量清空指定会话消息 重置min seq 比最大seq大1
 	ClearConversationsMsg(ctx context.Context, in *ClearConversationsMsgReq, opts ...grpc.CallOption) (*ClearConversationsMsgResp, error)
@@ -116,6 +136,29 @@ type MsgClient interface {
 	GetServerTime(ctx context.Context, in *GetServerTimeReq, opts ...grpc.CallOption) (*GetServerTimeResp, error)
 	ClearMsg(ctx context.Context, in *ClearMsgReq, opts ...grpc.CallOption) (*ClearMsgResp, error)
 	DestructMsgs(ctx context.Context, in *DestructMsgsReq, opts ...grpc.CallOption) (*DestructMsgsResp, error)
+	// 将早于指定时间的完整消息文档归档到对象存储
+	ArchiveMsgs(ctx context.Context, in *ArchiveMsgsReq, opts ...grpc.CallOption) (*ArchiveMsgsResp, error)
+	// 消息保留策略 按群组、会话类型或用户设置保留天数
+	SetMsgRetentionPolicy(ctx context.Context, in *SetMsgRetentionPolicyReq, opts ...grpc.CallOption) (*SetMsgRetentionPolicyResp, error)
+	DeleteMsgRetentionPolicies(ctx context.Context, in *DeleteMsgRetentionPoliciesReq, opts ...grpc.CallOption) (*DeleteMsgRetentionPoliciesResp, error)
+	GetMsgRetentionPolicies(ctx context.Context, in *GetMsgRetentionPoliciesReq, opts ...grpc.CallOption) (*GetMsgRetentionPoliciesResp, error)
+	// 合规保全 被保全的用户和会话不会被清理
+	SetLegalHold(ctx context.Context, in *SetLegalHoldReq, opts ...grpc.CallOption) (*SetLegalHoldResp, error)
+	RemoveLegalHold(ctx context.Context, in *RemoveLegalHoldReq, opts ...grpc.CallOption) (*RemoveLegalHoldResp, error)
+	GetLegalHolds(ctx context.Context, in *GetLegalHoldsReq, opts ...grpc.CallOption) (*GetLegalHoldsResp, error)
+	GetLegalHoldUserIDs(ctx context.Context, in *GetLegalHoldUserIDsReq, opts ...grpc.CallOption) (*GetLegalHoldUserIDsResp, error)
+	// 合规导出 导出用户或会话的完整消息记录和附件
+	StartComplianceExport(ctx context.Context, in *StartComplianceExportReq, opts ...grpc.CallOption) (*StartComplianceExportResp, error)
+	GetComplianceExport(ctx context.Context, in *GetComplianceExportReq, opts ...grpc.CallOption) (*GetComplianceExportResp, error)
+	// 消息收藏 保存消息快照和附件副本 通过版本日志增量同步
+	AddFavorite(ctx context.Context, in *AddFavoriteReq, opts ...grpc.CallOption) (*AddFavoriteResp, error)
+	DeleteFavorites(ctx context.Context, in *DeleteFavoritesReq, opts ...grpc.CallOption) (*DeleteFavoritesResp, error)
+	UpdateFavorite(ctx context.Context, in *UpdateFavoriteReq, opts ...grpc.CallOption) (*UpdateFavoriteResp, error)
+	SearchFavorites(ctx context.Context, in *SearchFavoritesReq, opts ...grpc.CallOption) (*SearchFavoritesResp, error)
+	GetIncrementalFavorites(ctx context.Context, in *GetIncrementalFavoritesReq, opts ...grpc.CallOption) (*GetIncrementalFavoritesResp, error)
+	GetFullFavoriteIDs(ctx context.Context, in *GetFullFavoriteIDsReq, opts ...grpc.CallOption) (*GetFullFavoriteIDsResp, error)
+	// 服务端转发消息 按目标会话逐个校验发送 保留原发送者和时间
+	ForwardMsgs(ctx context.Context, in *ForwardMsgsReq, opts ...grpc.CallOption) (*ForwardMsgsResp, error)
 	GetActiveConversation(ctx context.Context, in *GetActiveConversationReq, opts ...grpc.CallOption) (*GetActiveConversationResp, error)
 	AppendStreamMsg(ctx context.Context, in *AppendStreamMsgReq, opts ...grpc.CallOption) (*AppendStreamMsgResp, error)
 	GetStreamMsg(ctx context.Context, in *GetStreamMsgReq, opts ...grpc.CallOption) (*GetStreamMsgResp, error)
@@ -225,6 +268,16 @@ func (c *msgClient) SendMsg(ctx context.Context, in *SendMsgReq, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) SendNotifications(ctx context.Context, in *SendNotificationsReq, opts ...grpc.CallOption) (*SendNotificationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendNotificationsResp)
+	err := c.cc.Invoke(ctx, Msg_SendNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetUserConversationsMinSeq(ctx context.Context, in *SetUserConversationsMinSeqReq, opts ...grpc.CallOption) (*SetUserConversationsMinSeqResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetUserConversationsMinSeqResp)
@@ -405,6 +458,176 @@ func (c *msgClient) DestructMsgs(ctx context.Context, in *DestructMsgsReq, opts
 	return out, nil
 }
 
+func (c *msgClient) ArchiveMsgs(ctx context.Context, in *ArchiveMsgsReq, opts ...grpc.CallOption) (*ArchiveMsgsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveMsgsResp)
+	err := c.cc.Invoke(ctx, Msg_ArchiveMsgs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMsgRetentionPolicy(ctx context.Context, in *SetMsgRetentionPolicyReq, opts ...grpc.CallOption) (*SetMsgRetentionPolicyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMsgRetentionPolicyResp)
+	err := c.cc.Invoke(ctx, Msg_SetMsgRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteMsgRetentionPolicies(ctx context.Context, in *DeleteMsgRetentionPoliciesReq, opts ...grpc.CallOption) (*DeleteMsgRetentionPoliciesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMsgRetentionPoliciesResp)
+	err := c.cc.Invoke(ctx, Msg_DeleteMsgRetentionPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetMsgRetentionPolicies(ctx context.Context, in *GetMsgRetentionPoliciesReq, opts ...grpc.CallOption) (*GetMsgRetentionPoliciesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMsgRetentionPoliciesResp)
+	err := c.cc.Invoke(ctx, Msg_GetMsgRetentionPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLegalHold(ctx context.Context, in *SetLegalHoldReq, opts ...grpc.CallOption) (*SetLegalHoldResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLegalHoldResp)
+	err := c.cc.Invoke(ctx, Msg_SetLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveLegalHold(ctx context.Context, in *RemoveLegalHoldReq, opts ...grpc.CallOption) (*RemoveLegalHoldResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveLegalHoldResp)
+	err := c.cc.Invoke(ctx, Msg_RemoveLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetLegalHolds(ctx context.Context, in *GetLegalHoldsReq, opts ...grpc.CallOption) (*GetLegalHoldsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLegalHoldsResp)
+	err := c.cc.Invoke(ctx, Msg_GetLegalHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetLegalHoldUserIDs(ctx context.Context, in *GetLegalHoldUserIDsReq, opts ...grpc.CallOption) (*GetLegalHoldUserIDsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLegalHoldUserIDsResp)
+	err := c.cc.Invoke(ctx, Msg_GetLegalHoldUserIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StartComplianceExport(ctx context.Context, in *StartComplianceExportReq, opts ...grpc.CallOption) (*StartComplianceExportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartComplianceExportResp)
+	err := c.cc.Invoke(ctx, Msg_StartComplianceExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetComplianceExport(ctx context.Context, in *GetComplianceExportReq, opts ...grpc.CallOption) (*GetComplianceExportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetComplianceExportResp)
+	err := c.cc.Invoke(ctx, Msg_GetComplianceExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddFavorite(ctx context.Context, in *AddFavoriteReq, opts ...grpc.CallOption) (*AddFavoriteResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddFavoriteResp)
+	err := c.cc.Invoke(ctx, Msg_AddFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteFavorites(ctx context.Context, in *DeleteFavoritesReq, opts ...grpc.CallOption) (*DeleteFavoritesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFavoritesResp)
+	err := c.cc.Invoke(ctx, Msg_DeleteFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFavorite(ctx context.Context, in *UpdateFavoriteReq, opts ...grpc.CallOption) (*UpdateFavoriteResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFavoriteResp)
+	err := c.cc.Invoke(ctx, Msg_UpdateFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SearchFavorites(ctx context.Context, in *SearchFavoritesReq, opts ...grpc.CallOption) (*SearchFavoritesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchFavoritesResp)
+	err := c.cc.Invoke(ctx, Msg_SearchFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetIncrementalFavorites(ctx context.Context, in *GetIncrementalFavoritesReq, opts ...grpc.CallOption) (*GetIncrementalFavoritesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIncrementalFavoritesResp)
+	err := c.cc.Invoke(ctx, Msg_GetIncrementalFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetFullFavoriteIDs(ctx context.Context, in *GetFullFavoriteIDsReq, opts ...grpc.CallOption) (*GetFullFavoriteIDsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFullFavoriteIDsResp)
+	err := c.cc.Invoke(ctx, Msg_GetFullFavoriteIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForwardMsgs(ctx context.Context, in *ForwardMsgsReq, opts ...grpc.CallOption) (*ForwardMsgsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForwardMsgsResp)
+	err := c.cc.Invoke(ctx, Msg_ForwardMsgs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) GetActiveConversation(ctx context.Context, in *GetActiveConversationReq, opts ...grpc.CallOption) (*GetActiveConversationResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetActiveConversationResp)
@@ -504,6 +727,8 @@ type MsgServer interface {
 	SearchMessage(context.Context, *SearchMessageReq) (*SearchMessageResp, error)
 	// 发送消息
 	SendMsg(context.Context, *SendMsgReq) (*SendMsgResp, error)
+	// 批量发送同一条通知给多个用户
+	SendNotifications(context.Context, *SendNotificationsReq) (*SendNotificationsResp, error)
 	SetUserConversationsMinSeq(context.Context, *SetUserConversationsMinSeqReq) (*SetUserConversationsMinSeqResp, error)
 	// 全量清空指定会话消息 重置min seq 比最大seq大1
 	ClearConversationsMsg(context.Context, *ClearConversationsMsgReq) (*ClearConversationsMsgResp, error)
@@ -530,6 +755,29 @@ type MsgServer interface {
 	GetServerTime(context.Context, *GetServerTimeReq) (*GetServerTimeResp, error)
 	ClearMsg(context.Context, *ClearMsgReq) (*ClearMsgResp, error)
 	DestructMsgs(context.Context, *DestructMsgsReq) (*DestructMsgsResp, error)
+	// 将早于指定时间的完整消息文档归档到对象存储
+	ArchiveMsgs(context.Context, *ArchiveMsgsReq) (*ArchiveMsgsResp, error)
+	// 消息保留策略 按群组、会话类型或用户设置保留天数
+	SetMsgRetentionPolicy(context.Context, *SetMsgRetentionPolicyReq) (*SetMsgRetentionPolicyResp, error)
+	DeleteMsgRetentionPolicies(context.Context, *DeleteMsgRetentionPoliciesReq) (*DeleteMsgRetentionPoliciesResp, error)
+	GetMsgRetentionPolicies(context.Context, *GetMsgRetentionPoliciesReq) (*GetMsgRetentionPoliciesResp, error)
+	// 合规保全 被保全的用户和会话不会被清理
+	SetLegalHold(context.Context, *SetLegalHoldReq) (*SetLegalHoldResp, error)
+	RemoveLegalHold(context.Context, *RemoveLegalHoldReq) (*RemoveLegalHoldResp, error)
+	GetLegalHolds(context.Context, *GetLegalHoldsReq) (*GetLegalHoldsResp, error)
+	GetLegalHoldUserIDs(context.Context, *GetLegalHoldUserIDsReq) (*GetLegalHoldUserIDsResp, error)
+	// 合规导出 导出用户或会话的完整消息记录和附件
+	StartComplianceExport(context.Context, *StartComplianceExportReq) (*StartComplianceExportResp, error)
+	GetComplianceExport(context.Context, *GetComplianceExportReq) (*GetComplianceExportResp, error)
+	// 消息收藏 保存消息快照和附件副本 通过版本日志增量同步
+	AddFavorite(context.Context, *AddFavoriteReq) (*AddFavoriteResp, error)
+	DeleteFavorites(context.Context, *DeleteFavoritesReq) (*DeleteFavoritesResp, error)
+	UpdateFavorite(context.Context, *UpdateFavoriteReq) (*UpdateFavoriteResp, error)
+	SearchFavorites(context.Context, *SearchFavoritesReq) (*SearchFavoritesResp, error)
+	GetIncrementalFavorites(context.Context, *GetIncrementalFavoritesReq) (*GetIncrementalFavoritesResp, error)
+	GetFullFavoriteIDs(context.Context, *GetFullFavoriteIDsReq) (*GetFullFavoriteIDsResp, error)
+	// 服务端转发消息 按目标会话逐个校验发送 保留原发送者和时间
+	ForwardMsgs(context.Context, *ForwardMsgsReq) (*ForwardMsgsResp, error)
 	GetActiveConversation(context.Context, *GetActiveConversationReq) (*GetActiveConversationResp, error)
 	AppendStreamMsg(context.Context, *AppendStreamMsgReq) (*AppendStreamMsgResp, error)
 	GetStreamMsg(context.Context, *GetStreamMsgReq) (*GetStreamMsgResp, error)
@@ -576,6 +824,9 @@ func (UnimplementedMsgServer) SearchMessage(context.Context, *SearchMessageReq)
 func (UnimplementedMsgServer) SendMsg(context.Context, *SendMsgReq) (*SendMsgResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendMsg not implemented")
 }
+func (UnimplementedMsgServer) SendNotifications(context.Context, *SendNotificationsReq) (*SendNotificationsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendNotifications not implemented")
+}
 func (UnimplementedMsgServer) SetUserConversationsMinSeq(context.Context, *SetUserConversationsMinSeqReq) (*SetUserConversationsMinSeqResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetUserConversationsMinSeq not implemented")
 }
@@ -630,6 +881,57 @@ func (UnimplementedMsgServer) ClearMsg(context.Context, *ClearMsgReq) (*ClearMsg
 func (UnimplementedMsgServer) DestructMsgs(context.Context, *DestructMsgsReq) (*DestructMsgsResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method DestructMsgs not implemented")
 }
+func (UnimplementedMsgServer) ArchiveMsgs(context.Context, *ArchiveMsgsReq) (*ArchiveMsgsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveMsgs not implemented")
+}
+func (UnimplementedMsgServer) SetMsgRetentionPolicy(context.Context, *SetMsgRetentionPolicyReq) (*SetMsgRetentionPolicyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMsgRetentionPolicy not implemented")
+}
+func (UnimplementedMsgServer) DeleteMsgRetentionPolicies(context.Context, *DeleteMsgRetentionPoliciesReq) (*DeleteMsgRetentionPoliciesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMsgRetentionPolicies not implemented")
+}
+func (UnimplementedMsgServer) GetMsgRetentionPolicies(context.Context, *GetMsgRetentionPoliciesReq) (*GetMsgRetentionPoliciesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMsgRetentionPolicies not implemented")
+}
+func (UnimplementedMsgServer) SetLegalHold(context.Context, *SetLegalHoldReq) (*SetLegalHoldResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLegalHold not implemented")
+}
+func (UnimplementedMsgServer) RemoveLegalHold(context.Context, *RemoveLegalHoldReq) (*RemoveLegalHoldResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveLegalHold not implemented")
+}
+func (UnimplementedMsgServer) GetLegalHolds(context.Context, *GetLegalHoldsReq) (*GetLegalHoldsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLegalHolds not implemented")
+}
+func (UnimplementedMsgServer) GetLegalHoldUserIDs(context.Context, *GetLegalHoldUserIDsReq) (*GetLegalHoldUserIDsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLegalHoldUserIDs not implemented")
+}
+func (UnimplementedMsgServer) StartComplianceExport(context.Context, *StartComplianceExportReq) (*StartComplianceExportResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartComplianceExport not implemented")
+}
+func (UnimplementedMsgServer) GetComplianceExport(context.Context, *GetComplianceExportReq) (*GetComplianceExportResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetComplianceExport not implemented")
+}
+func (UnimplementedMsgServer) AddFavorite(context.Context, *AddFavoriteReq) (*AddFavoriteResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedMsgServer) DeleteFavorites(context.Context, *DeleteFavoritesReq) (*DeleteFavoritesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFavorites not implemented")
+}
+func (UnimplementedMsgServer) UpdateFavorite(context.Context, *UpdateFavoriteReq) (*UpdateFavoriteResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFavorite not implemented")
+}
+func (UnimplementedMsgServer) SearchFavorites(context.Context, *SearchFavoritesReq) (*SearchFavoritesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchFavorites not implemented")
+}
+func (UnimplementedMsgServer) GetIncrementalFavorites(context.Context, *GetIncrementalFavoritesReq) (*GetIncrementalFavoritesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIncrementalFavorites not implemented")
+}
+func (UnimplementedMsgServer) GetFullFavoriteIDs(context.Context, *GetFullFavoriteIDsReq) (*GetFullFavoriteIDsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFullFavoriteIDs not implemented")
+}
+func (UnimplementedMsgServer) ForwardMsgs(context.Context, *ForwardMsgsReq) (*ForwardMsgsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForwardMsgs not implemented")
+}
 func (UnimplementedMsgServer) GetActiveConversation(context.Context, *GetActiveConversationReq) (*GetActiveConversationResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetActiveConversation not implemented")
 }
@@ -837,6 +1139,24 @@ func _Msg_SendMsg_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SendNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNotificationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendNotifications(ctx, req.(*SendNotificationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetUserConversationsMinSeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetUserConversationsMinSeqReq)
 	if err := dec(in); err != nil {
@@ -1161,6 +1481,312 @@ func _Msg_DestructMsgs_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ArchiveMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ArchiveMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ArchiveMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArchiveMsgs(ctx, req.(*ArchiveMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMsgRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMsgRetentionPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMsgRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMsgRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMsgRetentionPolicy(ctx, req.(*SetMsgRetentionPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteMsgRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMsgRetentionPoliciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteMsgRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteMsgRetentionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteMsgRetentionPolicies(ctx, req.(*DeleteMsgRetentionPoliciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GetMsgRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgRetentionPoliciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetMsgRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GetMsgRetentionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetMsgRetentionPolicies(ctx, req.(*GetMsgRetentionPoliciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLegalHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLegalHold(ctx, req.(*SetLegalHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLegalHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveLegalHold(ctx, req.(*RemoveLegalHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GetLegalHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLegalHoldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetLegalHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GetLegalHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetLegalHolds(ctx, req.(*GetLegalHoldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GetLegalHoldUserIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLegalHoldUserIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetLegalHoldUserIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GetLegalHoldUserIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetLegalHoldUserIDs(ctx, req.(*GetLegalHoldUserIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StartComplianceExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartComplianceExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StartComplianceExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StartComplianceExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartComplianceExport(ctx, req.(*StartComplianceExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GetComplianceExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComplianceExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetComplianceExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GetComplianceExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetComplianceExport(ctx, req.(*GetComplianceExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFavorite(ctx, req.(*AddFavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFavoritesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteFavorites(ctx, req.(*DeleteFavoritesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFavorite(ctx, req.(*UpdateFavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SearchFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFavoritesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SearchFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SearchFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SearchFavorites(ctx, req.(*SearchFavoritesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GetIncrementalFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncrementalFavoritesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetIncrementalFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GetIncrementalFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetIncrementalFavorites(ctx, req.(*GetIncrementalFavoritesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GetFullFavoriteIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFullFavoriteIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetFullFavoriteIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GetFullFavoriteIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetFullFavoriteIDs(ctx, req.(*GetFullFavoriteIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForwardMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForwardMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForwardMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForwardMsgs(ctx, req.(*ForwardMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_GetActiveConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetActiveConversationReq)
 	if err := dec(in); err != nil {
@@ -1348,6 +1974,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMsg",
 			Handler:    _Msg_SendMsg_Handler,
 		},
+		{
+			MethodName: "SendNotifications",
+			Handler:    _Msg_SendNotifications_Handler,
+		},
 		{
 			MethodName: "SetUserConversationsMinSeq",
 			Handler:    _Msg_SetUserConversationsMinSeq_Handler,
@@ -1420,6 +2050,74 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestructMsgs",
 			Handler:    _Msg_DestructMsgs_Handler,
 		},
+		{
+			MethodName: "ArchiveMsgs",
+			Handler:    _Msg_ArchiveMsgs_Handler,
+		},
+		{
+			MethodName: "SetMsgRetentionPolicy",
+			Handler:    _Msg_SetMsgRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteMsgRetentionPolicies",
+			Handler:    _Msg_DeleteMsgRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "GetMsgRetentionPolicies",
+			Handler:    _Msg_GetMsgRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "SetLegalHold",
+			Handler:    _Msg_SetLegalHold_Handler,
+		},
+		{
+			MethodName: "RemoveLegalHold",
+			Handler:    _Msg_RemoveLegalHold_Handler,
+		},
+		{
+			MethodName: "GetLegalHolds",
+			Handler:    _Msg_GetLegalHolds_Handler,
+		},
+		{
+			MethodName: "GetLegalHoldUserIDs",
+			Handler:    _Msg_GetLegalHoldUserIDs_Handler,
+		},
+		{
+			MethodName: "StartComplianceExport",
+			Handler:    _Msg_StartComplianceExport_Handler,
+		},
+		{
+			MethodName: "GetComplianceExport",
+			Handler:    _Msg_GetComplianceExport_Handler,
+		},
+		{
+			MethodName: "AddFavorite",
+			Handler:    _Msg_AddFavorite_Handler,
+		},
+		{
+			MethodName: "DeleteFavorites",
+			Handler:    _Msg_DeleteFavorites_Handler,
+		},
+		{
+			MethodName: "UpdateFavorite",
+			Handler:    _Msg_UpdateFavorite_Handler,
+		},
+		{
+			MethodName: "SearchFavorites",
+			Handler:    _Msg_SearchFavorites_Handler,
+		},
+		{
+			MethodName: "GetIncrementalFavorites",
+			Handler:    _Msg_GetIncrementalFavorites_Handler,
+		},
+		{
+			MethodName: "GetFullFavoriteIDs",
+			Handler:    _Msg_GetFullFavoriteIDs_Handler,
+		},
+		{
+			MethodName: "ForwardMsgs",
+			Handler:    _Msg_ForwardMsgs_Handler,
+		},
 		{
 			MethodName: "GetActiveConversation",
 			Handler:    _Msg_GetActiveConversation_Handler,
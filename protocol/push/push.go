@@ -38,3 +38,13 @@ func (x *DelUserPushTokenReq) Check() error {
 	}
 	return nil
 }
+
+func (x *PushUnackedOfflineReq) Check() error {
+	if x.MsgData == nil {
+		return errors.New("MsgData is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("UserIDs is empty")
+	}
+	return nil
+}
@@ -221,6 +221,97 @@ func (*DelUserPushTokenResp) Descriptor() ([]byte, []int) {
 	return file_push_push_proto_rawDescGZIP(), []int{3}
 }
 
+// PushUnackedOfflineReq asks for the offline push of a message the gateway counted as reached,
+// which no client of the users in ack mode acknowledged.
+type PushUnackedOfflineReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgData *sdkws.MsgData `protobuf:"bytes,1,opt,name=msgData,proto3" json:"msgData"`
+	UserIDs []string       `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *PushUnackedOfflineReq) Reset() {
+	*x = PushUnackedOfflineReq{}
+	mi := &file_push_push_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushUnackedOfflineReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushUnackedOfflineReq) ProtoMessage() {}
+
+func (x *PushUnackedOfflineReq) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushUnackedOfflineReq.ProtoReflect.Descriptor instead.
+func (*PushUnackedOfflineReq) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PushUnackedOfflineReq) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *PushUnackedOfflineReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type PushUnackedOfflineResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushUnackedOfflineResp) Reset() {
+	*x = PushUnackedOfflineResp{}
+	mi := &file_push_push_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushUnackedOfflineResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushUnackedOfflineResp) ProtoMessage() {}
+
+func (x *PushUnackedOfflineResp) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushUnackedOfflineResp.ProtoReflect.Descriptor instead.
+func (*PushUnackedOfflineResp) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{5}
+}
+
 var File_push_push_proto protoreflect.FileDescriptor
 
 var file_push_push_proto_rawDesc = []byte{
@@ -242,20 +333,34 @@ var file_push_push_proto_rawDesc = []byte{
 	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44,
 	0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x32, 0xa7, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x73,
-	0x68, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x50,
-	0x75, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x12, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a,
-	0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75,
-	0x73, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x10, 0x44, 0x65, 0x6c,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x2e,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x62, 0x0a, 0x15, 0x50, 0x75, 0x73, 0x68,
+	0x55, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x18, 0x0a, 0x16,
+	0x50, 0x75, 0x73, 0x68, 0x55, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x32, 0x86, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x4d,
+	0x73, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x50, 0x75, 0x73,
+	0x68, 0x4d, 0x73, 0x67, 0x12, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e,
 	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x44, 0x65,
-	0x6c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5d, 0x0a, 0x12, 0x50, 0x75, 0x73, 0x68, 0x55, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x4f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x55, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x55, 0x6e, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42,
+	0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x70, 0x75, 0x73, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -270,25 +375,30 @@ func file_push_push_proto_rawDescGZIP() []byte {
 	return file_push_push_proto_rawDescData
 }
 
-var file_push_push_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_push_push_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_push_push_proto_goTypes = []any{
-	(*PushMsgReq)(nil),           // 0: openim.push.PushMsgReq
-	(*PushMsgResp)(nil),          // 1: openim.push.PushMsgResp
-	(*DelUserPushTokenReq)(nil),  // 2: openim.push.DelUserPushTokenReq
-	(*DelUserPushTokenResp)(nil), // 3: openim.push.DelUserPushTokenResp
-	(*sdkws.MsgData)(nil),        // 4: openim.sdkws.MsgData
+	(*PushMsgReq)(nil),             // 0: openim.push.PushMsgReq
+	(*PushMsgResp)(nil),            // 1: openim.push.PushMsgResp
+	(*DelUserPushTokenReq)(nil),    // 2: openim.push.DelUserPushTokenReq
+	(*DelUserPushTokenResp)(nil),   // 3: openim.push.DelUserPushTokenResp
+	(*PushUnackedOfflineReq)(nil),  // 4: openim.push.PushUnackedOfflineReq
+	(*PushUnackedOfflineResp)(nil), // 5: openim.push.PushUnackedOfflineResp
+	(*sdkws.MsgData)(nil),          // 6: openim.sdkws.MsgData
 }
 var file_push_push_proto_depIdxs = []int32{
-	4, // 0: openim.push.PushMsgReq.msgData:type_name -> openim.sdkws.MsgData
-	0, // 1: openim.push.PushMsgService.PushMsg:input_type -> openim.push.PushMsgReq
-	2, // 2: openim.push.PushMsgService.DelUserPushToken:input_type -> openim.push.DelUserPushTokenReq
-	1, // 3: openim.push.PushMsgService.PushMsg:output_type -> openim.push.PushMsgResp
-	3, // 4: openim.push.PushMsgService.DelUserPushToken:output_type -> openim.push.DelUserPushTokenResp
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: openim.push.PushMsgReq.msgData:type_name -> openim.sdkws.MsgData
+	6, // 1: openim.push.PushUnackedOfflineReq.msgData:type_name -> openim.sdkws.MsgData
+	0, // 2: openim.push.PushMsgService.PushMsg:input_type -> openim.push.PushMsgReq
+	2, // 3: openim.push.PushMsgService.DelUserPushToken:input_type -> openim.push.DelUserPushTokenReq
+	4, // 4: openim.push.PushMsgService.PushUnackedOffline:input_type -> openim.push.PushUnackedOfflineReq
+	1, // 5: openim.push.PushMsgService.PushMsg:output_type -> openim.push.PushMsgResp
+	3, // 6: openim.push.PushMsgService.DelUserPushToken:output_type -> openim.push.DelUserPushTokenResp
+	5, // 7: openim.push.PushMsgService.PushUnackedOffline:output_type -> openim.push.PushUnackedOfflineResp
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_push_push_proto_init() }
@@ -302,7 +412,7 @@ func file_push_push_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_push_push_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -42,6 +42,7 @@ const (
 	Friend_AddBlack_FullMethodName                       = "/openim.relation.friend/addBlack"
 	Friend_RemoveBlack_FullMethodName                    = "/openim.relation.friend/removeBlack"
 	Friend_IsFriend_FullMethodName                       = "/openim.relation.friend/isFriend"
+	Friend_GetReversalFriends_FullMethodName             = "/openim.relation.friend/getReversalFriends"
 	Friend_IsBlack_FullMethodName                        = "/openim.relation.friend/isBlack"
 	Friend_GetPaginationBlacks_FullMethodName            = "/openim.relation.friend/getPaginationBlacks"
 	Friend_GetSpecifiedBlacks_FullMethodName             = "/openim.relation.friend/GetSpecifiedBlacks"
@@ -83,6 +84,8 @@ type FriendClient interface {
 	RemoveBlack(ctx context.Context, in *RemoveBlackReq, opts ...grpc.CallOption) (*RemoveBlackResp, error)
 	// Check user is in friends list
 	IsFriend(ctx context.Context, in *IsFriendReq, opts ...grpc.CallOption) (*IsFriendResp, error)
+	// Get which of the users have the user in their friend list
+	GetReversalFriends(ctx context.Context, in *GetReversalFriendsReq, opts ...grpc.CallOption) (*GetReversalFriendsResp, error)
 	// Check user is blacklist
 	IsBlack(ctx context.Context, in *IsBlackReq, opts ...grpc.CallOption) (*IsBlackResp, error)
 	// Get blacklist
@@ -214,6 +217,16 @@ func (c *friendClient) IsFriend(ctx context.Context, in *IsFriendReq, opts ...gr
 	return out, nil
 }
 
+func (c *friendClient) GetReversalFriends(ctx context.Context, in *GetReversalFriendsReq, opts ...grpc.CallOption) (*GetReversalFriendsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReversalFriendsResp)
+	err := c.cc.Invoke(ctx, Friend_GetReversalFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *friendClient) IsBlack(ctx context.Context, in *IsBlackReq, opts ...grpc.CallOption) (*IsBlackResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IsBlackResp)
@@ -406,6 +419,8 @@ type FriendServer interface {
 	RemoveBlack(context.Context, *RemoveBlackReq) (*RemoveBlackResp, error)
 	// Check user is in friends list
 	IsFriend(context.Context, *IsFriendReq) (*IsFriendResp, error)
+	// Get which of the users have the user in their friend list
+	GetReversalFriends(context.Context, *GetReversalFriendsReq) (*GetReversalFriendsResp, error)
 	// Check user is blacklist
 	IsBlack(context.Context, *IsBlackReq) (*IsBlackResp, error)
 	// Get blacklist
@@ -474,6 +489,9 @@ func (UnimplementedFriendServer) RemoveBlack(context.Context, *RemoveBlackReq) (
 func (UnimplementedFriendServer) IsFriend(context.Context, *IsFriendReq) (*IsFriendResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method IsFriend not implemented")
 }
+func (UnimplementedFriendServer) GetReversalFriends(context.Context, *GetReversalFriendsReq) (*GetReversalFriendsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReversalFriends not implemented")
+}
 func (UnimplementedFriendServer) IsBlack(context.Context, *IsBlackReq) (*IsBlackResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method IsBlack not implemented")
 }
@@ -708,6 +726,24 @@ func _Friend_IsFriend_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Friend_GetReversalFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReversalFriendsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).GetReversalFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_GetReversalFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).GetReversalFriends(ctx, req.(*GetReversalFriendsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Friend_IsBlack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IsBlackReq)
 	if err := dec(in); err != nil {
@@ -1057,6 +1093,10 @@ var Friend_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "isFriend",
 			Handler:    _Friend_IsFriend_Handler,
 		},
+		{
+			MethodName: "getReversalFriends",
+			Handler:    _Friend_GetReversalFriends_Handler,
+		},
 		{
 			MethodName: "isBlack",
 			Handler:    _Friend_IsBlack_Handler,
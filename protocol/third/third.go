@@ -69,3 +69,20 @@ func (x *DeleteOutdatedDataReq) Check() error {
 	}
 	return nil
 }
+
+func (x *CopyObjectReq) Check() error {
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	if x.NewName == "" {
+		return errors.New("newName is empty")
+	}
+	return nil
+}
+
+func (x *DeleteObjectsReq) Check() error {
+	if len(x.Names) == 0 {
+		return errors.New("names is empty")
+	}
+	return nil
+}
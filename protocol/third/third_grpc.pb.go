@@ -42,6 +42,8 @@ const (
 	Third_InitiateFormData_FullMethodName        = "/openim.third.third/InitiateFormData"
 	Third_CompleteFormData_FullMethodName        = "/openim.third.third/CompleteFormData"
 	Third_DeleteOutdatedData_FullMethodName      = "/openim.third.third/DeleteOutdatedData"
+	Third_CopyObject_FullMethodName              = "/openim.third.third/CopyObject"
+	Third_DeleteObjects_FullMethodName           = "/openim.third.third/DeleteObjects"
 	Third_FcmUpdateToken_FullMethodName          = "/openim.third.third/FcmUpdateToken"
 	Third_SetAppBadge_FullMethodName             = "/openim.third.third/SetAppBadge"
 	Third_UploadLogs_FullMethodName              = "/openim.third.third/UploadLogs"
@@ -62,6 +64,9 @@ type ThirdClient interface {
 	InitiateFormData(ctx context.Context, in *InitiateFormDataReq, opts ...grpc.CallOption) (*InitiateFormDataResp, error)
 	CompleteFormData(ctx context.Context, in *CompleteFormDataReq, opts ...grpc.CallOption) (*CompleteFormDataResp, error)
 	DeleteOutdatedData(ctx context.Context, in *DeleteOutdatedDataReq, opts ...grpc.CallOption) (*DeleteOutdatedDataResp, error)
+	// copy an object under a new name pointing to the same stored file, and delete such copies
+	CopyObject(ctx context.Context, in *CopyObjectReq, opts ...grpc.CallOption) (*CopyObjectResp, error)
+	DeleteObjects(ctx context.Context, in *DeleteObjectsReq, opts ...grpc.CallOption) (*DeleteObjectsResp, error)
 	FcmUpdateToken(ctx context.Context, in *FcmUpdateTokenReq, opts ...grpc.CallOption) (*FcmUpdateTokenResp, error)
 	SetAppBadge(ctx context.Context, in *SetAppBadgeReq, opts ...grpc.CallOption) (*SetAppBadgeResp, error)
 	// Logs
@@ -168,6 +173,26 @@ func (c *thirdClient) DeleteOutdatedData(ctx context.Context, in *DeleteOutdated
 	return out, nil
 }
 
+func (c *thirdClient) CopyObject(ctx context.Context, in *CopyObjectReq, opts ...grpc.CallOption) (*CopyObjectResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyObjectResp)
+	err := c.cc.Invoke(ctx, Third_CopyObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdClient) DeleteObjects(ctx context.Context, in *DeleteObjectsReq, opts ...grpc.CallOption) (*DeleteObjectsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteObjectsResp)
+	err := c.cc.Invoke(ctx, Third_DeleteObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thirdClient) FcmUpdateToken(ctx context.Context, in *FcmUpdateTokenReq, opts ...grpc.CallOption) (*FcmUpdateTokenResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FcmUpdateTokenResp)
@@ -231,6 +256,9 @@ type ThirdServer interface {
 	InitiateFormData(context.Context, *InitiateFormDataReq) (*InitiateFormDataResp, error)
 	CompleteFormData(context.Context, *CompleteFormDataReq) (*CompleteFormDataResp, error)
 	DeleteOutdatedData(context.Context, *DeleteOutdatedDataReq) (*DeleteOutdatedDataResp, error)
+	// copy an object under a new name pointing to the same stored file, and delete such copies
+	CopyObject(context.Context, *CopyObjectReq) (*CopyObjectResp, error)
+	DeleteObjects(context.Context, *DeleteObjectsReq) (*DeleteObjectsResp, error)
 	FcmUpdateToken(context.Context, *FcmUpdateTokenReq) (*FcmUpdateTokenResp, error)
 	SetAppBadge(context.Context, *SetAppBadgeReq) (*SetAppBadgeResp, error)
 	// Logs
@@ -274,6 +302,12 @@ func (UnimplementedThirdServer) CompleteFormData(context.Context, *CompleteFormD
 func (UnimplementedThirdServer) DeleteOutdatedData(context.Context, *DeleteOutdatedDataReq) (*DeleteOutdatedDataResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteOutdatedData not implemented")
 }
+func (UnimplementedThirdServer) CopyObject(context.Context, *CopyObjectReq) (*CopyObjectResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CopyObject not implemented")
+}
+func (UnimplementedThirdServer) DeleteObjects(context.Context, *DeleteObjectsReq) (*DeleteObjectsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteObjects not implemented")
+}
 func (UnimplementedThirdServer) FcmUpdateToken(context.Context, *FcmUpdateTokenReq) (*FcmUpdateTokenResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method FcmUpdateToken not implemented")
 }
@@ -472,6 +506,42 @@ func _Third_DeleteOutdatedData_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Third_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyObjectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdServer).CopyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Third_CopyObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdServer).CopyObject(ctx, req.(*CopyObjectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Third_DeleteObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdServer).DeleteObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Third_DeleteObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdServer).DeleteObjects(ctx, req.(*DeleteObjectsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Third_FcmUpdateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FcmUpdateTokenReq)
 	if err := dec(in); err != nil {
@@ -605,6 +675,14 @@ var Third_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOutdatedData",
 			Handler:    _Third_DeleteOutdatedData_Handler,
 		},
+		{
+			MethodName: "CopyObject",
+			Handler:    _Third_CopyObject_Handler,
+		},
+		{
+			MethodName: "DeleteObjects",
+			Handler:    _Third_DeleteObjects_Handler,
+		},
 		{
 			MethodName: "FcmUpdateToken",
 			Handler:    _Third_FcmUpdateToken_Handler,
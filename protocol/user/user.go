@@ -65,6 +65,16 @@ func (x *SetGlobalRecvMessageOptReq) Check() error {
 	return nil
 }
 
+func (x *SetLastSeenPrivacyReq) Check() error {
+	if x.LastSeenPrivacy > 2 || x.LastSeenPrivacy < 0 {
+		return errors.New("LastSeenPrivacy is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("UserID is empty")
+	}
+	return nil
+}
+
 func (x *SetConversationReq) Check() error {
 	if err := x.Conversation.Check(); err != nil {
 		return err
@@ -38,6 +38,8 @@ const (
 	User_UpdateUserInfoEx_FullMethodName              = "/openim.user.user/updateUserInfoEx"
 	User_SetGlobalRecvMessageOpt_FullMethodName       = "/openim.user.user/setGlobalRecvMessageOpt"
 	User_GetGlobalRecvMessageOpt_FullMethodName       = "/openim.user.user/getGlobalRecvMessageOpt"
+	User_SetLastSeenPrivacy_FullMethodName            = "/openim.user.user/setLastSeenPrivacy"
+	User_GetLastSeenPrivacy_FullMethodName            = "/openim.user.user/getLastSeenPrivacy"
 	User_AccountCheck_FullMethodName                  = "/openim.user.user/accountCheck"
 	User_GetPaginationUsers_FullMethodName            = "/openim.user.user/getPaginationUsers"
 	User_UserRegister_FullMethodName                  = "/openim.user.user/userRegister"
@@ -74,6 +76,10 @@ type UserClient interface {
 	SetGlobalRecvMessageOpt(ctx context.Context, in *SetGlobalRecvMessageOptReq, opts ...grpc.CallOption) (*SetGlobalRecvMessageOptResp, error)
 	// Get the user message receiving option If not found, no error will be returned
 	GetGlobalRecvMessageOpt(ctx context.Context, in *GetGlobalRecvMessageOptReq, opts ...grpc.CallOption) (*GetGlobalRecvMessageOptResp, error)
+	// set who may see the user's last seen time
+	SetLastSeenPrivacy(ctx context.Context, in *SetLastSeenPrivacyReq, opts ...grpc.CallOption) (*SetLastSeenPrivacyResp, error)
+	// get who may see the user's last seen time
+	GetLastSeenPrivacy(ctx context.Context, in *GetLastSeenPrivacyReq, opts ...grpc.CallOption) (*GetLastSeenPrivacyResp, error)
 	// Check if userID exists
 	AccountCheck(ctx context.Context, in *AccountCheckReq, opts ...grpc.CallOption) (*AccountCheckResp, error)
 	// Turn the page (or specify userID, nickname) to pull user information Full field
@@ -174,6 +180,26 @@ func (c *userClient) GetGlobalRecvMessageOpt(ctx context.Context, in *GetGlobalR
 	return out, nil
 }
 
+func (c *userClient) SetLastSeenPrivacy(ctx context.Context, in *SetLastSeenPrivacyReq, opts ...grpc.CallOption) (*SetLastSeenPrivacyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLastSeenPrivacyResp)
+	err := c.cc.Invoke(ctx, User_SetLastSeenPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetLastSeenPrivacy(ctx context.Context, in *GetLastSeenPrivacyReq, opts ...grpc.CallOption) (*GetLastSeenPrivacyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLastSeenPrivacyResp)
+	err := c.cc.Invoke(ctx, User_GetLastSeenPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) AccountCheck(ctx context.Context, in *AccountCheckReq, opts ...grpc.CallOption) (*AccountCheckResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AccountCheckResp)
@@ -397,6 +423,10 @@ type UserServer interface {
 	SetGlobalRecvMessageOpt(context.Context, *SetGlobalRecvMessageOptReq) (*SetGlobalRecvMessageOptResp, error)
 	// Get the user message receiving option If not found, no error will be returned
 	GetGlobalRecvMessageOpt(context.Context, *GetGlobalRecvMessageOptReq) (*GetGlobalRecvMessageOptResp, error)
+	// set who may see the user's last seen time
+	SetLastSeenPrivacy(context.Context, *SetLastSeenPrivacyReq) (*SetLastSeenPrivacyResp, error)
+	// get who may see the user's last seen time
+	GetLastSeenPrivacy(context.Context, *GetLastSeenPrivacyReq) (*GetLastSeenPrivacyResp, error)
 	// Check if userID exists
 	AccountCheck(context.Context, *AccountCheckReq) (*AccountCheckResp, error)
 	// Turn the page (or specify userID, nickname) to pull user information Full field
@@ -462,6 +492,12 @@ func (UnimplementedUserServer) SetGlobalRecvMessageOpt(context.Context, *SetGlob
 func (UnimplementedUserServer) GetGlobalRecvMessageOpt(context.Context, *GetGlobalRecvMessageOptReq) (*GetGlobalRecvMessageOptResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetGlobalRecvMessageOpt not implemented")
 }
+func (UnimplementedUserServer) SetLastSeenPrivacy(context.Context, *SetLastSeenPrivacyReq) (*SetLastSeenPrivacyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLastSeenPrivacy not implemented")
+}
+func (UnimplementedUserServer) GetLastSeenPrivacy(context.Context, *GetLastSeenPrivacyReq) (*GetLastSeenPrivacyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLastSeenPrivacy not implemented")
+}
 func (UnimplementedUserServer) AccountCheck(context.Context, *AccountCheckReq) (*AccountCheckResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method AccountCheck not implemented")
 }
@@ -636,6 +672,42 @@ func _User_GetGlobalRecvMessageOpt_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_SetLastSeenPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLastSeenPrivacyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetLastSeenPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetLastSeenPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetLastSeenPrivacy(ctx, req.(*SetLastSeenPrivacyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetLastSeenPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastSeenPrivacyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetLastSeenPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetLastSeenPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetLastSeenPrivacy(ctx, req.(*GetLastSeenPrivacyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_AccountCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccountCheckReq)
 	if err := dec(in); err != nil {
@@ -1041,6 +1113,14 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getGlobalRecvMessageOpt",
 			Handler:    _User_GetGlobalRecvMessageOpt_Handler,
 		},
+		{
+			MethodName: "setLastSeenPrivacy",
+			Handler:    _User_SetLastSeenPrivacy_Handler,
+		},
+		{
+			MethodName: "getLastSeenPrivacy",
+			Handler:    _User_GetLastSeenPrivacy_Handler,
+		},
 		{
 			MethodName: "accountCheck",
 			Handler:    _User_AccountCheck_Handler,
@@ -18,9 +18,11 @@ require golang.org/x/net v0.29.0 // indirect
 
 require (
 	github.com/google/go-cmp v0.6.0
+	github.com/mattn/go-sqlite3 v1.14.22
 	github.com/openimsdk/protocol v0.0.72-alpha.70
 	github.com/openimsdk/tools v0.0.50-alpha.21
 	github.com/patrickmn/go-cache v2.1.0+incompatible
+	golang.org/x/crypto v0.27.0
 	golang.org/x/image v0.15.0
 	golang.org/x/sync v0.8.0
 	gorm.io/gorm v1.25.10
@@ -44,7 +46,6 @@ require (
 	github.com/leodido/go-urn v1.2.4 // indirect
 	github.com/lestrrat-go/strftime v1.0.6 // indirect
 	github.com/mattn/go-isatty v0.0.19 // indirect
-	github.com/mattn/go-sqlite3 v1.14.22 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/modern-go/reflect2 v1.0.2 // indirect
 	github.com/pelletier/go-toml/v2 v2.0.8 // indirect
@@ -54,7 +55,6 @@ require (
 	go.uber.org/multierr v1.6.0 // indirect
 	go.uber.org/zap v1.24.0 // indirect
 	golang.org/x/arch v0.3.0 // indirect
-	golang.org/x/crypto v0.27.0 // indirect
 	golang.org/x/sys v0.25.0 // indirect
 	golang.org/x/text v0.18.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240903143218-8af14fe29dc1 // indirect
@@ -65,3 +65,6 @@ require (
 replace nhooyr.io/websocket => github.com/coder/websocket v1.8.10
 
 replace github.com/openimsdk/protocol => ../protocol
+
+// SQLCipher build of the sqlite driver with the same API, used for local database encryption
+replace github.com/mattn/go-sqlite3 => github.com/mutecomm/go-sqlcipher/v4 v4.4.2
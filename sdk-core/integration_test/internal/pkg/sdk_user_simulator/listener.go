@@ -49,6 +49,10 @@ func (c *conversationCallBack) OnConversationUserInputStatusChanged(change strin
 
 }
 
+func (c *conversationCallBack) OnConversationFoldersChanged(folderList string) {
+
+}
+
 type userCallback struct {
 }
 
@@ -67,6 +71,15 @@ func (userCallback) OnUserCommandUpdate(callbackData string) {
 }
 func (userCallback) OnUserCommandDelete(callbackData string) {
 
+}
+func (userCallback) OnFavoriteAdded(callbackData string) {
+
+}
+func (userCallback) OnFavoriteDeleted(callbackData string) {
+
+}
+func (userCallback) OnFavoriteUpdated(callbackData string) {
+
 }
 
 type SingleMessage struct {
@@ -226,6 +239,14 @@ func (testGroupListener) OnGroupApplicationRejected(callbackInfo string) {
 
 }
 
+func (testGroupListener) OnGroupAnnouncementRemind(callbackInfo string) {
+
+}
+
+func (testGroupListener) OnGroupAnnouncementConfirmed(callbackInfo string) {
+
+}
+
 func (testGroupListener) OnGroupDismissed(callbackInfo string) {
 
 }
@@ -345,7 +345,13 @@ func (c *Conversation) SendMessage(ctx context.Context, s *sdk_struct.MsgStruct,
 			return nil, err
 		}
 		s.PictureElem.SourcePicture.Url = res.URL
+		s.PictureElem.SourcePicture.MD5 = res.MD5
 		s.PictureElem.BigPicture = s.PictureElem.SourcePicture
+		c.storeProcessedFile(ctx, &s.PictureElem.SourcePath, res.URL, s.PictureElem.SourcePicture.UUID)
+		if c.uploadPictureSnapshot(ctx, s) {
+			s.Content = utils.StructToJsonString(s.PictureElem)
+			break
+		}
 		u, err := url.Parse(res.URL)
 		if err == nil {
 			snapshot := u.Query()
@@ -390,6 +396,7 @@ func (c *Conversation) SendMessage(ctx context.Context, s *sdk_struct.MsgStruct,
 			return nil, err
 		}
 		s.SoundElem.SourceURL = res.URL
+		s.SoundElem.MD5 = res.MD5
 		s.Content = utils.StructToJsonString(s.SoundElem)
 	case constant.Video:
 		if s.Status == constant.MsgStatusSendSuccess {
@@ -428,6 +435,7 @@ func (c *Conversation) SendMessage(ctx context.Context, s *sdk_struct.MsgStruct,
 				return
 			}
 			s.VideoElem.SnapshotURL = snapRes.URL
+			s.VideoElem.SnapshotMD5 = snapRes.MD5
 		}()
 
 		go func() {
@@ -446,12 +454,14 @@ func (c *Conversation) SendMessage(ctx context.Context, s *sdk_struct.MsgStruct,
 			}
 			if res != nil {
 				s.VideoElem.VideoURL = res.URL
+				s.VideoElem.VideoMD5 = res.MD5
 			}
 		}()
 		wg.Wait()
 		if err := putErrs; err != nil {
 			return nil, err
 		}
+		c.storeProcessedFile(ctx, &s.VideoElem.SnapshotPath, s.VideoElem.SnapshotURL, s.VideoElem.SnapshotUUID)
 		s.Content = utils.StructToJsonString(s.VideoElem)
 	case constant.File:
 		if s.Status == constant.MsgStatusSendSuccess {
@@ -488,6 +498,7 @@ func (c *Conversation) SendMessage(ctx context.Context, s *sdk_struct.MsgStruct,
 			return nil, err
 		}
 		s.FileElem.SourceURL = res.URL
+		s.FileElem.MD5 = res.MD5
 		s.Content = utils.StructToJsonString(s.FileElem)
 	case constant.Text:
 		s.Content = utils.StructToJsonString(s.TextElem)
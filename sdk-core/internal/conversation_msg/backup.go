@@ -0,0 +1,358 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/internal/third"
+	"github.com/openimsdk/openim-sdk-core/v3/internal/third/file"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/backup"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/common"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	sdk "github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/version"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	backupMessageBatch = 500
+	backupReadBuffer   = 1 << 20
+)
+
+// ExportLocalBackup writes the local conversations, drafts, read cursors and messages to an
+// encrypted backup file. Progress counts the exported conversations, then the uploaded bytes.
+func (c *Conversation) ExportLocalBackup(ctx context.Context, req *sdk.ExportLocalBackupParams, progress third.Progress) (*sdk.ExportLocalBackupResp, error) {
+	if !c.backupMutex.TryLock() {
+		return nil, errs.New("local backup is in progress").Wrap()
+	}
+	defer c.backupMutex.Unlock()
+	resp := &sdk.ExportLocalBackupResp{FilePath: req.FilePath}
+	if resp.FilePath == "" {
+		resp.FilePath = filepath.Join(c.DataDir, fmt.Sprintf("OpenIM_backup_%s_%d.oimbak", c.loginUserID, time.Now().UnixMilli()))
+	}
+	conversations, err := c.db.GetAllConversations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tmpPath := resp.FilePath + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "create backup file failed")
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(tmpPath)
+	}()
+	w, err := backup.NewWriter(f, req.Password, &backup.Manifest{UserID: c.loginUserID, SDKVersion: version.Version, CreateTime: time.Now().UnixMilli()})
+	if err != nil {
+		return nil, err
+	}
+	progress.OnProgress(0, int64(len(conversations)))
+	for i, conversation := range conversations {
+		count, err := c.exportConversation(ctx, w, conversation)
+		if err != nil {
+			return nil, err
+		}
+		resp.MessageCount += count
+		progress.OnProgress(int64(i+1), int64(len(conversations)))
+	}
+	resp.ConversationCount = len(conversations)
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, errs.WrapMsg(err, "close backup file failed")
+	}
+	if err := os.Rename(tmpPath, resp.FilePath); err != nil {
+		return nil, errs.WrapMsg(err, "rename backup file failed")
+	}
+	log.ZInfo(ctx, "local backup exported", "filePath", resp.FilePath, "conversations", resp.ConversationCount, "messages", resp.MessageCount)
+	if req.Upload {
+		uploaded, err := c.file.UploadFile(ctx, &file.UploadFileReq{
+			Filepath:    resp.FilePath,
+			Name:        "backup/" + filepath.Base(resp.FilePath),
+			ContentType: "application/octet-stream",
+			Cause:       "backup",
+		}, third.NewUploadProgress(ctx, progress))
+		if err != nil {
+			return nil, err
+		}
+		resp.URL = uploaded.URL
+	}
+	return resp, nil
+}
+
+func (c *Conversation) exportConversation(ctx context.Context, w *backup.Writer, conversation *model_struct.LocalConversation) (int, error) {
+	conversationID := conversation.ConversationID
+	if err := w.Write(&backup.Record{Type: backup.RecordConversation, Conversation: conversation}); err != nil {
+		return 0, err
+	}
+	cursors, err := c.db.GetReadCursorsByConversationID(ctx, conversationID)
+	if err != nil {
+		return 0, err
+	}
+	if len(cursors) > 0 {
+		if err := w.Write(&backup.Record{Type: backup.RecordReadCursors, ConversationID: conversationID, ReadCursors: cursors}); err != nil {
+			return 0, err
+		}
+	}
+	state, err := c.db.GetReadState(ctx, conversationID)
+	if err != nil && !isRecordNotFoundError(err) {
+		return 0, err
+	}
+	if err == nil {
+		if err := w.Write(&backup.Record{Type: backup.RecordReadState, ConversationID: conversationID, ReadState: state}); err != nil {
+			return 0, err
+		}
+	}
+	var (
+		count       int
+		sendTime    int64
+		clientMsgID string
+	)
+	for {
+		msgs, err := c.db.GetMessagesAfter(ctx, conversationID, sendTime, clientMsgID, backupMessageBatch)
+		if err != nil {
+			return 0, err
+		}
+		if len(msgs) == 0 {
+			return count, nil
+		}
+		last := msgs[len(msgs)-1]
+		sendTime, clientMsgID = last.SendTime, last.ClientMsgID
+		// deleted messages only keep a tombstone locally, their content must not be exported
+		msgs = datautil.Filter(msgs, func(msg *model_struct.LocalChatLog) (*model_struct.LocalChatLog, bool) {
+			return msg, msg.Status != constant.MsgStatusHasDeleted
+		})
+		if len(msgs) == 0 {
+			continue
+		}
+		if err := w.Write(&backup.Record{Type: backup.RecordMessages, ConversationID: conversationID, Messages: msgs}); err != nil {
+			return 0, err
+		}
+		count += len(msgs)
+	}
+}
+
+// RestoreLocalBackup merges a backup into the local db. Messages already present, by
+// ClientMsgID or seq, are kept as they are; the local state of existing conversations wins
+// over the backup, except for a draft that only the backup has. Progress counts the bytes read.
+func (c *Conversation) RestoreLocalBackup(ctx context.Context, req *sdk.RestoreLocalBackupParams, progress third.Progress) (*sdk.RestoreLocalBackupResp, error) {
+	if !c.backupMutex.TryLock() {
+		return nil, errs.New("local backup is in progress").Wrap()
+	}
+	defer c.backupMutex.Unlock()
+	f, err := os.Open(req.FilePath)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "open backup file failed")
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errs.WrapMsg(err, "stat backup file failed")
+	}
+	size := info.Size()
+	// read in large blocks so that progress is reported about once per MB
+	r, err := backup.NewReader(bufio.NewReaderSize(file.NewProgressReader(f, func(current int64) {
+		progress.OnProgress(current, size)
+	}), backupReadBuffer), req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if r.Manifest.UserID != c.loginUserID {
+		return nil, sdkerrs.ErrArgs.WrapMsg("backup belongs to another user", "userID", r.Manifest.UserID)
+	}
+	log.ZInfo(ctx, "restore local backup", "filePath", req.FilePath, "sdkVersion", r.Manifest.SDKVersion, "createTime", r.Manifest.CreateTime)
+	resp := &sdk.RestoreLocalBackupResp{}
+	var added, changed []string
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch record.Type {
+		case backup.RecordConversation:
+			isNew, isChanged, err := c.restoreConversation(ctx, record.Conversation)
+			if err != nil {
+				return nil, err
+			}
+			if isNew {
+				added = append(added, record.Conversation.ConversationID)
+				resp.ConversationCount++
+			} else if isChanged {
+				changed = append(changed, record.Conversation.ConversationID)
+			}
+		case backup.RecordMessages:
+			inserted, skipped, err := c.restoreMessages(ctx, record.ConversationID, record.Messages)
+			if err != nil {
+				return nil, err
+			}
+			resp.MessageCount += inserted
+			resp.SkippedMessageCount += skipped
+			if inserted > 0 && !datautil.Contain(record.ConversationID, added...) {
+				changed = append(changed, record.ConversationID)
+			}
+		case backup.RecordReadCursors:
+			if err := c.restoreReadCursors(ctx, record.ConversationID, record.ReadCursors); err != nil {
+				return nil, err
+			}
+		case backup.RecordReadState:
+			if err := c.restoreReadState(ctx, record.ReadState); err != nil {
+				return nil, err
+			}
+		default:
+			log.ZWarn(ctx, "skip unknown backup record", nil, "type", record.Type)
+		}
+	}
+	progress.OnProgress(size, size)
+	if len(added) > 0 {
+		_ = common.TriggerCmdUpdateConversation(ctx, common.UpdateConNode{Action: constant.NewCon, Args: added}, c.GetCh())
+	}
+	if changed = datautil.Distinct(changed); len(changed) > 0 {
+		_ = common.TriggerCmdUpdateConversation(ctx, common.UpdateConNode{Action: constant.ConChange, Args: changed}, c.GetCh())
+	}
+	log.ZInfo(ctx, "local backup restored", "conversations", resp.ConversationCount, "messages", resp.MessageCount, "skipped", resp.SkippedMessageCount)
+	return resp, nil
+}
+
+func (c *Conversation) restoreConversation(ctx context.Context, conversation *model_struct.LocalConversation) (isNew bool, isChanged bool, err error) {
+	c.conversationSyncMutex.Lock()
+	defer c.conversationSyncMutex.Unlock()
+	exists, err := c.db.ConversationIfExists(ctx, conversation.ConversationID)
+	if err != nil {
+		return false, false, err
+	}
+	if !exists {
+		// the unread count of the old device is stale, the next sync sets the real one
+		conversation.UnreadCount = 0
+		return true, false, c.db.InsertConversation(ctx, conversation)
+	}
+	if conversation.DraftText == "" {
+		return false, false, nil
+	}
+	local, err := c.db.GetConversation(ctx, conversation.ConversationID)
+	if err != nil {
+		return false, false, err
+	}
+	if local.DraftText != "" {
+		return false, false, nil
+	}
+	return false, true, c.db.UpdateColumnsConversation(ctx, conversation.ConversationID, map[string]any{
+		"draft_text":      conversation.DraftText,
+		"draft_text_time": conversation.DraftTextTime,
+	})
+}
+
+func (c *Conversation) restoreMessages(ctx context.Context, conversationID string, msgs []*model_struct.LocalChatLog) (inserted int, skipped int, err error) {
+	clientMsgIDs := datautil.Slice(msgs, func(msg *model_struct.LocalChatLog) string { return msg.ClientMsgID })
+	existing, err := c.db.GetMessagesByClientMsgIDs(ctx, conversationID, clientMsgIDs)
+	if err != nil {
+		return 0, 0, err
+	}
+	var seqs []int64
+	for _, msg := range msgs {
+		if msg.Seq != 0 {
+			seqs = append(seqs, msg.Seq)
+		}
+	}
+	if len(seqs) > 0 {
+		bySeq, err := c.db.GetMessagesBySeqs(ctx, conversationID, seqs)
+		if err != nil {
+			return 0, 0, err
+		}
+		existing = append(existing, bySeq...)
+	}
+	seenIDs := make(map[string]struct{}, len(existing))
+	seenSeqs := make(map[int64]struct{}, len(existing))
+	for _, msg := range existing {
+		seenIDs[msg.ClientMsgID] = struct{}{}
+		if msg.Seq != 0 {
+			seenSeqs[msg.Seq] = struct{}{}
+		}
+	}
+	var list []*model_struct.LocalChatLog
+	for _, msg := range msgs {
+		if _, ok := seenIDs[msg.ClientMsgID]; ok {
+			skipped++
+			continue
+		}
+		if _, ok := seenSeqs[msg.Seq]; ok && msg.Seq != 0 {
+			skipped++
+			continue
+		}
+		seenIDs[msg.ClientMsgID] = struct{}{}
+		if msg.Seq != 0 {
+			seenSeqs[msg.Seq] = struct{}{}
+		}
+		// a message still sending on the old device can only be resent from here
+		if msg.Status == constant.MsgStatusSending {
+			msg.Status = constant.MsgStatusSendFailed
+		}
+		list = append(list, msg)
+	}
+	if len(list) == 0 {
+		return 0, skipped, nil
+	}
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, list); err != nil {
+		return 0, 0, err
+	}
+	return len(list), skipped, nil
+}
+
+func (c *Conversation) restoreReadCursors(ctx context.Context, conversationID string, cursors []*model_struct.LocalReadCursor) error {
+	local, err := c.db.GetReadCursorsByConversationID(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	readSeqs := make(map[string]int64, len(local))
+	for _, cursor := range local {
+		readSeqs[cursor.UserID] = cursor.MaxReadSeq
+	}
+	for _, cursor := range cursors {
+		if readSeq, ok := readSeqs[cursor.UserID]; ok && readSeq >= cursor.MaxReadSeq {
+			continue
+		}
+		if err := c.db.UpsertReadCursor(ctx, cursor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Conversation) restoreReadState(ctx context.Context, state *model_struct.LocalReadState) error {
+	local, err := c.db.GetReadState(ctx, state.ConversationID)
+	if err != nil && !isRecordNotFoundError(err) {
+		return err
+	}
+	if err == nil && local.AllReadSeq >= state.AllReadSeq {
+		return nil
+	}
+	return c.db.UpsertReadState(ctx, state)
+}
@@ -357,6 +357,10 @@ func (c *Conversation) searchLocalMessages(ctx context.Context, searchParam *sdk
 		return nil, errors.New("keywordlist and messageTypelist all null")
 	}
 
+	if searchParam.FullTextSearch && len(searchParam.KeywordList) != 0 {
+		return c.searchLocalMessagesFullText(ctx, searchParam, startTime, endTime)
+	}
+
 	// Search in a specific conversation if ConversationID is provided
 	if searchParam.ConversationID != "" {
 		// Validate pagination parameters
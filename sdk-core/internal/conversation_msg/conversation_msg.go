@@ -47,15 +47,21 @@ const (
 
 var SearchContentType = []int{constant.Text, constant.AtText, constant.File}
 
+// FullTextSearchContentType are the content types with text in the full-text index.
+var FullTextSearchContentType = []int{constant.Text, constant.AtText, constant.File, constant.Merger,
+	constant.Card, constant.Location, constant.Custom, constant.Quote}
+
 type Conversation struct {
 	*interaction.LongConnMgr
 	conversationSyncer          *syncer.Syncer[*model_struct.LocalConversation, pbConversation.GetOwnerConversationResp, string]
+	folderSyncer                *syncer.Syncer[*model_struct.LocalConversationFolder, syncer.NoResp, string]
 	db                          db_interface.DataBase
 	ConversationListener        func() open_im_sdk_callback.OnConversationListener
 	msgListener                 func() open_im_sdk_callback.OnAdvancedMsgListener
 	msgKvListener               func() open_im_sdk_callback.OnMessageKvInfoListener
 	batchMsgListener            func() open_im_sdk_callback.OnBatchMsgListener
 	businessListener            func() open_im_sdk_callback.OnCustomBusinessListener
+	mediaProcessor              func() open_im_sdk_callback.MediaProcessor
 	recvCh                      chan common.Cmd2Value
 	msgSyncerCh                 chan common.Cmd2Value
 	loginUserID                 string
@@ -65,6 +71,7 @@ type Conversation struct {
 	group                       *group.Group
 	user                        *user.User
 	file                        *file.File
+	downloader                  *file.Downloader
 	cache                       *cache.Cache[string, *model_struct.LocalConversation]
 	maxSeqRecorder              MaxSeqRecorder
 	messagePullForwardEndSeqMap *cache.ConversationSeqContextCache
@@ -73,6 +80,8 @@ type Conversation struct {
 	msgOffset                   int
 	progress                    int
 	conversationSyncMutex       sync.Mutex
+	backupMutex                 sync.Mutex
+	downloadMutex               sync.Mutex
 
 	startTime time.Time
 
@@ -99,9 +108,13 @@ func (c *Conversation) SetBusinessListener(businessListener func() open_im_sdk_c
 	c.businessListener = businessListener
 }
 
+func (c *Conversation) SetMediaProcessor(mediaProcessor func() open_im_sdk_callback.MediaProcessor) {
+	c.mediaProcessor = mediaProcessor
+}
+
 func NewConversation(ctx context.Context, longConnMgr *interaction.LongConnMgr, db db_interface.DataBase,
 	recvCh, msgSyncerCh chan common.Cmd2Value, relation *relation.Relation, group *group.Group, user *user.User,
-	file *file.File) *Conversation {
+	file *file.File, downloader *file.Downloader) *Conversation {
 	info := ccontext.Info(ctx)
 	n := &Conversation{db: db,
 		LongConnMgr:                 longConnMgr,
@@ -114,6 +127,7 @@ func NewConversation(ctx context.Context, longConnMgr *interaction.LongConnMgr,
 		group:                       group,
 		user:                        user,
 		file:                        file,
+		downloader:                  downloader,
 		IsExternalExtensions:        info.IsExternalExtensions(),
 		maxSeqRecorder:              NewMaxSeqRecorder(),
 		messagePullForwardEndSeqMap: cache.NewConversationSeqContextCache(),
@@ -147,8 +161,8 @@ func (c *Conversation) initSyncer() {
 					"is_not_in_group": serverConversation.IsNotInGroup, "group_at_type": serverConversation.GroupAtType,
 					"update_unread_count_time": serverConversation.UpdateUnreadCountTime,
 					"attached_info":            serverConversation.AttachedInfo, "ex": serverConversation.Ex, "msg_destruct_time": serverConversation.MsgDestructTime,
-					"is_msg_destruct": serverConversation.IsMsgDestruct,
-					"max_seq":         serverConversation.MaxSeq, "min_seq": serverConversation.MinSeq})
+					"is_msg_destruct": serverConversation.IsMsgDestruct, "folder_ids": serverConversation.FolderIDs,
+					"max_seq": serverConversation.MaxSeq, "min_seq": serverConversation.MinSeq})
 		}),
 		syncer.WithUUID[*model_struct.LocalConversation, pbConversation.GetOwnerConversationResp, string](func(value *model_struct.LocalConversation) string {
 			return value.ConversationID
@@ -166,7 +180,8 @@ func (c *Conversation) initSyncer() {
 				server.MaxSeq != local.MaxSeq ||
 				server.MinSeq != local.MinSeq ||
 				server.MsgDestructTime != local.MsgDestructTime ||
-				server.IsMsgDestruct != local.IsMsgDestruct {
+				server.IsMsgDestruct != local.IsMsgDestruct ||
+				server.FolderIDs != local.FolderIDs {
 				log.ZDebug(context.Background(), "not same", "conversationID", server.ConversationID, "server", server.RecvMsgOpt, "local", local.RecvMsgOpt)
 				return false
 			}
@@ -197,6 +212,7 @@ func (c *Conversation) initSyncer() {
 		syncer.WithReqApiRouter[*model_struct.LocalConversation, pbConversation.GetOwnerConversationResp, string](api.GetOwnerConversation.Route()),
 		syncer.WithFullSyncLimit[*model_struct.LocalConversation, pbConversation.GetOwnerConversationResp, string](conversationSyncLimit),
 	)
+	c.initFolderSyncer()
 
 }
 
@@ -43,6 +43,7 @@ func ServerConversationToLocal(conversation *pbConversation.Conversation) *model
 		Ex:               conversation.Ex,
 		MsgDestructTime:  conversation.MsgDestructTime,
 		IsMsgDestruct:    conversation.IsMsgDestruct,
+		FolderIDs:        encodeFolderIDs(conversation.FolderIDs),
 	}
 }
 
@@ -137,6 +138,11 @@ func LocalChatLogToMsgStruct(localMessage *model_struct.LocalChatLog) *sdk_struc
 	return message
 }
 
+// MsgDataToMsgStruct converts a message pulled from the server into the form handed to the app.
+func MsgDataToMsgStruct(serverMessage *sdkws.MsgData) *sdk_struct.MsgStruct {
+	return LocalChatLogToMsgStruct(MsgDataToLocalChatLog(serverMessage))
+}
+
 func msgHandleByContentType(msg *sdk_struct.MsgStruct) (err error) {
 	switch msg.ContentType {
 	case constant.Text:
@@ -266,3 +272,37 @@ func MsgStructToLocalChatLog(message *sdk_struct.MsgStruct) *model_struct.LocalC
 	localMessage.AttachedInfo = utils.StructToJsonString(message.AttachedInfoElem)
 	return localMessage
 }
+
+// encodeFolderIDs keeps the folder IDs of a conversation as a JSON array, empty when the
+// conversation is in no folder.
+func encodeFolderIDs(folderIDs []string) string {
+	if len(folderIDs) == 0 {
+		return ""
+	}
+	return utils.StructToJsonString(folderIDs)
+}
+
+func decodeFolderIDs(folderIDs string) []string {
+	if folderIDs == "" {
+		return nil
+	}
+	var ids []string
+	if err := utils.JsonStringToStruct(folderIDs, &ids); err != nil {
+		return nil
+	}
+	return ids
+}
+
+func ServerConversationFolderToLocal(folder *pbConversation.ConversationFolder) *model_struct.LocalConversationFolder {
+	localFolder := &model_struct.LocalConversationFolder{
+		FolderID:   folder.FolderID,
+		Name:       folder.Name,
+		Sort:       folder.Sort,
+		CreateTime: folder.CreateTime,
+		UpdateTime: folder.UpdateTime,
+	}
+	if folder.Rule != nil {
+		localFolder.Rule = utils.StructToJsonString(folder.Rule)
+	}
+	return localFolder
+}
@@ -0,0 +1,170 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/internal/third/file"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	sdk "github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// DownloadMessageFile downloads the picture, video, voice or file of a message into the media
+// cache, and records the local path in the message elem, like SourcePath after sending.
+func (c *Conversation) DownloadMessageFile(ctx context.Context, req *sdk.DownloadMessageFileParams, progress file.DownloadFileCallback) (*sdk.DownloadMessageFileResp, error) {
+	msg, err := c.db.GetMessage(ctx, req.ConversationID, req.ClientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	info, err := messageFile(msg, req.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	// a file sent from this device, or downloaded and not evicted yet
+	if info.localPath != "" && utils.FileExist(info.localPath) {
+		return &sdk.DownloadMessageFileResp{FilePath: info.localPath}, nil
+	}
+	resp, err := c.downloader.DownloadFile(ctx, info.req, progress)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.setMessageFilePath(ctx, req, resp.FilePath); err != nil {
+		log.ZWarn(ctx, "save downloaded file path failed", err, "clientMsgID", req.ClientMsgID)
+	}
+	return &sdk.DownloadMessageFileResp{FilePath: resp.FilePath}, nil
+}
+
+// setMessageFilePath reloads the message so that downloads of the video and its cover do not
+// overwrite each other's path.
+func (c *Conversation) setMessageFilePath(ctx context.Context, req *sdk.DownloadMessageFileParams, filePath string) error {
+	c.downloadMutex.Lock()
+	defer c.downloadMutex.Unlock()
+	msg, err := c.db.GetMessage(ctx, req.ConversationID, req.ClientMsgID)
+	if err != nil {
+		return err
+	}
+	info, err := messageFile(msg, req.Snapshot)
+	if err != nil || info.setPath == nil {
+		return err
+	}
+	return c.db.UpdateColumnsMessage(ctx, req.ConversationID, req.ClientMsgID, map[string]any{"content": info.setPath(filePath)})
+}
+
+type messageFileInfo struct {
+	req       *file.DownloadFileReq
+	localPath string
+	// setPath returns the content with the local path, nil when the elem has no field for it,
+	// e.g. for a picture thumbnail.
+	setPath func(path string) string
+}
+
+// messageFile returns what to download for a message, and how to write its local path back
+// into the content.
+func messageFile(msg *model_struct.LocalChatLog, snapshot bool) (*messageFileInfo, error) {
+	info := &messageFileInfo{}
+	switch msg.ContentType {
+	case constant.Picture:
+		var elem sdk_struct.PictureElem
+		if err := utils.JsonStringToStruct(msg.Content, &elem); err != nil {
+			return nil, err
+		}
+		picture := elem.BigPicture
+		if picture == nil || picture.Url == "" {
+			picture = elem.SourcePicture
+		}
+		if snapshot {
+			picture = elem.SnapshotPicture
+		}
+		if picture == nil || picture.Url == "" {
+			break
+		}
+		info.req = &file.DownloadFileReq{URL: picture.Url, Name: picture.UUID, Size: picture.Size, MD5: picture.MD5}
+		if !snapshot {
+			info.localPath = elem.SourcePath
+			info.setPath = func(path string) string {
+				elem.SourcePath = path
+				return utils.StructToJsonString(elem)
+			}
+		}
+	case constant.Video:
+		var elem sdk_struct.VideoElem
+		if err := utils.JsonStringToStruct(msg.Content, &elem); err != nil {
+			return nil, err
+		}
+		if snapshot {
+			if elem.SnapshotURL == "" {
+				break
+			}
+			info.req = &file.DownloadFileReq{URL: elem.SnapshotURL, Name: elem.SnapshotUUID, Size: elem.SnapshotSize, MD5: elem.SnapshotMD5}
+			info.localPath = elem.SnapshotPath
+			info.setPath = func(path string) string {
+				elem.SnapshotPath = path
+				return utils.StructToJsonString(elem)
+			}
+		} else {
+			if elem.VideoURL == "" {
+				break
+			}
+			info.req = &file.DownloadFileReq{URL: elem.VideoURL, Name: elem.VideoUUID, Size: elem.VideoSize, MD5: elem.VideoMD5}
+			info.localPath = elem.VideoPath
+			info.setPath = func(path string) string {
+				elem.VideoPath = path
+				return utils.StructToJsonString(elem)
+			}
+		}
+	case constant.Sound:
+		var elem sdk_struct.SoundElem
+		if err := utils.JsonStringToStruct(msg.Content, &elem); err != nil {
+			return nil, err
+		}
+		if elem.SourceURL == "" {
+			break
+		}
+		info.req = &file.DownloadFileReq{URL: elem.SourceURL, Name: elem.UUID, Size: elem.DataSize, MD5: elem.MD5}
+		info.localPath = elem.SoundPath
+		info.setPath = func(path string) string {
+			elem.SoundPath = path
+			return utils.StructToJsonString(elem)
+		}
+	case constant.File:
+		var elem sdk_struct.FileElem
+		if err := utils.JsonStringToStruct(msg.Content, &elem); err != nil {
+			return nil, err
+		}
+		if elem.SourceURL == "" {
+			break
+		}
+		info.req = &file.DownloadFileReq{URL: elem.SourceURL, Name: elem.FileName, Size: elem.FileSize, MD5: elem.MD5}
+		info.localPath = elem.FilePath
+		info.setPath = func(path string) string {
+			elem.FilePath = path
+			return utils.StructToJsonString(elem)
+		}
+	default:
+		return nil, sdkerrs.ErrMsgContentTypeNotSupport.WrapMsg("message has no file", "contentType", msg.ContentType)
+	}
+	if info.req == nil {
+		return nil, errs.New("message file has no url", "clientMsgID", msg.ClientMsgID).Wrap()
+	}
+	return info, nil
+}
@@ -0,0 +1,219 @@
+package conversation_msg
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/syncer"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	pbConversation "github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (c *Conversation) initFolderSyncer() {
+	c.folderSyncer = syncer.New[*model_struct.LocalConversationFolder, syncer.NoResp, string](
+		func(ctx context.Context, value *model_struct.LocalConversationFolder) error {
+			return c.db.InsertConversationFolder(ctx, value)
+		},
+		func(ctx context.Context, value *model_struct.LocalConversationFolder) error {
+			return c.db.DeleteConversationFolder(ctx, value.FolderID)
+		},
+		func(ctx context.Context, server, local *model_struct.LocalConversationFolder) error {
+			return c.db.UpdateConversationFolder(ctx, server)
+		},
+		func(value *model_struct.LocalConversationFolder) string {
+			return value.FolderID
+		},
+		nil,
+		nil,
+	)
+}
+
+// syncConversationFolders replaces the local folders with the folder list the server sent
+// along with the conversation changes, and gives the listener the new list if it changed.
+func (c *Conversation) syncConversationFolders(ctx context.Context, folders []*pbConversation.ConversationFolder) error {
+	local, err := c.db.GetConversationFolders(ctx)
+	if err != nil {
+		return err
+	}
+	var changed bool
+	server := datautil.Batch(ServerConversationFolderToLocal, folders)
+	if err := c.folderSyncer.Sync(ctx, server, local, func(ctx context.Context, state int, server, local *model_struct.LocalConversationFolder) error {
+		if state != syncer.Unchanged {
+			changed = true
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	list, err := c.db.GetConversationFolders(ctx)
+	if err != nil {
+		return err
+	}
+	c.ConversationListener().OnConversationFoldersChanged(utils.StructToJsonString(list))
+	return nil
+}
+
+func (c *Conversation) CreateConversationFolder(ctx context.Context, req *pbConversation.CreateConversationFolderReq) (*model_struct.LocalConversationFolder, error) {
+	c.conversationSyncMutex.Lock()
+	defer c.conversationSyncMutex.Unlock()
+	resp, err := c.createConversationFolder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.IncrSyncConversations(ctx); err != nil {
+		return nil, err
+	}
+	return ServerConversationFolderToLocal(resp.Folder), nil
+}
+
+// UpdateConversationFolder changes the name, the sort or the rule of a folder, the fields
+// left unset keep their values.
+func (c *Conversation) UpdateConversationFolder(ctx context.Context, req *pbConversation.UpdateConversationFolderReq) error {
+	c.conversationSyncMutex.Lock()
+	defer c.conversationSyncMutex.Unlock()
+	if err := c.updateConversationFolder(ctx, req); err != nil {
+		return err
+	}
+	return c.IncrSyncConversations(ctx)
+}
+
+func (c *Conversation) DeleteConversationFolder(ctx context.Context, folderID string) error {
+	c.conversationSyncMutex.Lock()
+	defer c.conversationSyncMutex.Unlock()
+	if err := c.deleteConversationFolder(ctx, folderID); err != nil {
+		return err
+	}
+	return c.IncrSyncConversations(ctx)
+}
+
+func (c *Conversation) GetConversationFolders(ctx context.Context) ([]*model_struct.LocalConversationFolder, error) {
+	return c.db.GetConversationFolders(ctx)
+}
+
+func (c *Conversation) AddConversationsToFolder(ctx context.Context, folderID string, conversationIDs []string) error {
+	c.conversationSyncMutex.Lock()
+	defer c.conversationSyncMutex.Unlock()
+	if err := c.addConversationsToFolder(ctx, folderID, conversationIDs); err != nil {
+		return err
+	}
+	return c.IncrSyncConversations(ctx)
+}
+
+func (c *Conversation) RemoveConversationsFromFolder(ctx context.Context, folderID string, conversationIDs []string) error {
+	c.conversationSyncMutex.Lock()
+	defer c.conversationSyncMutex.Unlock()
+	if err := c.removeConversationsFromFolder(ctx, folderID, conversationIDs); err != nil {
+		return err
+	}
+	return c.IncrSyncConversations(ctx)
+}
+
+// folderMatcher tells whether a conversation is in the folder. A regular folder matches the
+// conversations listing it, a smart folder matches by its rule.
+func folderMatcher(folder *model_struct.LocalConversationFolder) (func(*model_struct.LocalConversation) bool, error) {
+	if folder.Rule == "" {
+		return func(conversation *model_struct.LocalConversation) bool {
+			return datautil.Contain(folder.FolderID, decodeFolderIDs(conversation.FolderIDs)...)
+		}, nil
+	}
+	var rule pbConversation.ConversationFolderRule
+	if err := utils.JsonStringToStruct(folder.Rule, &rule); err != nil {
+		return nil, err
+	}
+	return func(conversation *model_struct.LocalConversation) bool {
+		if len(rule.SessionTypes) > 0 && !datautil.Contain(conversation.ConversationType, rule.SessionTypes...) {
+			return false
+		}
+		if rule.GroupIDPrefix != "" && (conversation.GroupID == "" || !strings.HasPrefix(conversation.GroupID, rule.GroupIDPrefix)) {
+			return false
+		}
+		switch rule.Unread {
+		case constant.FolderUnreadOnly:
+			return conversation.UnreadCount > 0
+		case constant.FolderUnreadAtMe:
+			return conversation.UnreadCount > 0 &&
+				(conversation.GroupAtType == constant.AtMe || conversation.GroupAtType == constant.AtAll || conversation.GroupAtType == constant.AtAllAtMe)
+		}
+		return true
+	}, nil
+}
+
+func (c *Conversation) getFolderMatcher(ctx context.Context, folderID string) (func(*model_struct.LocalConversation) bool, error) {
+	folders, err := c.db.GetConversationFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, folder := range folders {
+		if folder.FolderID == folderID {
+			return folderMatcher(folder)
+		}
+	}
+	return nil, sdkerrs.ErrArgs.WrapMsg("folder not found", "folderID", folderID)
+}
+
+// GetConversationListSplitByFolder pages the conversations of a folder in the order of
+// GetConversationListSplit.
+func (c *Conversation) GetConversationListSplitByFolder(ctx context.Context, folderID string, offset, count int) ([]*model_struct.LocalConversation, error) {
+	match, err := c.getFolderMatcher(ctx, folderID)
+	if err != nil {
+		return nil, err
+	}
+	conversations, err := c.db.GetAllConversationListDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conversations = datautil.Filter(conversations, func(conversation *model_struct.LocalConversation) (*model_struct.LocalConversation, bool) {
+		return conversation, match(conversation)
+	})
+	if offset >= len(conversations) {
+		return nil, nil
+	}
+	conversations = conversations[offset:]
+	if count < len(conversations) {
+		conversations = conversations[:count]
+	}
+	return conversations, nil
+}
+
+// FolderUnreadCount is the unread message count of a folder.
+type FolderUnreadCount struct {
+	FolderID    string `json:"folderID"`
+	UnreadCount int32  `json:"unreadCount"`
+}
+
+// GetConversationFolderUnreadCounts counts the unread messages of every folder the way
+// GetTotalUnreadMsgCount does, conversations that don't notify are left out.
+func (c *Conversation) GetConversationFolderUnreadCounts(ctx context.Context) ([]*FolderUnreadCount, error) {
+	folders, err := c.db.GetConversationFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conversations, err := c.db.GetAllConversationListDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]*FolderUnreadCount, 0, len(folders))
+	for _, folder := range folders {
+		match, err := folderMatcher(folder)
+		if err != nil {
+			log.ZWarn(ctx, "invalid folder rule", err, "folderID", folder.FolderID, "rule", folder.Rule)
+			continue
+		}
+		count := &FolderUnreadCount{FolderID: folder.FolderID}
+		for _, conversation := range conversations {
+			if conversation.RecvMsgOpt < constant.ReceiveNotNotifyMessage && match(conversation) {
+				count.UnreadCount += conversation.UnreadCount
+			}
+		}
+		counts = append(counts, count)
+	}
+	return counts, nil
+}
@@ -0,0 +1,95 @@
+package conversation_msg
+
+import (
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+)
+
+func TestFolderMatcher(t *testing.T) {
+	single := &model_struct.LocalConversation{ConversationID: "si_u1_u2", ConversationType: constant.SingleChatType, FolderIDs: `["f1","f2"]`}
+	work := &model_struct.LocalConversation{ConversationID: "sg_work_1", ConversationType: constant.ReadGroupChatType, GroupID: "work_1", UnreadCount: 3}
+	workAtMe := &model_struct.LocalConversation{ConversationID: "sg_work_2", ConversationType: constant.ReadGroupChatType, GroupID: "work_2", UnreadCount: 1, GroupAtType: constant.AtMe}
+	workAtAll := &model_struct.LocalConversation{ConversationID: "sg_work_3", ConversationType: constant.ReadGroupChatType, GroupID: "work_3", UnreadCount: 1, GroupAtType: constant.AtAll}
+	workRead := &model_struct.LocalConversation{ConversationID: "sg_work_4", ConversationType: constant.ReadGroupChatType, GroupID: "work_4", GroupAtType: constant.AtMe}
+	family := &model_struct.LocalConversation{ConversationID: "sg_family", ConversationType: constant.ReadGroupChatType, GroupID: "family", UnreadCount: 2, FolderIDs: `["f1"]`}
+	notification := &model_struct.LocalConversation{ConversationID: "sn_admin", ConversationType: constant.NotificationChatType, UnreadCount: 5}
+	all := []*model_struct.LocalConversation{single, work, workAtMe, workAtAll, workRead, family, notification}
+
+	tests := []struct {
+		name   string
+		folder *model_struct.LocalConversationFolder
+		want   []*model_struct.LocalConversation
+	}{
+		{
+			name:   "regular folder",
+			folder: &model_struct.LocalConversationFolder{FolderID: "f1"},
+			want:   []*model_struct.LocalConversation{single, family},
+		},
+		{
+			name:   "regular folder without conversations",
+			folder: &model_struct.LocalConversationFolder{FolderID: "f3"},
+		},
+		{
+			name:   "session type",
+			folder: &model_struct.LocalConversationFolder{FolderID: "s1", Rule: `{"sessionTypes":[1,4]}`},
+			want:   []*model_struct.LocalConversation{single, notification},
+		},
+		{
+			name:   "group ID prefix",
+			folder: &model_struct.LocalConversationFolder{FolderID: "s2", Rule: `{"groupIDPrefix":"work_"}`},
+			want:   []*model_struct.LocalConversation{work, workAtMe, workAtAll, workRead},
+		},
+		{
+			name:   "unread",
+			folder: &model_struct.LocalConversationFolder{FolderID: "s3", Rule: `{"unread":1}`},
+			want:   []*model_struct.LocalConversation{work, workAtMe, workAtAll, family, notification},
+		},
+		{
+			name:   "unread mentioning me",
+			folder: &model_struct.LocalConversationFolder{FolderID: "s4", Rule: `{"unread":2}`},
+			want:   []*model_struct.LocalConversation{workAtMe, workAtAll},
+		},
+		{
+			name:   "all conditions",
+			folder: &model_struct.LocalConversationFolder{FolderID: "s5", Rule: `{"sessionTypes":[3],"groupIDPrefix":"work_","unread":2}`},
+			want:   []*model_struct.LocalConversation{workAtMe, workAtAll},
+		},
+		{
+			name:   "group ID prefix leaves out single chats",
+			folder: &model_struct.LocalConversationFolder{FolderID: "s6", Rule: `{"sessionTypes":[1,3],"groupIDPrefix":"fam"}`},
+			want:   []*model_struct.LocalConversation{family},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, err := folderMatcher(tt.folder)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, conversation := range all {
+				if match(conversation) {
+					got = append(got, conversation.ConversationID)
+				}
+			}
+			var want []string
+			for _, conversation := range tt.want {
+				want = append(want, conversation.ConversationID)
+			}
+			if len(got) != len(want) {
+				t.Fatalf("got %v, want %v", got, want)
+			}
+			for i := range got {
+				if got[i] != want[i] {
+					t.Fatalf("got %v, want %v", got, want)
+				}
+			}
+		})
+	}
+
+	if _, err := folderMatcher(&model_struct.LocalConversationFolder{FolderID: "bad", Rule: "{"}); err == nil {
+		t.Error("a broken rule is an error")
+	}
+}
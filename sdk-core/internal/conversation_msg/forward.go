@@ -0,0 +1,21 @@
+package conversation_msg
+
+import (
+	"context"
+
+	pbMsg "github.com/openimsdk/protocol/msg"
+)
+
+// ForwardMessages forwards messages of a conversation to the targets on the server, the
+// content and its uploaded files are reused, so nothing is uploaded again. The forwarded
+// messages arrive through the normal sync, every target reports its own result.
+func (c *Conversation) ForwardMessages(ctx context.Context, req *pbMsg.ForwardMsgsReq) ([]*pbMsg.ForwardMsgResult, error) {
+	if _, err := c.db.GetConversation(ctx, req.ConversationID); err != nil {
+		return nil, err
+	}
+	resp, err := c.forwardMsgs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
@@ -24,6 +24,8 @@ import (
 )
 
 func (c *Conversation) IncrSyncConversations(ctx context.Context) error {
+	// the folders come with the conversation changes, a full sync needs them from the response
+	var serverResp *pbConversation.GetIncrementalConversationResp
 	conversationSyncer := syncer.VersionSynchronizer[*model_struct.LocalConversation, *pbConversation.GetIncrementalConversationResp]{
 		Ctx:       ctx,
 		DB:        c.db,
@@ -36,7 +38,9 @@ func (c *Conversation) IncrSyncConversations(ctx context.Context) error {
 			return c.db.GetAllConversations(ctx)
 		},
 		Server: func(version *model_struct.LocalVersionSync) (*pbConversation.GetIncrementalConversationResp, error) {
-			return c.getIncrementalConversationFromServer(ctx, version.Version, version.VersionID)
+			resp, err := c.getIncrementalConversationFromServer(ctx, version.Version, version.VersionID)
+			serverResp = resp
+			return resp, err
 		},
 		Full: func(resp *pbConversation.GetIncrementalConversationResp) bool {
 			return resp.Full
@@ -53,10 +57,22 @@ func (c *Conversation) IncrSyncConversations(ctx context.Context) error {
 		Insert: func(resp *pbConversation.GetIncrementalConversationResp) []*model_struct.LocalConversation {
 			return datautil.Batch(ServerConversationToLocal, resp.Insert)
 		},
+		ExtraData: func(resp *pbConversation.GetIncrementalConversationResp) any {
+			if !resp.FolderChanged {
+				return nil
+			}
+			return resp.Folders
+		},
+		ExtraDataProcessor: func(ctx context.Context, data any) error {
+			return c.syncConversationFolders(ctx, data.([]*pbConversation.ConversationFolder))
+		},
 		Syncer: func(server, local []*model_struct.LocalConversation) error {
 			return c.conversationSyncer.Sync(ctx, server, local, nil, true)
 		},
 		FullSyncer: func(ctx context.Context) error {
+			if err := c.syncConversationFolders(ctx, serverResp.Folders); err != nil {
+				return err
+			}
 			conversationIDList, err := c.db.GetAllConversationIDList(ctx)
 			if err != nil {
 				return err
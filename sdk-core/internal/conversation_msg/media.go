@@ -0,0 +1,170 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/openimsdk/openim-sdk-core/v3/internal/third/file"
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/media"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// mediaDir holds the files processed for sending. Once uploaded they move into the media
+// cache, which bounds their size.
+func (c *Conversation) mediaDir() string {
+	return filepath.Join(c.DataDir, "media_process", c.loginUserID)
+}
+
+func (c *Conversation) getMediaProcessor() open_im_sdk_callback.MediaProcessor {
+	if c.mediaProcessor == nil {
+		return nil
+	}
+	return c.mediaProcessor()
+}
+
+func (c *Conversation) webPEncoder() media.WebPEncoder {
+	processor := c.getMediaProcessor()
+	if processor == nil {
+		return nil
+	}
+	return func(srcPath, dstPath string, quality int) error {
+		if msg := processor.EncodeWebP(srcPath, dstPath, quality); msg != "" {
+			return errs.New(msg, "path", srcPath).Wrap()
+		}
+		return nil
+	}
+}
+
+// CreateImageMessageWithOptions is CreateImageMessageFromFullPath with the picture compressed,
+// made upright, stripped of its location or given a thumbnail first, as opts selects.
+func (c *Conversation) CreateImageMessageWithOptions(ctx context.Context, imageFullPath string, opts *sdk_struct.ImageProcessOptions) (*sdk_struct.MsgStruct, error) {
+	s := sdk_struct.MsgStruct{}
+	err := c.initBasicInfo(ctx, &s, constant.UserMsgType, constant.Picture)
+	if err != nil {
+		return nil, err
+	}
+	res, err := media.ProcessImage(imageFullPath, c.mediaDir(), s.ClientMsgID, opts, c.webPEncoder())
+	if err != nil {
+		return nil, err
+	}
+	if res.Source.Path == imageFullPath {
+		// kept as is, the app may delete it before it is sent
+		if _, err := utils.CopyFile(imageFullPath, utils.FileTmpPath(imageFullPath, c.DataDir)); err != nil {
+			return nil, err
+		}
+	}
+	log.ZDebug(ctx, "image processed", "imageFullPath", imageFullPath, "result", res)
+	s.PictureElem = &sdk_struct.PictureElem{
+		SourcePath: res.Source.Path,
+		SourcePicture: &sdk_struct.PictureBaseInfo{
+			Width:  res.Source.Width,
+			Height: res.Source.Height,
+			Type:   res.Source.Type,
+			Size:   res.Source.Size,
+		},
+	}
+	if res.Thumbnail != nil {
+		s.PictureElem.SnapshotPath = res.Thumbnail.Path
+		s.PictureElem.SnapshotPicture = &sdk_struct.PictureBaseInfo{
+			Width:  res.Thumbnail.Width,
+			Height: res.Thumbnail.Height,
+			Type:   res.Thumbnail.Type,
+			Size:   res.Thumbnail.Size,
+		}
+	}
+	return &s, nil
+}
+
+// CreateVideoMessageWithOptions is CreateVideoMessageFromFullPath with the snapshot extracted
+// from the video by the MediaProcessor if not given, and processed as opts selects.
+func (c *Conversation) CreateVideoMessageWithOptions(ctx context.Context, videoFullPath string, videoType string,
+	duration int64, snapshotFullPath string, opts *sdk_struct.VideoProcessOptions) (*sdk_struct.MsgStruct, error) {
+	if opts == nil {
+		opts = &sdk_struct.VideoProcessOptions{}
+	}
+	name := utils.GetMsgID(c.loginUserID)
+	if snapshotFullPath == "" && opts.ExtractSnapshot {
+		processor := c.getMediaProcessor()
+		if processor == nil {
+			return nil, sdkerrs.ErrArgs.WrapMsg("no media processor to extract the video snapshot")
+		}
+		if err := os.MkdirAll(c.mediaDir(), 0755); err != nil {
+			return nil, errs.WrapMsg(err, "create media dir failed")
+		}
+		snapshotFullPath = filepath.Join(c.mediaDir(), name+"_frame.jpg")
+		if msg := processor.ExtractVideoFrame(videoFullPath, snapshotFullPath); msg != "" {
+			return nil, errs.New(msg, "videoFullPath", videoFullPath).Wrap()
+		}
+	}
+	if snapshotFullPath != "" && opts.Snapshot != nil {
+		snapshotOpts := *opts.Snapshot
+		snapshotOpts.Thumbnail = false
+		res, err := media.ProcessImage(snapshotFullPath, c.mediaDir(), name, &snapshotOpts, c.webPEncoder())
+		if err != nil {
+			return nil, err
+		}
+		snapshotFullPath = res.Source.Path
+	}
+	return c.CreateVideoMessageFromFullPath(ctx, videoFullPath, videoType, duration, snapshotFullPath)
+}
+
+// uploadPictureSnapshot uploads the thumbnail made on the device, it reports false to fall
+// back to the one resized by the server.
+func (c *Conversation) uploadPictureSnapshot(ctx context.Context, s *sdk_struct.MsgStruct) bool {
+	elem := s.PictureElem
+	if elem.SnapshotPath == "" || elem.SnapshotPicture == nil || !utils.FileExist(elem.SnapshotPath) {
+		return false
+	}
+	res, err := c.file.UploadFile(ctx, &file.UploadFileReq{
+		ContentType: elem.SnapshotPicture.Type,
+		Filepath:    elem.SnapshotPath,
+		Uuid:        elem.SnapshotPicture.UUID,
+		Name:        c.fileName("pictureSnapshot", s.ClientMsgID) + filepath.Ext(elem.SnapshotPath),
+		Cause:       "msg-picture-snapshot",
+	}, nil)
+	if err != nil {
+		log.ZWarn(ctx, "upload picture snapshot failed", err, "clientMsgID", s.ClientMsgID)
+		return false
+	}
+	elem.SnapshotPicture.Url = res.URL
+	elem.SnapshotPicture.MD5 = res.MD5
+	c.storeProcessedFile(ctx, &elem.SnapshotPath, res.URL, elem.SnapshotPicture.UUID)
+	return true
+}
+
+// storeProcessedFile moves an uploaded file from mediaDir into the media cache and updates
+// its path; other files belong to the app and are left alone.
+func (c *Conversation) storeProcessedFile(ctx context.Context, path *string, url string, name string) {
+	if url == "" || !strings.HasPrefix(*path, c.mediaDir()+string(filepath.Separator)) {
+		return
+	}
+	filePath, err := c.downloader.StoreFile(ctx, *path, url, name)
+	if err != nil {
+		log.ZWarn(ctx, "store processed file failed", err, "path", *path)
+		return
+	}
+	*path = filePath
+}
@@ -99,6 +99,7 @@ func (c *Conversation) syncFlag(c2v common.Cmd2Value) {
 			c.group.SyncAllAdminGroupApplicationWithoutNotice,
 			c.group.SyncAllSelfGroupApplicationWithoutNotice,
 			c.user.SyncAllCommandWithoutNotice,
+			c.user.IncrSyncFavorites,
 		}
 		runSyncFunctions(ctx, asyncNoWaitFunctions, asyncNoWait)
 
@@ -445,6 +446,7 @@ func (c *Conversation) syncData(c2v common.Cmd2Value) {
 		c.group.SyncAllAdminGroupApplication,
 		c.group.SyncAllSelfGroupApplication,
 		c.user.SyncAllCommand,
+		c.user.IncrSyncFavorites,
 		c.group.SyncAllJoinedGroupsAndMembers,
 		c.relation.IncrSyncFriends,
 		c.IncrSyncConversations,
@@ -0,0 +1,155 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	sdk "github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/search"
+
+	"github.com/openimsdk/tools/log"
+)
+
+type searchHit struct {
+	conversationID string
+	msg            *model_struct.LocalChatLogSearchResult
+}
+
+// searchLocalMessagesFullText searches with the full-text index. Messages are ranked by
+// relevance, and conversations by their best match.
+func (c *Conversation) searchLocalMessagesFullText(ctx context.Context, searchParam *sdk.SearchLocalMessagesParams, startTime, endTime int64) (*sdk.SearchLocalMessagesCallback, error) {
+	contentType := searchParam.MessageTypeList
+	if len(contentType) == 0 {
+		contentType = FullTextSearchContentType
+	}
+	var offset, count int
+	if searchParam.Count > 0 || searchParam.ConversationID != "" {
+		if searchParam.PageIndex < 1 || searchParam.Count < 1 {
+			return nil, errors.New("page or count is null")
+		}
+		offset = (searchParam.PageIndex - 1) * searchParam.Count
+		count = searchParam.Count
+	}
+
+	var hits []searchHit
+	if searchParam.ConversationID != "" {
+		if _, err := c.db.GetConversation(ctx, searchParam.ConversationID); err != nil {
+			return nil, err
+		}
+		list, err := c.db.SearchMessageFullText(ctx, searchParam.ConversationID, contentType, searchParam.KeywordList,
+			searchParam.KeywordListMatchType, startTime, endTime, offset, count)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range list {
+			hits = append(hits, searchHit{conversationID: searchParam.ConversationID, msg: msg})
+		}
+	} else {
+		var err error
+		hits, err = c.searchAllConversationsFullText(ctx, searchParam, contentType, startTime, endTime, offset+count)
+		if err != nil {
+			return nil, err
+		}
+		if offset >= len(hits) {
+			hits = nil
+		} else if count > 0 && offset+count < len(hits) {
+			hits = hits[offset : offset+count]
+		} else {
+			hits = hits[offset:]
+		}
+	}
+
+	var r sdk.SearchLocalMessagesCallback
+	items := make(map[string]*sdk.SearchByConversationResult)
+	for _, hit := range hits {
+		item, ok := items[hit.conversationID]
+		if !ok {
+			conversation, err := c.db.GetConversation(ctx, hit.conversationID)
+			if err != nil {
+				continue
+			}
+			item = &sdk.SearchByConversationResult{
+				ConversationID:    hit.conversationID,
+				ConversationType:  conversation.ConversationType,
+				ShowName:          conversation.ShowName,
+				FaceURL:           conversation.FaceURL,
+				LatestMsgSendTime: conversation.LatestMsgSendTime,
+			}
+			items[hit.conversationID] = item
+			r.SearchResultItems = append(r.SearchResultItems, item)
+		}
+		msg := LocalChatLogToMsgStruct(&hit.msg.LocalChatLog)
+		snippet, highlights := search.Snippet(search.Text(msg.ContentType, msg.Content), searchParam.KeywordList)
+		item.MessageList = append(item.MessageList, msg)
+		item.MatchList = append(item.MatchList, &sdk.SearchMessageMatch{
+			ClientMsgID: msg.ClientMsgID,
+			Score:       hit.msg.Score,
+			Snippet:     snippet,
+			Highlights:  highlights,
+		})
+		item.MessageCount++
+		r.TotalCount++
+	}
+	return &r, nil
+}
+
+// searchAllConversationsFullText returns the best matches of every conversation, merged by
+// relevance. Each conversation contributes at most limit messages, all of them if it is 0.
+func (c *Conversation) searchAllConversationsFullText(ctx context.Context, searchParam *sdk.SearchLocalMessagesParams, contentType []int, startTime, endTime int64, limit int) ([]searchHit, error) {
+	conversationIDList, err := c.db.GetAllConversationIDList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		hits []searchHit
+		mu   sync.Mutex
+	)
+	g, _ := errgroup.WithContext(ctx)
+	g.SetLimit(searchMessageGoroutineLimit)
+	for _, v := range conversationIDList {
+		conversationID := v
+		g.Go(func() error {
+			list, err := c.db.SearchMessageFullText(ctx, conversationID, contentType, searchParam.KeywordList,
+				searchParam.KeywordListMatchType, startTime, endTime, 0, limit)
+			if err != nil {
+				log.ZWarn(ctx, "search conversation message", err, "conversationID", conversationID)
+				return nil
+			}
+			mu.Lock()
+			for _, msg := range list {
+				hits = append(hits, searchHit{conversationID: conversationID, msg: msg})
+			}
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].msg.Score != hits[j].msg.Score {
+			return hits[i].msg.Score > hits[j].msg.Score
+		}
+		return hits[i].msg.SendTime > hits[j].msg.SendTime
+	})
+	return hits, nil
+}
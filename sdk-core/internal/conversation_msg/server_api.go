@@ -77,3 +77,33 @@ func (c *Conversation) getConversationReadCursorsFromServer(ctx context.Context,
 	req := &pbConversation.GetConversationReadCursorsReq{ConversationIDs: conversationIDs, UserID: c.loginUserID}
 	return api.GetConversationReadCursors.Invoke(ctx, req)
 }
+
+func (c *Conversation) createConversationFolder(ctx context.Context, req *pbConversation.CreateConversationFolderReq) (*pbConversation.CreateConversationFolderResp, error) {
+	req.UserID = c.loginUserID
+	return api.CreateConversationFolder.Invoke(ctx, req)
+}
+
+func (c *Conversation) updateConversationFolder(ctx context.Context, req *pbConversation.UpdateConversationFolderReq) error {
+	req.UserID = c.loginUserID
+	return api.UpdateConversationFolder.Execute(ctx, req)
+}
+
+func (c *Conversation) deleteConversationFolder(ctx context.Context, folderID string) error {
+	req := &pbConversation.DeleteConversationFolderReq{UserID: c.loginUserID, FolderID: folderID}
+	return api.DeleteConversationFolder.Execute(ctx, req)
+}
+
+func (c *Conversation) addConversationsToFolder(ctx context.Context, folderID string, conversationIDs []string) error {
+	req := &pbConversation.AddConversationsToFolderReq{UserID: c.loginUserID, FolderID: folderID, ConversationIDs: conversationIDs}
+	return api.AddConversationsToFolder.Execute(ctx, req)
+}
+
+func (c *Conversation) removeConversationsFromFolder(ctx context.Context, folderID string, conversationIDs []string) error {
+	req := &pbConversation.RemoveConversationsFromFolderReq{UserID: c.loginUserID, FolderID: folderID, ConversationIDs: conversationIDs}
+	return api.RemoveConversationsFromFolder.Execute(ctx, req)
+}
+
+func (c *Conversation) forwardMsgs(ctx context.Context, req *pbMsg.ForwardMsgsReq) (*pbMsg.ForwardMsgsResp, error) {
+	req.UserID = c.loginUserID
+	return api.ForwardMsgs.Invoke(ctx, req)
+}
@@ -69,6 +69,51 @@ func (g *Group) JoinGroup(ctx context.Context, groupID, reqMsg string, joinSourc
 	return nil
 }
 
+// CreateGroupInvite creates an invite token for a group. expireTime is a unix
+// millisecond timestamp, 0 means the invite never expires; maxUses 0 means unlimited.
+func (g *Group) CreateGroupInvite(ctx context.Context, groupID string, expireTime int64, maxUses int32, needApproval bool, ex string) (*group.GroupInvite, error) {
+	req := &group.CreateGroupInviteReq{GroupID: groupID, ExpireTime: expireTime, MaxUses: maxUses, NeedApproval: needApproval, Ex: ex}
+	return g.createGroupInvite(ctx, req)
+}
+
+func (g *Group) GetGroupInvites(ctx context.Context, groupID string) ([]*group.GroupInvite, error) {
+	return g.getGroupInvites(ctx, groupID)
+}
+
+func (g *Group) RevokeGroupInvite(ctx context.Context, groupID, token string) error {
+	return g.revokeGroupInvite(ctx, groupID, token)
+}
+
+func (g *Group) GetGroupInviteInfo(ctx context.Context, token string) (*group.GetGroupInviteInfoResp, error) {
+	return g.getGroupInviteInfo(ctx, token)
+}
+
+// JoinGroupByInvite joins a group through an invite link or QR code. If the invite
+// requires approval, an application is created instead and NeedApproval is set.
+func (g *Group) JoinGroupByInvite(ctx context.Context, token, reqMsg string, joinSource int32, ex string) (*group.JoinGroupByInviteResp, error) {
+	req := &group.JoinGroupByInviteReq{Token: token, ReqMessage: reqMsg, JoinSource: joinSource, Ex: ex}
+	resp, err := g.joinGroupByInvite(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.NeedApproval {
+		if err := g.SyncSelfGroupApplications(ctx, resp.GroupID); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+	g.groupSyncMutex.Lock()
+	defer g.groupSyncMutex.Unlock()
+
+	if err := g.IncrSyncJoinGroup(ctx); err != nil {
+		return nil, err
+	}
+	if err := g.IncrSyncGroupAndMember(ctx, resp.GroupID); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (g *Group) QuitGroup(ctx context.Context, groupID string) error {
 	if err := g.quitGroup(ctx, groupID); err != nil {
 		return err
@@ -183,6 +228,57 @@ func (g *Group) SetGroupMemberInfo(ctx context.Context, groupMemberInfo *group.S
 	return g.IncrSyncGroupAndMember(ctx, groupMemberInfo.GroupID)
 }
 
+// SetGroupAdminPermissions sets the permission bitmask of a group admin, see constant.GroupPermission*.
+func (g *Group) SetGroupAdminPermissions(ctx context.Context, groupID, userID string, permissions int32) error {
+	req := &group.SetGroupAdminPermissionsReq{GroupID: groupID, UserID: userID, Permissions: permissions}
+	if err := g.setGroupAdminPermissions(ctx, req); err != nil {
+		return err
+	}
+
+	g.groupSyncMutex.Lock()
+	defer g.groupSyncMutex.Unlock()
+
+	return g.IncrSyncGroupAndMember(ctx, groupID)
+}
+
+// PublishGroupAnnouncement publishes a new group announcement and keeps it in the
+// announcement history. With requireConfirm set, members are asked to confirm reading it.
+func (g *Group) PublishGroupAnnouncement(ctx context.Context, groupID, content string, requireConfirm bool, ex string) (*sdkws.GroupAnnouncement, error) {
+	req := &group.PublishGroupAnnouncementReq{GroupID: groupID, Content: content, RequireConfirm: requireConfirm, Ex: ex}
+	announcement, err := g.publishGroupAnnouncement(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	g.groupSyncMutex.Lock()
+	defer g.groupSyncMutex.Unlock()
+
+	if err := g.IncrSyncGroupAndMember(ctx, groupID); err != nil {
+		return nil, err
+	}
+	return announcement, nil
+}
+
+// GetGroupAnnouncements returns the announcement history of a group, newest first.
+func (g *Group) GetGroupAnnouncements(ctx context.Context, groupID string, pageNumber, showNumber int32) (*group.GetGroupAnnouncementsResp, error) {
+	req := &group.GetGroupAnnouncementsReq{GroupID: groupID, Pagination: &sdkws.RequestPagination{PageNumber: pageNumber, ShowNumber: showNumber}}
+	return g.getGroupAnnouncements(ctx, req)
+}
+
+func (g *Group) ConfirmGroupAnnouncement(ctx context.Context, groupID, announcementID string) error {
+	return g.confirmGroupAnnouncement(ctx, groupID, announcementID)
+}
+
+func (g *Group) GetGroupAnnouncementConfirms(ctx context.Context, groupID, announcementID string) (*group.GetGroupAnnouncementConfirmsResp, error) {
+	return g.getGroupAnnouncementConfirms(ctx, groupID, announcementID)
+}
+
+// RemindGroupAnnouncement reminds the members who have not confirmed the announcement yet
+// and returns their user IDs.
+func (g *Group) RemindGroupAnnouncement(ctx context.Context, groupID, announcementID string) ([]string, error) {
+	return g.remindGroupAnnouncement(ctx, groupID, announcementID)
+}
+
 //func (g *Group) SetGroupMemberRoleLevel(ctx context.Context, groupID, userID string, roleLevel int) error {
 //	return g.SetGroupMemberInfo(ctx, &group.SetGroupMemberInfo{GroupID: groupID, UserID: userID, RoleLevel: wrapperspb.Int32(int32(roleLevel))})
 //}
@@ -69,6 +69,22 @@ func (g *Group) doNotification(ctx context.Context, msg *sdkws.MsgData) error {
 			return errs.New(fmt.Sprintf("GroupApplicationRejectedNotification ReceiverAs unknown %d", detail.ReceiverAs)).Wrap()
 		}
 
+	case constant.GroupAnnouncementRemindNotification: // 1521
+		var detail sdkws.GroupAnnouncementRemindTips
+		if err := utils.UnmarshalNotificationElem(msg.Content, &detail); err != nil {
+			return err
+		}
+		g.listener().OnGroupAnnouncementRemind(utils.StructToJsonString(&detail))
+		return nil
+
+	case constant.GroupAnnouncementConfirmedNotification: // 1522
+		var detail sdkws.GroupAnnouncementConfirmedTips
+		if err := utils.UnmarshalNotificationElem(msg.Content, &detail); err != nil {
+			return err
+		}
+		g.listener().OnGroupAnnouncementConfirmed(utils.StructToJsonString(&detail))
+		return nil
+
 	default:
 		g.groupSyncMutex.Lock()
 		defer g.groupSyncMutex.Unlock()
@@ -258,6 +274,12 @@ func (g *Group) doNotification(ctx context.Context, msg *sdkws.MsgData) error {
 			if err := utils.UnmarshalNotificationElem(msg.Content, &detail); err != nil {
 				return err
 			}
+			// a new announcement that needs confirmation is the first reminder for the members
+			if announcement := detail.Announcement; announcement != nil && announcement.RequireConfirm && announcement.CreatorUserID != g.loginUserID {
+				g.listener().OnGroupAnnouncementRemind(utils.StructToJsonString(&sdkws.GroupAnnouncementRemindTips{
+					OpUser: detail.OpUser, Group: detail.Group, Announcement: announcement,
+				}))
+			}
 			return g.onlineSyncGroupAndMember(ctx, detail.Group.GroupID, nil, nil,
 				nil, detail.Group, groupSortIDUnchanged, detail.GroupMemberVersion, detail.GroupMemberVersionID)
 		case constant.GroupInfoSetNameNotification: // 1520
@@ -49,6 +49,10 @@ func (g *Group) setGroupMemberInfo(ctx context.Context, req *group.SetGroupMembe
 	return api.SetGroupMemberInfo.Execute(ctx, req)
 }
 
+func (g *Group) setGroupAdminPermissions(ctx context.Context, req *group.SetGroupAdminPermissionsReq) error {
+	return api.SetGroupAdminPermissions.Execute(ctx, req)
+}
+
 func (g *Group) kickGroupMember(ctx context.Context, req *group.KickGroupMemberReq) error {
 	return api.KickGroupMember.Execute(ctx, req)
 }
@@ -105,3 +109,45 @@ func (g *Group) inviteUserToGroup(ctx context.Context, req *group.InviteUserToGr
 func (g *Group) handlerGroupApplication(ctx context.Context, req *group.GroupApplicationResponseReq) error {
 	return api.AcceptGroupApplication.Execute(ctx, req)
 }
+
+func (g *Group) createGroupInvite(ctx context.Context, req *group.CreateGroupInviteReq) (*group.GroupInvite, error) {
+	return api.ExtractField(ctx, api.CreateGroupInvite.Invoke, req, (*group.CreateGroupInviteResp).GetInvite)
+}
+
+func (g *Group) getGroupInvites(ctx context.Context, groupID string) ([]*group.GroupInvite, error) {
+	req := &group.GetGroupInvitesReq{GroupID: groupID, Pagination: &sdkws.RequestPagination{}}
+	return api.Page(ctx, req, api.GetGroupInvites.Invoke, (*group.GetGroupInvitesResp).GetInvites)
+}
+
+func (g *Group) revokeGroupInvite(ctx context.Context, groupID, token string) error {
+	return api.RevokeGroupInvite.Execute(ctx, &group.RevokeGroupInviteReq{GroupID: groupID, Token: token})
+}
+
+func (g *Group) getGroupInviteInfo(ctx context.Context, token string) (*group.GetGroupInviteInfoResp, error) {
+	return api.GetGroupInviteInfo.Invoke(ctx, &group.GetGroupInviteInfoReq{Token: token})
+}
+
+func (g *Group) joinGroupByInvite(ctx context.Context, req *group.JoinGroupByInviteReq) (*group.JoinGroupByInviteResp, error) {
+	return api.JoinGroupByInvite.Invoke(ctx, req)
+}
+
+func (g *Group) publishGroupAnnouncement(ctx context.Context, req *group.PublishGroupAnnouncementReq) (*sdkws.GroupAnnouncement, error) {
+	return api.ExtractField(ctx, api.PublishGroupAnnouncement.Invoke, req, (*group.PublishGroupAnnouncementResp).GetAnnouncement)
+}
+
+func (g *Group) getGroupAnnouncements(ctx context.Context, req *group.GetGroupAnnouncementsReq) (*group.GetGroupAnnouncementsResp, error) {
+	return api.GetGroupAnnouncements.Invoke(ctx, req)
+}
+
+func (g *Group) confirmGroupAnnouncement(ctx context.Context, groupID, announcementID string) error {
+	return api.ConfirmGroupAnnouncement.Execute(ctx, &group.ConfirmGroupAnnouncementReq{GroupID: groupID, AnnouncementID: announcementID})
+}
+
+func (g *Group) getGroupAnnouncementConfirms(ctx context.Context, groupID, announcementID string) (*group.GetGroupAnnouncementConfirmsResp, error) {
+	return api.GetGroupAnnouncementConfirms.Invoke(ctx, &group.GetGroupAnnouncementConfirmsReq{GroupID: groupID, AnnouncementID: announcementID})
+}
+
+func (g *Group) remindGroupAnnouncement(ctx context.Context, groupID, announcementID string) ([]string, error) {
+	req := &group.RemindGroupAnnouncementReq{GroupID: groupID, AnnouncementID: announcementID}
+	return api.ExtractField(ctx, api.RemindGroupAnnouncement.Invoke, req, (*group.RemindGroupAnnouncementResp).GetRemindedUserIDs)
+}
@@ -103,6 +103,9 @@ type LongConnMgr struct {
 	connWrite *sync.Mutex
 
 	sub *subscription
+	// resumeToken resumes the gateway session on reconnect, so that subscriptions and missed
+	// pushes carry over without a full sync. Only used from the readPump goroutine.
+	resumeToken string
 }
 
 type Message struct {
@@ -464,21 +467,30 @@ func (c *LongConnMgr) close() error {
 	return c.conn.Close()
 }
 
-func (c *LongConnMgr) handleMessage(message []byte) error {
+func (c *LongConnMgr) decodeMessage(message []byte) (*GeneralWsResp, error) {
 	if c.IsCompression {
 		var decompressErr error
 		message, decompressErr = c.compressor.DecompressWithPool(message)
 		if decompressErr != nil {
 			log.ZError(c.ctx, "DeCompress failed", decompressErr, message)
-			return sdkerrs.ErrMsgDeCompression
+			return nil, sdkerrs.ErrMsgDeCompression
 		}
 	}
 	var wsResp GeneralWsResp
 	err := c.encoder.Decode(message, &wsResp)
 	if err != nil {
 		log.ZError(c.ctx, "decodeBinaryWs err", err, "message", message)
-		return sdkerrs.ErrMsgDecodeBinaryWs
+		return nil, sdkerrs.ErrMsgDecodeBinaryWs
+	}
+	return &wsResp, nil
+}
+
+func (c *LongConnMgr) handleMessage(message []byte) error {
+	resp, err := c.decodeMessage(message)
+	if err != nil {
+		return err
 	}
+	wsResp := *resp
 	ctx := context.WithValue(c.ctx, "operationID", wsResp.OperationID)
 	log.ZInfo(ctx, "recv msg", "errCode", wsResp.ErrCode, "errMsg", wsResp.ErrMsg,
 		"reqIdentifier", wsResp.ReqIdentifier)
@@ -521,6 +533,16 @@ func (c *LongConnMgr) handleMessage(message []byte) error {
 		if err := c.handlerUserOnlineChange(ctx, wsResp); err != nil {
 			log.ZError(ctx, "handlerUserOnlineChange failed", err, "wsResp", wsResp)
 		}
+	case constant.SessionResume:
+		if _, err := c.setResumeTips(ctx, &wsResp); err != nil {
+			log.ZError(ctx, "setResumeTips failed", err, "wsResp", wsResp)
+		}
+	case constant.ResyncMsg:
+		// the gateway dropped pushes to this connection while it could not keep up
+		log.ZWarn(ctx, "gateway dropped pushes, sync messages", nil)
+		if err := common.TriggerCmdIMMessageSync(ctx, c.pushMsgAndMaxSeqCh); err != nil {
+			log.ZError(ctx, "TriggerCmdIMMessageSync failed", err)
+		}
 	default:
 		return sdkerrs.ErrMsgBinaryTypeNotSupport
 	}
@@ -634,12 +656,18 @@ func (c *LongConnMgr) reConn(ctx context.Context, num *int) (needRecon bool, err
 	defer c.connWrite.Unlock()
 	c.listener.OnConnecting()
 	c.SetConnectionStatus(Connecting)
-	url := fmt.Sprintf("%s?sendID=%s&token=%s&platformID=%d&operationID=%s&isBackground=%t",
+	url := fmt.Sprintf("%s?sendID=%s&token=%s&platformID=%d&operationID=%s&isBackground=%t&pushAck=true&sessionResume=true",
 		ccontext.Info(ctx).WsAddr(), ccontext.Info(ctx).UserID(), ccontext.Info(ctx).Token(),
 		ccontext.Info(ctx).PlatformID(), ccontext.Info(ctx).OperationID(), c.GetBackground())
 	if c.IsCompression {
 		url += fmt.Sprintf("&compression=%s", "gzip")
 	}
+	// a token is good for one attempt, the gateway gives a new one on every connection
+	resumeToken := c.resumeToken
+	c.resumeToken = ""
+	if resumeToken != "" {
+		url += fmt.Sprintf("&resumeToken=%s", resumeToken)
+	}
 	log.ZDebug(ctx, "conn start", "url", url)
 	resp, err := c.conn.Dial(url, nil)
 	if err != nil {
@@ -677,12 +705,24 @@ func (c *LongConnMgr) reConn(ctx context.Context, num *int) (needRecon bool, err
 		c.listener.OnConnectFailed(sdkerrs.NetworkError, err.Error())
 		return true, err
 	}
-	if err := c.writeConnFirstSubMsg(ctx); err != nil {
-		log.ZError(ctx, "first write user online sub info error", err)
-		ccontext.GetApiErrCodeCallback(ctx).OnError(ctx, err)
-		c.listener.OnConnectFailed(sdkerrs.NetworkError, err.Error())
-		c.conn.Close()
-		return true, err
+	var resumed bool
+	if resumeToken != "" {
+		if resumed, err = c.readResumeTips(ctx); err != nil {
+			log.ZWarn(ctx, "read resume tips error", err)
+			c.listener.OnConnectFailed(sdkerrs.NetworkError, err.Error())
+			c.conn.Close()
+			return true, err
+		}
+	}
+	// a resumed session kept the subscriptions on the gateway
+	if !resumed {
+		if err := c.writeConnFirstSubMsg(ctx); err != nil {
+			log.ZError(ctx, "first write user online sub info error", err)
+			ccontext.GetApiErrCodeCallback(ctx).OnError(ctx, err)
+			c.listener.OnConnectFailed(sdkerrs.NetworkError, err.Error())
+			c.conn.Close()
+			return true, err
+		}
 	}
 	c.listener.OnConnectSuccess()
 	c.sub.onConnSuccess()
@@ -692,19 +732,89 @@ func (c *LongConnMgr) reConn(ctx context.Context, num *int) (needRecon bool, err
 	c.conn.SetPongHandler(c.pongHandler)
 	c.conn.SetPingHandler(c.pingHandler)
 	*num++
-	log.ZInfo(c.ctx, "long conn establish success", "localAddr", c.conn.LocalAddr(), "connNum", *num)
+	log.ZInfo(c.ctx, "long conn establish success", "localAddr", c.conn.LocalAddr(), "connNum", *num, "resumed", resumed)
 	c.reconnectStrategy.Reset()
-	_ = common.TriggerCmdConnected(ctx, c.pushMsgAndMaxSeqCh)
+	// the gateway replays the pushes missed by a resumed session, there is nothing to sync
+	if !resumed {
+		_ = common.TriggerCmdConnected(ctx, c.pushMsgAndMaxSeqCh)
+	}
 	return true, nil
 }
 
+// readResumeTips reads the answer of the gateway to the resume token presented on connect, which
+// comes before anything else on the connection, and reports whether the session was resumed.
+func (c *LongConnMgr) readResumeTips(ctx context.Context) (bool, error) {
+	c.conn.SetReadLimit(maxMessageSize)
+	_ = c.conn.SetReadDeadline(pongWait)
+	_, message, err := c.conn.ReadMessage()
+	if err != nil {
+		return false, err
+	}
+	wsResp, err := c.decodeMessage(message)
+	if err != nil {
+		return false, err
+	}
+	if wsResp.ReqIdentifier != constant.SessionResume {
+		return false, fmt.Errorf("unexpected first message %d", wsResp.ReqIdentifier)
+	}
+	return c.setResumeTips(ctx, wsResp)
+}
+
+func (c *LongConnMgr) setResumeTips(ctx context.Context, wsResp *GeneralWsResp) (bool, error) {
+	var tips sdkws.SessionResumeTips
+	if err := proto.Unmarshal(wsResp.Data, &tips); err != nil {
+		return false, err
+	}
+	log.ZDebug(ctx, "session resume tips", "resumed", tips.Resumed, "graceSeconds", tips.GraceSeconds)
+	c.resumeToken = tips.Token
+	return tips.Resumed, nil
+}
+
 func (c *LongConnMgr) doPushMsg(ctx context.Context, wsResp GeneralWsResp) error {
 	var msg sdkws.PushMessages
 	err := proto.Unmarshal(wsResp.Data, &msg)
 	if err != nil {
 		return err
 	}
-	return common.TriggerCmdPushMsg(ctx, &msg, c.pushMsgAndMaxSeqCh)
+	if err := common.TriggerCmdPushMsg(ctx, &msg, c.pushMsgAndMaxSeqCh); err != nil {
+		return err
+	}
+	return c.ackPushMsg(ctx, &msg)
+}
+
+// ackPushMsg tells the gateway that the pushed messages arrived, so that it stops retransmitting
+// them. Gateways without ack mode ignore it.
+func (c *LongConnMgr) ackPushMsg(ctx context.Context, msg *sdkws.PushMessages) error {
+	ack := sdkws.PushMsgAck{ConversationSeqs: make(map[string]*sdkws.Seqs)}
+	for _, pushMsgs := range []map[string]*sdkws.PullMsgs{msg.Msgs, msg.NotificationMsgs} {
+		for conversationID, msgs := range pushMsgs {
+			for _, m := range msgs.GetMsgs() {
+				if m.Seq == 0 {
+					continue
+				}
+				seqs, ok := ack.ConversationSeqs[conversationID]
+				if !ok {
+					seqs = &sdkws.Seqs{}
+					ack.ConversationSeqs[conversationID] = seqs
+				}
+				seqs.Seqs = append(seqs.Seqs, m.Seq)
+			}
+		}
+	}
+	if len(ack.ConversationSeqs) == 0 {
+		return nil
+	}
+	data, err := proto.Marshal(&ack)
+	if err != nil {
+		return err
+	}
+	return c.writeBinaryMsg(GeneralWsReq{
+		ReqIdentifier: constant.PushMsgAck,
+		SendID:        ccontext.Info(ctx).UserID(),
+		OperationID:   utils.OperationIDGenerator(),
+		MsgIncr:       utils.OperationIDGenerator(),
+		Data:          data,
+	})
 }
 func (c *LongConnMgr) Close(ctx context.Context) {
 	if c.GetConnectionStatus() == Connected {
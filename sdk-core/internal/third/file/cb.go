@@ -60,3 +60,11 @@ func (e emptyUploadCallback) UploadComplete(fileSize int64, streamSize int64, st
 func (e emptyUploadCallback) Complete(size int64, url string, typ int) {
 	fmt.Println("Callback Complete:", size, url, typ)
 }
+
+type DownloadFileCallback interface {
+	OnProgress(current int64, size int64) // downloaded bytes, size is 0 if unknown
+}
+
+type emptyDownloadCallback struct{}
+
+func (e emptyDownloadCallback) OnProgress(current int64, size int64) {}
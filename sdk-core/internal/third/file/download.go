@@ -0,0 +1,437 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+const (
+	DefaultDownloadCacheSize  = 1 << 30 // 1GB
+	defaultDownloadConcurrent = 3
+	downloadRetry             = 3
+	partSuffix                = ".part"
+	metaSuffix                = ".meta"
+	stalePartAge              = 7 * 24 * time.Hour
+)
+
+type DownloadFileReq struct {
+	URL string `json:"url"`
+	// Name gives the cached file its extension when the URL has none.
+	Name string `json:"name"`
+	// Size and MD5 (hex) are checked when set, as recorded in the message elem.
+	Size int64  `json:"size"`
+	MD5  string `json:"md5"`
+}
+
+type DownloadFileResp struct {
+	FilePath string `json:"filePath"`
+}
+
+// downloadMeta is kept next to a partial download so that it can resume after a restart,
+// as long as the object has not changed on the server.
+type downloadMeta struct {
+	URL       string `json:"url"`
+	Validator string `json:"validator"` // ETag or Last-Modified, sent as If-Range
+	Size      int64  `json:"size"`
+	MD5       string `json:"md5"`
+}
+
+// Downloader downloads files into a size bounded cache directory. Files are named after their
+// URL, so a file already downloaded is not fetched again; the least recently used files are
+// removed once the cache grows beyond its size.
+type Downloader struct {
+	cacheDir    string
+	cacheSize   int64
+	limit       chan struct{}
+	evictLock   sync.Mutex
+	mapLocker   sync.Locker
+	downloading map[string]*lockInfo
+}
+
+func NewDownloader(cacheDir string, cacheSize int64) *Downloader {
+	if cacheSize <= 0 {
+		cacheSize = DefaultDownloadCacheSize
+	}
+	return &Downloader{
+		cacheDir:    cacheDir,
+		cacheSize:   cacheSize,
+		limit:       make(chan struct{}, defaultDownloadConcurrent),
+		mapLocker:   &sync.Mutex{},
+		downloading: make(map[string]*lockInfo),
+	}
+}
+
+func (d *Downloader) lockKey(key string) {
+	d.mapLocker.Lock()
+	locker, ok := d.downloading[key]
+	if !ok {
+		locker = &lockInfo{count: 0, locker: &sync.Mutex{}}
+		d.downloading[key] = locker
+	}
+	atomic.AddInt32(&locker.count, 1)
+	d.mapLocker.Unlock()
+	locker.locker.Lock()
+}
+
+func (d *Downloader) unlockKey(key string) {
+	d.mapLocker.Lock()
+	locker, ok := d.downloading[key]
+	if !ok {
+		d.mapLocker.Unlock()
+		return
+	}
+	if atomic.AddInt32(&locker.count, -1) == 0 {
+		delete(d.downloading, key)
+	}
+	d.mapLocker.Unlock()
+	locker.locker.Unlock()
+}
+
+// CachePath is where the file of rawURL is cached.
+func (d *Downloader) CachePath(rawURL string, name string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	ext := path.Ext(name)
+	if u, err := url.Parse(rawURL); err == nil && ext == "" {
+		ext = path.Ext(u.Path)
+	}
+	if len(ext) > 16 {
+		ext = ""
+	}
+	return filepath.Join(d.cacheDir, hex.EncodeToString(sum[:16])+ext)
+}
+
+// DownloadFile returns the cached file of req.URL, downloading it first if needed. Downloads
+// of the same URL are done once; an interrupted download resumes from where it stopped.
+func (d *Downloader) DownloadFile(ctx context.Context, req *DownloadFileReq, cb DownloadFileCallback) (*DownloadFileResp, error) {
+	if cb == nil {
+		cb = emptyDownloadCallback{}
+	}
+	if req.URL == "" {
+		return nil, errs.New("url is empty").Wrap()
+	}
+	filePath := d.CachePath(req.URL, req.Name)
+	d.lockKey(filePath)
+	defer d.unlockKey(filePath)
+	if size, ok := d.cached(filePath, req.Size); ok {
+		cb.OnProgress(size, size)
+		return &DownloadFileResp{FilePath: filePath}, nil
+	}
+	select {
+	case d.limit <- struct{}{}:
+		defer func() { <-d.limit }()
+	case <-ctx.Done():
+		return nil, errs.Wrap(ctx.Err())
+	}
+	if err := os.MkdirAll(d.cacheDir, 0755); err != nil {
+		return nil, errs.WrapMsg(err, "create download cache dir failed")
+	}
+	if err := d.download(ctx, req, filePath, cb); err != nil {
+		return nil, err
+	}
+	d.evict(ctx, filePath)
+	return &DownloadFileResp{FilePath: filePath}, nil
+}
+
+// StoreFile moves a local file that was uploaded as rawURL into the cache, so that it counts
+// toward the cache size and later downloads of rawURL find it. It returns the new path.
+func (d *Downloader) StoreFile(ctx context.Context, localPath string, rawURL string, name string) (string, error) {
+	filePath := d.CachePath(rawURL, name)
+	d.lockKey(filePath)
+	defer d.unlockKey(filePath)
+	if err := os.MkdirAll(d.cacheDir, 0755); err != nil {
+		return "", errs.WrapMsg(err, "create download cache dir failed")
+	}
+	if err := os.Rename(localPath, filePath); err != nil {
+		return "", errs.WrapMsg(err, "move file into download cache failed", "path", localPath)
+	}
+	now := time.Now()
+	_ = os.Chtimes(filePath, now, now)
+	d.evict(ctx, filePath)
+	return filePath, nil
+}
+
+// cached reports whether filePath is complete and marks it as recently used.
+func (d *Downloader) cached(filePath string, size int64) (int64, bool) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return 0, false
+	}
+	if size > 0 && info.Size() != size {
+		_ = os.Remove(filePath)
+		return 0, false
+	}
+	now := time.Now()
+	_ = os.Chtimes(filePath, now, now)
+	return info.Size(), true
+}
+
+func (d *Downloader) download(ctx context.Context, req *DownloadFileReq, filePath string, cb DownloadFileCallback) error {
+	partPath := filePath + partSuffix
+	metaPath := filePath + metaSuffix
+	meta := loadDownloadMeta(metaPath)
+	if meta == nil || meta.URL != req.URL || meta.Validator == "" {
+		meta = &downloadMeta{URL: req.URL}
+		_ = os.Remove(partPath)
+	}
+	for attempt := 1; ; attempt++ {
+		retry, err := d.fetch(ctx, req, partPath, metaPath, meta, cb)
+		if err == nil {
+			break
+		}
+		if !retry || attempt >= downloadRetry || ctx.Err() != nil {
+			return err
+		}
+		log.ZWarn(ctx, "download interrupted, resume", err, "url", req.URL, "attempt", attempt)
+		select {
+		case <-time.After(time.Duration(attempt) * time.Second):
+		case <-ctx.Done():
+			return errs.Wrap(ctx.Err())
+		}
+	}
+	if err := verifyDownload(partPath, req, meta); err != nil {
+		_ = os.Remove(partPath)
+		_ = os.Remove(metaPath)
+		return err
+	}
+	if err := os.Rename(partPath, filePath); err != nil {
+		return errs.WrapMsg(err, "rename downloaded file failed")
+	}
+	_ = os.Remove(metaPath)
+	return nil
+}
+
+// fetch downloads the rest of the file into partPath. retry reports whether a failure is
+// worth another attempt, e.g. a dropped connection, as opposed to a missing object.
+func (d *Downloader) fetch(ctx context.Context, req *DownloadFileReq, partPath string, metaPath string, meta *downloadMeta, cb DownloadFileCallback) (retry bool, err error) {
+	var offset int64
+	if info, err := os.Stat(partPath); err == nil {
+		offset = info.Size()
+	}
+	if offset > 0 && meta.Validator == "" {
+		// without a validator the server cannot tell whether the part is still current
+		_ = os.Remove(partPath)
+		offset = 0
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, req.URL, nil)
+	if err != nil {
+		return false, errs.WrapMsg(err, "new download request failed", "url", req.URL)
+	}
+	if offset > 0 {
+		httpReq.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+		httpReq.Header.Set("If-Range", meta.Validator)
+	}
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return true, errs.WrapMsg(err, "download request failed", "url", req.URL)
+	}
+	defer resp.Body.Close()
+	flag := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	switch resp.StatusCode {
+	case http.StatusOK:
+		offset = 0
+		flag |= os.O_TRUNC
+		meta.Size = max(resp.ContentLength, 0)
+	case http.StatusPartialContent:
+		start, total, ok := parseContentRange(resp.Header.Get("Content-Range"))
+		if !ok || start != offset {
+			_ = os.Remove(partPath)
+			return true, errs.New("unexpected content range", "contentRange", resp.Header.Get("Content-Range"), "offset", offset).Wrap()
+		}
+		meta.Size = total
+	case http.StatusRequestedRangeNotSatisfiable:
+		if meta.Size > 0 && offset == meta.Size {
+			return false, nil
+		}
+		_ = os.Remove(partPath)
+		return true, errs.New("download range not satisfiable", "offset", offset).Wrap()
+	default:
+		return resp.StatusCode >= http.StatusInternalServerError, errs.New("download failed", "url", req.URL, "status", resp.Status).Wrap()
+	}
+	if resp.StatusCode == http.StatusOK || meta.Validator == "" {
+		meta.Validator = resp.Header.Get("ETag")
+		if meta.Validator == "" || strings.HasPrefix(meta.Validator, "W/") {
+			meta.Validator = resp.Header.Get("Last-Modified")
+		}
+		meta.MD5 = contentMD5(resp.Header.Get("Content-MD5"))
+	}
+	if err := saveDownloadMeta(metaPath, meta); err != nil {
+		return false, err
+	}
+	part, err := os.OpenFile(partPath, flag, 0644)
+	if err != nil {
+		return false, errs.WrapMsg(err, "open download part failed")
+	}
+	defer part.Close()
+	progress := &downloadProgress{cb: cb, size: meta.Size}
+	progress.report(offset)
+	_, err = io.Copy(part, NewProgressReader(resp.Body, func(current int64) {
+		progress.report(offset + current)
+	}))
+	if err != nil {
+		return true, errs.WrapMsg(err, "download body failed", "url", req.URL)
+	}
+	return false, nil
+}
+
+// evict removes the least recently used files until the cache fits its size, and partial
+// downloads abandoned for a long time. keep has just been downloaded.
+func (d *Downloader) evict(ctx context.Context, keep string) {
+	d.evictLock.Lock()
+	defer d.evictLock.Unlock()
+	entries, err := os.ReadDir(d.cacheDir)
+	if err != nil {
+		log.ZWarn(ctx, "read download cache dir failed", err)
+		return
+	}
+	type cachedFile struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var (
+		files []cachedFile
+		total int64
+	)
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || entry.IsDir() {
+			continue
+		}
+		filePath := filepath.Join(d.cacheDir, entry.Name())
+		if strings.HasSuffix(entry.Name(), partSuffix) || strings.HasSuffix(entry.Name(), metaSuffix) {
+			if time.Since(info.ModTime()) > stalePartAge {
+				_ = os.Remove(filePath)
+			}
+			continue
+		}
+		files = append(files, cachedFile{path: filePath, size: info.Size(), modTime: info.ModTime()})
+		total += info.Size()
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+	for _, file := range files {
+		if total <= d.cacheSize {
+			break
+		}
+		if file.path == keep {
+			continue
+		}
+		if err := os.Remove(file.path); err != nil {
+			log.ZWarn(ctx, "remove cached file failed", err, "path", file.path)
+			continue
+		}
+		total -= file.size
+	}
+}
+
+func verifyDownload(partPath string, req *DownloadFileReq, meta *downloadMeta) error {
+	part, err := os.Open(partPath)
+	if err != nil {
+		return errs.WrapMsg(err, "open download part failed")
+	}
+	defer part.Close()
+	hash := md5.New()
+	size, err := io.Copy(hash, part)
+	if err != nil {
+		return errs.WrapMsg(err, "read download part failed")
+	}
+	if meta.Size > 0 && size != meta.Size {
+		return errs.New("downloaded size mismatch", "size", size, "expected", meta.Size).Wrap()
+	}
+	if req.Size > 0 && size != req.Size {
+		return errs.New("downloaded size mismatch", "size", size, "expected", req.Size).Wrap()
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	for _, expected := range []string{req.MD5, meta.MD5} {
+		if expected != "" && !strings.EqualFold(expected, sum) {
+			return errs.New("downloaded md5 mismatch", "md5", sum, "expected", expected).Wrap()
+		}
+	}
+	return nil
+}
+
+func loadDownloadMeta(metaPath string) *downloadMeta {
+	data, err := os.ReadFile(metaPath)
+	if err != nil {
+		return nil
+	}
+	var meta downloadMeta
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil
+	}
+	return &meta
+}
+
+func saveDownloadMeta(metaPath string, meta *downloadMeta) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.WrapMsg(os.WriteFile(metaPath, data, 0644), "save download meta failed")
+}
+
+// parseContentRange parses "bytes start-end/total".
+func parseContentRange(contentRange string) (start int64, total int64, ok bool) {
+	var end int64
+	if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/%d", &start, &end, &total); err != nil {
+		return 0, 0, false
+	}
+	return start, total, end < total
+}
+
+// contentMD5 converts a Content-MD5 header, base64 of the digest, to hex.
+func contentMD5(header string) string {
+	digest, err := base64.StdEncoding.DecodeString(header)
+	if err != nil || len(digest) != md5.Size {
+		return ""
+	}
+	return hex.EncodeToString(digest)
+}
+
+// downloadProgress reports at most once per percent.
+type downloadProgress struct {
+	cb       DownloadFileCallback
+	size     int64
+	reported int64
+}
+
+func (p *downloadProgress) report(current int64) {
+	if p.size > 0 && current < p.size && current-p.reported < p.size/100 {
+		return
+	}
+	p.reported = current
+	p.cb.OnProgress(current, p.size)
+}
@@ -0,0 +1,112 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newFileServer(t *testing.T, content []byte) (*httptest.Server, *int32) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("ETag", `"v1"`)
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestDownloadFile(t *testing.T) {
+	ctx := context.Background()
+	content := bytes.Repeat([]byte("0123456789"), 10000)
+	sum := md5.Sum(content)
+	srv, requests := newFileServer(t, content)
+	d := NewDownloader(t.TempDir(), 0)
+	req := &DownloadFileReq{URL: srv.URL + "/object/a.jpg", Size: int64(len(content)), MD5: hex.EncodeToString(sum[:])}
+
+	// an interrupted download left a part behind
+	filePath := d.CachePath(req.URL, "")
+	if err := os.MkdirAll(d.cacheDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filePath+partSuffix, content[:4000], 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveDownloadMeta(filePath+metaSuffix, &downloadMeta{URL: req.URL, Validator: `"v1"`, Size: int64(len(content))}); err != nil {
+		t.Fatal(err)
+	}
+	var first int64 = -1
+	resp, err := d.DownloadFile(ctx, req, progressFunc(func(current, size int64) {
+		if first < 0 {
+			first = current
+		}
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != 4000 {
+		t.Errorf("download did not resume, first progress %d", first)
+	}
+	if !strings.HasSuffix(resp.FilePath, ".jpg") {
+		t.Errorf("cache path %s lost the extension", resp.FilePath)
+	}
+	data, err := os.ReadFile(resp.FilePath)
+	if err != nil || !bytes.Equal(data, content) {
+		t.Fatalf("downloaded file differs: %v", err)
+	}
+
+	// served from the cache
+	if _, err := d.DownloadFile(ctx, req, nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(requests); n != 1 {
+		t.Errorf("%d requests, want 1", n)
+	}
+
+	bad := &DownloadFileReq{URL: srv.URL + "/object/b.jpg", MD5: strings.Repeat("0", 32)}
+	if _, err := d.DownloadFile(ctx, bad, nil); err == nil {
+		t.Error("md5 mismatch not detected")
+	}
+	if _, err := os.Stat(d.CachePath(bad.URL, "") + partSuffix); !os.IsNotExist(err) {
+		t.Error("part of a corrupt download kept")
+	}
+}
+
+func TestDownloadEvict(t *testing.T) {
+	ctx := context.Background()
+	content := bytes.Repeat([]byte("x"), 1000)
+	srv, _ := newFileServer(t, content)
+	d := NewDownloader(t.TempDir(), 2500)
+	var paths []string
+	for _, name := range []string{"a", "b", "c"} {
+		resp, err := d.DownloadFile(ctx, &DownloadFileReq{URL: srv.URL + "/" + name}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, resp.FilePath)
+		// mtime is the recency, keep the order unambiguous
+		past := time.Now().Add(time.Duration(len(paths)-10) * time.Minute)
+		_ = os.Chtimes(resp.FilePath, past, past)
+	}
+	if _, err := os.Stat(paths[0]); !os.IsNotExist(err) {
+		t.Error("least recently used file not evicted")
+	}
+	for _, p := range paths[1:] {
+		if _, err := os.Stat(p); err != nil {
+			t.Errorf("recent file evicted: %v", err)
+		}
+	}
+}
+
+type progressFunc func(current, size int64)
+
+func (f progressFunc) OnProgress(current, size int64) { f(current, size) }
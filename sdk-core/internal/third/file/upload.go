@@ -47,6 +47,7 @@ type UploadFileReq struct {
 
 type UploadFileResp struct {
 	URL string `json:"url"`
+	MD5 string `json:"md5"` // hex md5 of the whole file
 }
 
 type partInfo struct {
@@ -159,6 +160,7 @@ func (f *File) UploadFile(ctx context.Context, req *UploadFileReq, cb UploadFile
 		cb.Complete(fileSize, uploadInfo.Resp.Url, 0)
 		return &UploadFileResp{
 			URL: uploadInfo.Resp.Url,
+			MD5: info.FileMd5,
 		}, nil
 	}
 	if uploadInfo.Resp.Upload.PartSize != partSize {
@@ -231,6 +233,7 @@ func (f *File) UploadFile(ctx context.Context, req *UploadFileReq, cb UploadFile
 	}
 	return &UploadFileResp{
 		URL: resp.Url,
+		MD5: info.FileMd5,
 	}, nil
 }
 
@@ -2,12 +2,19 @@ package third
 
 import (
 	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/internal/third/file"
 )
 
 type Progress interface {
 	OnProgress(current int64, size int64)
 }
 
+// NewUploadProgress reports the bytes sent by a file upload to p.
+func NewUploadProgress(ctx context.Context, p Progress) file.UploadFileCallback {
+	return &progressConvert{ctx: ctx, p: p}
+}
+
 type progressConvert struct {
 	ctx context.Context
 	p   Progress
@@ -2,7 +2,9 @@ package user
 
 import (
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
 	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/user"
 
 	"github.com/openimsdk/protocol/sdkws"
@@ -37,3 +39,25 @@ func LocalUserToPublicUser(user *model_struct.LocalUser) *sdk_struct.PublicUser
 		CreateTime: user.CreateTime,
 	}
 }
+
+// serverFavoriteToLocalFavorite keeps the message snapshot in the same MsgStruct form as the chat logs.
+func (u *User) serverFavoriteToLocalFavorite(favorite *msg.FavoriteInfo) *model_struct.LocalFavorite {
+	localFavorite := &model_struct.LocalFavorite{
+		FavoriteID:     favorite.FavoriteID,
+		ConversationID: favorite.ConversationID,
+		Seq:            favorite.Seq,
+		Attachments:    utils.StructToJsonString(favorite.Attachments),
+		Tags:           favorite.Tags,
+		Note:           favorite.Note,
+		CreateTime:     favorite.CreateTime,
+		UpdateTime:     favorite.UpdateTime,
+	}
+	if favorite.Msg != nil {
+		if u.msgConverter != nil {
+			localFavorite.Msg = utils.StructToJsonString(u.msgConverter(favorite.Msg))
+		} else {
+			localFavorite.Msg = utils.StructToJsonString(favorite.Msg)
+		}
+	}
+	return localFavorite
+}
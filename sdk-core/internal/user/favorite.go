@@ -0,0 +1,187 @@
+package user
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/syncer"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const favoriteSyncPageSize = 100
+
+func (u *User) initFavoriteSyncer() {
+	u.favoriteSyncer = syncer.New[*model_struct.LocalFavorite, syncer.NoResp, string](
+		func(ctx context.Context, value *model_struct.LocalFavorite) error {
+			return u.DataBase.InsertFavorite(ctx, value)
+		},
+		func(ctx context.Context, value *model_struct.LocalFavorite) error {
+			return u.DataBase.DeleteFavorite(ctx, value.FavoriteID)
+		},
+		func(ctx context.Context, server, local *model_struct.LocalFavorite) error {
+			return u.DataBase.UpdateFavorite(ctx, server)
+		},
+		func(value *model_struct.LocalFavorite) string {
+			return value.FavoriteID
+		},
+		nil,
+		func(ctx context.Context, state int, server, local *model_struct.LocalFavorite) error {
+			if u.listener == nil {
+				return nil
+			}
+			switch state {
+			case syncer.Insert:
+				u.listener().OnFavoriteAdded(utils.StructToJsonString(server))
+			case syncer.Delete:
+				u.listener().OnFavoriteDeleted(utils.StructToJsonString(local))
+			case syncer.Update:
+				u.listener().OnFavoriteUpdated(utils.StructToJsonString(server))
+			}
+			return nil
+		},
+	)
+}
+
+// IncrSyncFavorites pulls the favorite changes since the local version.
+func (u *User) IncrSyncFavorites(ctx context.Context) error {
+	favoriteSyncer := syncer.VersionSynchronizer[*model_struct.LocalFavorite, *msg.GetIncrementalFavoritesResp]{
+		Ctx:       ctx,
+		DB:        u.DataBase,
+		TableName: model_struct.LocalFavorite{}.TableName(),
+		EntityID:  u.loginUserID,
+		Key: func(localFavorite *model_struct.LocalFavorite) string {
+			return localFavorite.FavoriteID
+		},
+		Local: func() ([]*model_struct.LocalFavorite, error) {
+			return u.DataBase.GetAllFavorites(ctx)
+		},
+		Server: func(version *model_struct.LocalVersionSync) (*msg.GetIncrementalFavoritesResp, error) {
+			return u.getIncrementalFavorites(ctx, &msg.GetIncrementalFavoritesReq{
+				UserID:    u.loginUserID,
+				Version:   version.Version,
+				VersionID: version.VersionID,
+			})
+		},
+		Full: func(resp *msg.GetIncrementalFavoritesResp) bool {
+			return resp.Full
+		},
+		Version: func(resp *msg.GetIncrementalFavoritesResp) (string, uint64) {
+			return resp.VersionID, resp.Version
+		},
+		Delete: func(resp *msg.GetIncrementalFavoritesResp) []string {
+			return resp.Delete
+		},
+		Update: func(resp *msg.GetIncrementalFavoritesResp) []*model_struct.LocalFavorite {
+			return datautil.Batch(u.serverFavoriteToLocalFavorite, resp.Update)
+		},
+		Insert: func(resp *msg.GetIncrementalFavoritesResp) []*model_struct.LocalFavorite {
+			return datautil.Batch(u.serverFavoriteToLocalFavorite, resp.Insert)
+		},
+		Syncer: func(server, local []*model_struct.LocalFavorite) error {
+			return u.favoriteSyncer.Sync(ctx, server, local, nil)
+		},
+		FullSyncer: func(ctx context.Context) error {
+			return u.fullSyncFavorites(ctx)
+		},
+		FullID: func(ctx context.Context) ([]string, error) {
+			resp, err := u.getFullFavoriteIDs(ctx, &msg.GetFullFavoriteIDsReq{UserID: u.loginUserID})
+			if err != nil {
+				return nil, err
+			}
+			return resp.FavoriteIDs, nil
+		},
+	}
+	return favoriteSyncer.IncrementalSync()
+}
+
+func (u *User) fullSyncFavorites(ctx context.Context) error {
+	var server []*model_struct.LocalFavorite
+	for page := int32(1); ; page++ {
+		resp, err := u.searchFavorites(ctx, &msg.SearchFavoritesReq{
+			UserID:     u.loginUserID,
+			Pagination: &sdkws.RequestPagination{PageNumber: page, ShowNumber: favoriteSyncPageSize},
+		})
+		if err != nil {
+			return err
+		}
+		server = append(server, datautil.Batch(u.serverFavoriteToLocalFavorite, resp.Favorites)...)
+		if len(resp.Favorites) < favoriteSyncPageSize || int64(len(server)) >= resp.Total {
+			break
+		}
+	}
+	local, err := u.DataBase.GetAllFavorites(ctx)
+	if err != nil {
+		return err
+	}
+	return u.favoriteSyncer.Sync(ctx, server, local, nil)
+}
+
+// AddFavorite saves a snapshot of the message at seq in the conversation.
+func (u *User) AddFavorite(ctx context.Context, conversationID string, seq int64, tags []string, note string) (*model_struct.LocalFavorite, error) {
+	resp, err := u.addFavorite(ctx, &msg.AddFavoriteReq{
+		UserID:         u.loginUserID,
+		ConversationID: conversationID,
+		Seq:            seq,
+		Tags:           tags,
+		Note:           note,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := u.IncrSyncFavorites(ctx); err != nil {
+		return nil, err
+	}
+	return u.serverFavoriteToLocalFavorite(resp.Favorite), nil
+}
+
+func (u *User) DeleteFavorites(ctx context.Context, favoriteIDs []string) error {
+	if err := u.deleteFavorites(ctx, &msg.DeleteFavoritesReq{UserID: u.loginUserID, FavoriteIDs: favoriteIDs}); err != nil {
+		return err
+	}
+	return u.IncrSyncFavorites(ctx)
+}
+
+// UpdateFavorite replaces the tags and the note of a favorite.
+func (u *User) UpdateFavorite(ctx context.Context, favoriteID string, tags []string, note string) error {
+	if err := u.updateFavorite(ctx, &msg.UpdateFavoriteReq{
+		UserID:     u.loginUserID,
+		FavoriteID: favoriteID,
+		Tags:       tags,
+		Note:       note,
+	}); err != nil {
+		return err
+	}
+	return u.IncrSyncFavorites(ctx)
+}
+
+// GetFavorites reads a page of the local favorites, the latest first.
+func (u *User) GetFavorites(ctx context.Context, offset, count int) ([]*model_struct.LocalFavorite, error) {
+	return u.DataBase.GetFavoriteList(ctx, offset, count)
+}
+
+// SearchFavoritesResult is a page of the favorites found on the server.
+type SearchFavoritesResult struct {
+	Total     int64                         `json:"total"`
+	Favorites []*model_struct.LocalFavorite `json:"favorites"`
+}
+
+// SearchFavorites matches the keyword against the message text and the note on the server,
+// a favorite must carry all the given tags.
+func (u *User) SearchFavorites(ctx context.Context, keyword string, tags []string, pageNumber, showNumber int32) (*SearchFavoritesResult, error) {
+	resp, err := u.searchFavorites(ctx, &msg.SearchFavoritesReq{
+		UserID:     u.loginUserID,
+		Keyword:    keyword,
+		Tags:       tags,
+		Pagination: &sdkws.RequestPagination{PageNumber: pageNumber, ShowNumber: showNumber},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SearchFavoritesResult{
+		Total:     resp.Total,
+		Favorites: datautil.Batch(u.serverFavoriteToLocalFavorite, resp.Favorites),
+	}, nil
+}
@@ -29,6 +29,8 @@ func (u *User) doNotification(ctx context.Context, msg *sdkws.MsgData) error {
 		return u.userCommandDeleteNotification(ctx, msg)
 	case constant.UserCommandUpdateNotification:
 		return u.userCommandUpdateNotification(ctx, msg)
+	case constant.UserFavoriteChangedNotification:
+		return u.favoritesChangedNotification(ctx, msg)
 	default:
 		return errs.New("unknown content type", "contentType", msg.ContentType, "clientMsgID", msg.ClientMsgID, "serverMsgID", msg.ServerMsgID).Wrap()
 	}
@@ -93,3 +95,16 @@ func (u *User) userCommandUpdateNotification(ctx context.Context, msg *sdkws.Msg
 	}
 	return nil
 }
+
+// favoritesChangedNotification handles notification when favorites change on any of the user's devices
+func (u *User) favoritesChangedNotification(ctx context.Context, msg *sdkws.MsgData) error {
+	tips := sdkws.FavoritesChangedTips{}
+	if err := utils.UnmarshalNotificationElem(msg.Content, &tips); err != nil {
+		return err
+	}
+	if tips.ToUserID != u.loginUserID {
+		log.ZDebug(ctx, "ToUserID != u.loginUserID, do nothing", "detail.UserID", tips.ToUserID, "u.loginUserID", u.loginUserID)
+		return nil
+	}
+	return u.IncrSyncFavorites(ctx)
+}
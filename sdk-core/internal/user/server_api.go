@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/api"
+	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/protocol/user"
 )
@@ -33,3 +34,27 @@ func (u *User) processUserCommandUpdate(ctx context.Context, req *user.ProcessUs
 func (u *User) processUserCommandGetAll(ctx context.Context, req *user.ProcessUserCommandGetAllReq) (*user.ProcessUserCommandGetAllResp, error) {
 	return api.ProcessUserCommandGetAll.Invoke(ctx, req)
 }
+
+func (u *User) addFavorite(ctx context.Context, req *msg.AddFavoriteReq) (*msg.AddFavoriteResp, error) {
+	return api.AddFavorite.Invoke(ctx, req)
+}
+
+func (u *User) deleteFavorites(ctx context.Context, req *msg.DeleteFavoritesReq) error {
+	return api.DeleteFavorites.Execute(ctx, req)
+}
+
+func (u *User) updateFavorite(ctx context.Context, req *msg.UpdateFavoriteReq) error {
+	return api.UpdateFavorite.Execute(ctx, req)
+}
+
+func (u *User) searchFavorites(ctx context.Context, req *msg.SearchFavoritesReq) (*msg.SearchFavoritesResp, error) {
+	return api.SearchFavorites.Invoke(ctx, req)
+}
+
+func (u *User) getIncrementalFavorites(ctx context.Context, req *msg.GetIncrementalFavoritesReq) (*msg.GetIncrementalFavoritesResp, error) {
+	return api.GetIncrementalFavorites.Invoke(ctx, req)
+}
+
+func (u *User) getFullFavoriteIDs(ctx context.Context, req *msg.GetFullFavoriteIDsReq) (*msg.GetFullFavoriteIDsResp, error) {
+	return api.GetFullFavoriteIDs.Invoke(ctx, req)
+}
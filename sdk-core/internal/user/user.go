@@ -29,6 +29,8 @@ import (
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/syncer"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/utils/datautil"
 )
 
@@ -53,7 +55,9 @@ type User struct {
 	listener       func() open_im_sdk_callback.OnUserListener
 	userSyncer     *syncer.Syncer[*model_struct.LocalUser, syncer.NoResp, string]
 	commandSyncer  *syncer.Syncer[*model_struct.LocalUserCommand, syncer.NoResp, string]
+	favoriteSyncer *syncer.Syncer[*model_struct.LocalFavorite, syncer.NoResp, string]
 	conversationCh chan common.Cmd2Value
+	msgConverter   func(*sdkws.MsgData) *sdk_struct.MsgStruct
 	UserCache      *cache.UserCache[string, *model_struct.LocalUser]
 
 	//OnlineStatusCache *cache.Cache[string, *userPb.OnlineStatus]
@@ -64,6 +68,11 @@ func (u *User) SetListener(listener func() open_im_sdk_callback.OnUserListener)
 	u.listener = listener
 }
 
+// SetMsgConverter sets how a server message is turned into the MsgStruct kept in the favorite snapshots.
+func (u *User) SetMsgConverter(converter func(*sdkws.MsgData) *sdk_struct.MsgStruct) {
+	u.msgConverter = converter
+}
+
 func (u *User) initSyncer() {
 	u.userSyncer = syncer.New[*model_struct.LocalUser, syncer.NoResp, string](
 		func(ctx context.Context, value *model_struct.LocalUser) error {
@@ -139,6 +148,7 @@ func (u *User) initSyncer() {
 			return nil
 		},
 	)
+	u.initFavoriteSyncer()
 }
 
 func (u *User) GetUserInfoWithCache(ctx context.Context, cacheKey string) (*model_struct.LocalUser, error) {
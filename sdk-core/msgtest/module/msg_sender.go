@@ -360,3 +360,12 @@ func (u *UserListener) OnUserCommandUpdate(userCommand string) {
 	//TODO implement me
 	panic("implement me")
 }
+
+func (u *UserListener) OnFavoriteAdded(favorite string) {
+}
+
+func (u *UserListener) OnFavoriteDeleted(favorite string) {
+}
+
+func (u *UserListener) OnFavoriteUpdated(favorite string) {
+}
@@ -42,6 +42,10 @@ func (c *conversationCallBack) OnConversationUserInputStatusChanged(change strin
 
 }
 
+func (c *conversationCallBack) OnConversationFoldersChanged(folderList string) {
+
+}
+
 type userCallback struct {
 }
 
@@ -60,6 +64,15 @@ func (userCallback) OnUserCommandUpdate(callbackData string) {
 }
 func (userCallback) OnUserCommandDelete(callbackData string) {
 
+}
+func (userCallback) OnFavoriteAdded(callbackData string) {
+
+}
+func (userCallback) OnFavoriteDeleted(callbackData string) {
+
+}
+func (userCallback) OnFavoriteUpdated(callbackData string) {
+
 }
 
 type SingleMessage struct {
@@ -206,6 +219,14 @@ func (testGroupListener) OnGroupApplicationRejected(callbackInfo string) {
 
 }
 
+func (testGroupListener) OnGroupAnnouncementRemind(callbackInfo string) {
+
+}
+
+func (testGroupListener) OnGroupAnnouncementConfirmed(callbackInfo string) {
+
+}
+
 func (testGroupListener) OnGroupDismissed(callbackInfo string) {
 
 }
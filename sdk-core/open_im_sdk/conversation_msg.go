@@ -87,6 +87,18 @@ func CreateVideoMessageFromFullPath(operationID string, videoFullPath string, vi
 func CreateImageMessageFromFullPath(operationID string, imageFullPath string) string {
 	return syncCall(operationID, UserForSDK.Conversation().CreateImageMessageFromFullPath, imageFullPath)
 }
+
+// CreateImageMessageWithOptions creates a picture message, processing the picture on the device
+// first as options, a JSON ImageProcessOptions, selects.
+func CreateImageMessageWithOptions(operationID string, imageFullPath string, options string) string {
+	return syncCall(operationID, UserForSDK.Conversation().CreateImageMessageWithOptions, imageFullPath, options)
+}
+
+// CreateVideoMessageWithOptions creates a video message, extracting or processing its snapshot
+// first as options, a JSON VideoProcessOptions, selects.
+func CreateVideoMessageWithOptions(operationID string, videoFullPath string, videoType string, duration int64, snapshotFullPath string, options string) string {
+	return syncCall(operationID, UserForSDK.Conversation().CreateVideoMessageWithOptions, videoFullPath, videoType, duration, snapshotFullPath, options)
+}
 func CreateSoundMessageFromFullPath(operationID string, soundPath string, duration int64) string {
 	return syncCall(operationID, UserForSDK.Conversation().CreateSoundMessageFromFullPath, soundPath, duration)
 }
@@ -258,3 +270,60 @@ func SubscribeConversationReadState(callback open_im_sdk_callback.Base, operatio
 func UnsubscribeConversationReadState(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
 	call(callback, operationID, UserForSDK.Conversation().UnsubscribeConversationReadState, conversationID)
 }
+
+// ExportLocalBackup writes the local chat history to an encrypted backup file, optionally
+// uploading it as well. The result holds the file path and the uploaded URL.
+func ExportLocalBackup(callback open_im_sdk_callback.Base, operationID string, req string, progress open_im_sdk_callback.BackupProgress) {
+	call(callback, operationID, UserForSDK.Conversation().ExportLocalBackup, req, progress)
+}
+
+// RestoreLocalBackup merges a backup file written by ExportLocalBackup into the local chat history.
+func RestoreLocalBackup(callback open_im_sdk_callback.Base, operationID string, req string, progress open_im_sdk_callback.BackupProgress) {
+	call(callback, operationID, UserForSDK.Conversation().RestoreLocalBackup, req, progress)
+}
+
+// DownloadMessageFile downloads the file of a picture, video, voice or file message into the
+// local media cache and returns its path, which is also saved in the message elem.
+func DownloadMessageFile(callback open_im_sdk_callback.Base, operationID string, req string, progress open_im_sdk_callback.DownloadFileCallback) {
+	call(callback, operationID, UserForSDK.Conversation().DownloadMessageFile, req, progress)
+}
+
+func CreateConversationFolder(callback open_im_sdk_callback.Base, operationID string, req string) {
+	call(callback, operationID, UserForSDK.Conversation().CreateConversationFolder, req)
+}
+
+func UpdateConversationFolder(callback open_im_sdk_callback.Base, operationID string, req string) {
+	call(callback, operationID, UserForSDK.Conversation().UpdateConversationFolder, req)
+}
+
+func DeleteConversationFolder(callback open_im_sdk_callback.Base, operationID string, folderID string) {
+	call(callback, operationID, UserForSDK.Conversation().DeleteConversationFolder, folderID)
+}
+
+func GetConversationFolders(callback open_im_sdk_callback.Base, operationID string) {
+	call(callback, operationID, UserForSDK.Conversation().GetConversationFolders)
+}
+
+func AddConversationsToFolder(callback open_im_sdk_callback.Base, operationID string, folderID string, conversationIDList string) {
+	call(callback, operationID, UserForSDK.Conversation().AddConversationsToFolder, folderID, conversationIDList)
+}
+
+func RemoveConversationsFromFolder(callback open_im_sdk_callback.Base, operationID string, folderID string, conversationIDList string) {
+	call(callback, operationID, UserForSDK.Conversation().RemoveConversationsFromFolder, folderID, conversationIDList)
+}
+
+// GetConversationListSplitByFolder pages the conversations of a folder, a smart folder picks
+// them by its rule.
+func GetConversationListSplitByFolder(callback open_im_sdk_callback.Base, operationID string, folderID string, offset int, count int) {
+	call(callback, operationID, UserForSDK.Conversation().GetConversationListSplitByFolder, folderID, offset, count)
+}
+
+func GetConversationFolderUnreadCounts(callback open_im_sdk_callback.Base, operationID string) {
+	call(callback, operationID, UserForSDK.Conversation().GetConversationFolderUnreadCounts)
+}
+
+// ForwardMessages forwards messages of a conversation to several conversations on the server
+// and reports the result of every target.
+func ForwardMessages(callback open_im_sdk_callback.Base, operationID string, req string) {
+	call(callback, operationID, UserForSDK.Conversation().ForwardMessages, req)
+}
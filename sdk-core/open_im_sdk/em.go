@@ -82,6 +82,14 @@ func (e *emptyGroupListener) OnGroupApplicationRejected(groupApplication string)
 	log.ZWarn(e.ctx, "GroupListener is not implemented", nil, "groupApplication", groupApplication)
 }
 
+func (e *emptyGroupListener) OnGroupAnnouncementRemind(announcementTips string) {
+	log.ZWarn(e.ctx, "GroupListener is not implemented", nil, "announcementTips", announcementTips)
+}
+
+func (e *emptyGroupListener) OnGroupAnnouncementConfirmed(confirmedTips string) {
+	log.ZWarn(e.ctx, "GroupListener is not implemented", nil, "confirmedTips", confirmedTips)
+}
+
 type emptyFriendshipListener struct {
 	ctx context.Context
 }
@@ -183,6 +191,10 @@ func (e *emptyConversationListener) OnConversationUserInputStatusChanged(change
 
 }
 
+func (e *emptyConversationListener) OnConversationFoldersChanged(folderList string) {
+
+}
+
 type emptyAdvancedMsgListener struct {
 	ctx context.Context
 }
@@ -270,6 +282,18 @@ func (e *emptyUserListener) OnUserCommandUpdate(userCommand string) {
 	log.ZWarn(e.ctx, "UserListener is not implemented", nil, "userCommand", userCommand)
 }
 
+func (e *emptyUserListener) OnFavoriteAdded(favorite string) {
+	log.ZWarn(e.ctx, "UserListener is not implemented", nil, "favorite", favorite)
+}
+
+func (e *emptyUserListener) OnFavoriteDeleted(favorite string) {
+	log.ZWarn(e.ctx, "UserListener is not implemented", nil, "favorite", favorite)
+}
+
+func (e *emptyUserListener) OnFavoriteUpdated(favorite string) {
+	log.ZWarn(e.ctx, "UserListener is not implemented", nil, "favorite", favorite)
+}
+
 func newEmptyUserListener(ctx context.Context) open_im_sdk_callback.OnUserListener {
 	return &emptyUserListener{ctx: ctx}
 }
@@ -68,6 +68,30 @@ func SetGroupMemberInfo(callback open_im_sdk_callback.Base, operationID string,
 	call(callback, operationID, UserForSDK.Group().SetGroupMemberInfo, groupMemberInfo)
 }
 
+func SetGroupAdminPermissions(callback open_im_sdk_callback.Base, operationID string, groupID string, userID string, permissions int32) {
+	call(callback, operationID, UserForSDK.Group().SetGroupAdminPermissions, groupID, userID, permissions)
+}
+
+func PublishGroupAnnouncement(callback open_im_sdk_callback.Base, operationID string, groupID string, content string, requireConfirm bool, ex string) {
+	call(callback, operationID, UserForSDK.Group().PublishGroupAnnouncement, groupID, content, requireConfirm, ex)
+}
+
+func GetGroupAnnouncements(callback open_im_sdk_callback.Base, operationID string, groupID string, pageNumber int32, showNumber int32) {
+	call(callback, operationID, UserForSDK.Group().GetGroupAnnouncements, groupID, pageNumber, showNumber)
+}
+
+func ConfirmGroupAnnouncement(callback open_im_sdk_callback.Base, operationID string, groupID string, announcementID string) {
+	call(callback, operationID, UserForSDK.Group().ConfirmGroupAnnouncement, groupID, announcementID)
+}
+
+func GetGroupAnnouncementConfirms(callback open_im_sdk_callback.Base, operationID string, groupID string, announcementID string) {
+	call(callback, operationID, UserForSDK.Group().GetGroupAnnouncementConfirms, groupID, announcementID)
+}
+
+func RemindGroupAnnouncement(callback open_im_sdk_callback.Base, operationID string, groupID string, announcementID string) {
+	call(callback, operationID, UserForSDK.Group().RemindGroupAnnouncement, groupID, announcementID)
+}
+
 //func SetGroupMemberRoleLevel(callback open_im_sdk_callback.Base, operationID string, groupID string, userID string, roleLevel int) {
 //	call(callback, operationID, UserForSDK.Group().SetGroupMemberRoleLevel, groupID, userID, roleLevel)
 //}
@@ -139,3 +163,23 @@ func AcceptGroupApplication(callback open_im_sdk_callback.Base, operationID stri
 func RefuseGroupApplication(callback open_im_sdk_callback.Base, operationID string, groupID string, fromUserID string, handleMsg string) {
 	call(callback, operationID, UserForSDK.Group().RefuseGroupApplication, groupID, fromUserID, handleMsg)
 }
+
+func CreateGroupInvite(callback open_im_sdk_callback.Base, operationID string, groupID string, expireTime int64, maxUses int32, needApproval bool, ex string) {
+	call(callback, operationID, UserForSDK.Group().CreateGroupInvite, groupID, expireTime, maxUses, needApproval, ex)
+}
+
+func GetGroupInvites(callback open_im_sdk_callback.Base, operationID string, groupID string) {
+	call(callback, operationID, UserForSDK.Group().GetGroupInvites, groupID)
+}
+
+func RevokeGroupInvite(callback open_im_sdk_callback.Base, operationID string, groupID string, token string) {
+	call(callback, operationID, UserForSDK.Group().RevokeGroupInvite, groupID, token)
+}
+
+func GetGroupInviteInfo(callback open_im_sdk_callback.Base, operationID string, token string) {
+	call(callback, operationID, UserForSDK.Group().GetGroupInviteInfo, token)
+}
+
+func JoinGroupByInvite(callback open_im_sdk_callback.Base, operationID string, token string, reqMsg string, joinSource int32, ex string) {
+	call(callback, operationID, UserForSDK.Group().JoinGroupByInvite, token, reqMsg, joinSource, ex)
+}
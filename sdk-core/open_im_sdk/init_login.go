@@ -25,6 +25,7 @@ import (
 	pbConstant "github.com/openimsdk/protocol/constant"
 
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
 	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
 	"github.com/openimsdk/openim-sdk-core/v3/version"
 
@@ -69,7 +70,11 @@ func InitSDK(listener open_im_sdk_callback.OnConnListener, operationID string, c
 		return false
 	}
 
-	log.ZInfo(ctx, "InitSDK info", "config", configArgs)
+	logConfig := configArgs
+	if logConfig.DBEncryptKey != "" {
+		logConfig.DBEncryptKey = "******"
+	}
+	log.ZInfo(ctx, "InitSDK info", "config", logConfig)
 	if listener == nil || config == "" {
 		log.ZError(ctx, "listener or config is nil", nil)
 		return false
@@ -102,6 +107,12 @@ func NetworkStatusChanged(callback open_im_sdk_callback.Base, operationID string
 	call(callback, operationID, UserForSDK.NetworkStatusChanged)
 }
 
+// ChangeDBEncryptKey re-encrypts the local database of the logged in user with newKey, an empty
+// key stores it in plaintext. The app must pass newKey as dbEncryptKey to later InitSDK calls.
+func ChangeDBEncryptKey(callback open_im_sdk_callback.Base, operationID string, newKey string) {
+	call(callback, operationID, UserForSDK.ChangeDBEncryptKey, newKey)
+}
+
 func GetLoginStatus(operationID string) int {
 	if UserForSDK == nil {
 		return constant.Uninitialized
@@ -124,6 +135,17 @@ func (u *LoginMgr) Logout(ctx context.Context) error {
 	return u.logout(ctx, false)
 }
 
+func (u *LoginMgr) ChangeDBEncryptKey(ctx context.Context, newKey string) error {
+	if u.getLoginStatus(ctx) != Logged {
+		return sdkerrs.ErrLoginOut
+	}
+	if err := u.db.ChangeEncryptKey(ctx, newKey); err != nil {
+		return err
+	}
+	u.info.DBEncryptKey = newKey
+	return nil
+}
+
 func (u *LoginMgr) SetAppBackgroundStatus(ctx context.Context, isBackground bool) error {
 	return u.setAppBackgroundStatus(ctx, isBackground)
 }
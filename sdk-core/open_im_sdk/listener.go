@@ -47,6 +47,11 @@ func SetCustomBusinessListener(listener open_im_sdk_callback.OnCustomBusinessLis
 	listenerCall(UserForSDK.SetCustomBusinessListener, listener)
 }
 
+// SetMediaProcessor sets the codecs used by the video and WebP steps of media processing.
+func SetMediaProcessor(processor open_im_sdk_callback.MediaProcessor) {
+	listenerCall(UserForSDK.SetMediaProcessor, processor)
+}
+
 func SetMessageKvInfoListener(listener open_im_sdk_callback.OnMessageKvInfoListener) {
 	listenerCall(UserForSDK.SetMessageKvInfoListener, listener)
 }
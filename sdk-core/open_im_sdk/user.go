@@ -51,3 +51,27 @@ func DeleteUserCommand(callback open_im_sdk_callback.Base, operationID string, T
 func GetAllUserCommands(callback open_im_sdk_callback.Base, operationID string, Type int32) {
 	call(callback, operationID, UserForSDK.User().ProcessUserCommandGetAll, Type)
 }
+
+// AddFavorite saves a snapshot of a message to the user's favorites
+func AddFavorite(callback open_im_sdk_callback.Base, operationID string, conversationID string, seq int64, tags string, note string) {
+	call(callback, operationID, UserForSDK.User().AddFavorite, conversationID, seq, tags, note)
+}
+
+func DeleteFavorites(callback open_im_sdk_callback.Base, operationID string, favoriteIDs string) {
+	call(callback, operationID, UserForSDK.User().DeleteFavorites, favoriteIDs)
+}
+
+// UpdateFavorite replaces the tags and the note of a favorite
+func UpdateFavorite(callback open_im_sdk_callback.Base, operationID string, favoriteID string, tags string, note string) {
+	call(callback, operationID, UserForSDK.User().UpdateFavorite, favoriteID, tags, note)
+}
+
+// GetFavorites reads the local favorites, the latest first
+func GetFavorites(callback open_im_sdk_callback.Base, operationID string, offset int, count int) {
+	call(callback, operationID, UserForSDK.User().GetFavorites, offset, count)
+}
+
+// SearchFavorites searches the favorites on the server by keyword and tags
+func SearchFavorites(callback open_im_sdk_callback.Base, operationID string, keyword string, tags string, pageNumber int32, showNumber int32) {
+	call(callback, operationID, UserForSDK.User().SearchFavorites, keyword, tags, pageNumber, showNumber)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"runtime/debug"
 	"strings"
 	"sync"
@@ -112,6 +113,7 @@ type LoginMgr struct {
 	signalingListener    open_im_sdk_callback.OnSignalingListener
 	businessListener     open_im_sdk_callback.OnCustomBusinessListener
 	msgKvListener        open_im_sdk_callback.OnMessageKvInfoListener
+	mediaProcessor       open_im_sdk_callback.MediaProcessor
 
 	conversationCh chan common.Cmd2Value
 	cmdWsCh        chan common.Cmd2Value
@@ -231,6 +233,14 @@ func (u *LoginMgr) SetUserListener(userListener open_im_sdk_callback.OnUserListe
 	u.userListener = userListener
 }
 
+func (u *LoginMgr) MediaProcessor() open_im_sdk_callback.MediaProcessor {
+	return u.mediaProcessor
+}
+
+func (u *LoginMgr) SetMediaProcessor(processor open_im_sdk_callback.MediaProcessor) {
+	u.mediaProcessor = processor
+}
+
 func (u *LoginMgr) SetCustomBusinessListener(listener open_im_sdk_callback.OnCustomBusinessListener) {
 	u.businessListener = listener
 }
@@ -334,13 +344,17 @@ func (u *LoginMgr) login(ctx context.Context, userID, token string) error {
 	u.token = token
 	u.loginUserID = userID
 	var err error
-	u.db, err = db.NewDataBase(ctx, userID, u.info.DataDir, int(u.info.LogLevel))
+	u.db, err = db.NewDataBase(ctx, userID, u.info.DataDir, int(u.info.LogLevel), u.info.DBEncryptKey)
 	if err != nil {
+		if sdkerrs.ErrDBKey.Is(err) {
+			return err
+		}
 		return sdkerrs.ErrSdkInternal.WrapMsg("init database " + err.Error())
 	}
 	u.checkSendingMessage(ctx)
 	log.ZDebug(ctx, "NewDataBase ok", "userID", userID, "dataDir", u.info.DataDir, "login cost time", time.Since(t1))
 	u.user = user.NewUser(u.db, u.loginUserID, u.conversationCh)
+	u.user.SetMsgConverter(conv.MsgDataToMsgStruct)
 	u.file = file.NewFile(u.db, u.loginUserID)
 	u.relation = relation.NewFriend(u.loginUserID, u.db, u.user, u.conversationCh)
 
@@ -350,7 +364,8 @@ func (u *LoginMgr) login(ctx context.Context, userID, token string) error {
 
 	u.msgSyncer, _ = interaction.NewMsgSyncer(ctx, u.conversationCh, u.msgSyncerCh, u.loginUserID, u.longConnMgr, u.db, 0)
 	u.conversation = conv.NewConversation(ctx, u.longConnMgr, u.db, u.conversationCh, u.msgSyncerCh,
-		u.relation, u.group, u.user, u.file)
+		u.relation, u.group, u.user, u.file,
+		file.NewDownloader(filepath.Join(u.info.DataDir, "media_cache", userID), u.info.MediaCacheSize))
 	u.setListener(ctx)
 
 	u.run(ctx)
@@ -367,6 +382,7 @@ func (u *LoginMgr) setListener(ctx context.Context) {
 	setListener(ctx, &u.advancedMsgListener, u.AdvancedMsgListener, u.conversation.SetMsgListener, newEmptyAdvancedMsgListener)
 	setListener(ctx, &u.batchMsgListener, u.BatchMsgListener, u.conversation.SetBatchMsgListener, nil)
 	setListener(ctx, &u.businessListener, u.BusinessListener, u.conversation.SetBusinessListener, newEmptyCustomBusinessListener)
+	setListener(ctx, &u.mediaProcessor, u.MediaProcessor, u.conversation.SetMediaProcessor, nil)
 }
 
 func setListener[T any](ctx context.Context, listener *T, getter func() T, setFunc func(listener func() T), newFunc func(context.Context) T) {
@@ -44,6 +44,8 @@ type OnGroupListener interface {
 	OnGroupMemberInfoChanged(groupMemberInfo string)
 	OnGroupApplicationAccepted(groupApplication string)
 	OnGroupApplicationRejected(groupApplication string)
+	OnGroupAnnouncementRemind(announcementTips string)
+	OnGroupAnnouncementConfirmed(confirmedTips string)
 }
 type OnFriendshipListener interface {
 	OnFriendApplicationAdded(friendApplication string)
@@ -65,6 +67,7 @@ type OnConversationListener interface {
 	OnConversationChanged(conversationList string)
 	OnTotalUnreadMessageCountChanged(totalUnreadCount int32)
 	OnConversationUserInputStatusChanged(change string)
+	OnConversationFoldersChanged(folderList string)
 }
 
 type OnAdvancedMsgListener interface {
@@ -89,6 +92,9 @@ type OnUserListener interface {
 	OnUserCommandAdd(userCommand string)
 	OnUserCommandDelete(userCommand string)
 	OnUserCommandUpdate(userCommand string)
+	OnFavoriteAdded(favorite string)
+	OnFavoriteDeleted(favorite string)
+	OnFavoriteUpdated(favorite string)
 }
 
 type OnCustomBusinessListener interface {
@@ -155,3 +161,22 @@ type UploadFileCallback interface {
 type UploadLogProgress interface {
 	OnProgress(current int64, size int64)
 }
+
+// DownloadFileCallback reports the bytes downloaded; size is 0 if the server does not tell.
+type DownloadFileCallback interface {
+	OnProgress(current int64, size int64)
+}
+
+// BackupProgress reports the progress of exporting or restoring a local backup.
+type BackupProgress interface {
+	OnProgress(current int64, size int64)
+}
+
+// MediaProcessor provides the media codecs the SDK does not have. Each method writes dstPath
+// and returns an empty string on success, otherwise the error message.
+type MediaProcessor interface {
+	// ExtractVideoFrame saves the first frame of a video as a JPEG or PNG.
+	ExtractVideoFrame(videoPath string, dstPath string) string
+	// EncodeWebP converts a PNG into WebP with quality from 1 to 100.
+	EncodeWebP(srcPath string, dstPath string, quality int) string
+}
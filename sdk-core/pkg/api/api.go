@@ -56,6 +56,13 @@ var (
 	SetConversationHasReadSeq        = newApi[msg.SetConversationHasReadSeqReq, msg.SetConversationHasReadSeqResp]("/msg/set_conversation_has_read_seq")
 	SendMsg                          = newApi[msg.SendMsgReq, msg.SendMsgResp]("/msg/send_msg")
 	GetServerTime                    = newApi[msg.GetServerTimeReq, msg.GetServerTimeResp]("/msg/get_server_time")
+	AddFavorite                      = newApi[msg.AddFavoriteReq, msg.AddFavoriteResp]("/msg/add_favorite")
+	DeleteFavorites                  = newApi[msg.DeleteFavoritesReq, msg.DeleteFavoritesResp]("/msg/delete_favorites")
+	UpdateFavorite                   = newApi[msg.UpdateFavoriteReq, msg.UpdateFavoriteResp]("/msg/update_favorite")
+	SearchFavorites                  = newApi[msg.SearchFavoritesReq, msg.SearchFavoritesResp]("/msg/search_favorites")
+	GetIncrementalFavorites          = newApi[msg.GetIncrementalFavoritesReq, msg.GetIncrementalFavoritesResp]("/msg/get_incremental_favorites")
+	GetFullFavoriteIDs               = newApi[msg.GetFullFavoriteIDsReq, msg.GetFullFavoriteIDsResp]("/msg/get_full_favorite_ids")
+	ForwardMsgs                      = newApi[msg.ForwardMsgsReq, msg.ForwardMsgsResp]("/msg/forward_msgs")
 )
 
 var (
@@ -79,20 +86,37 @@ var (
 	MuteGroup                      = newApi[group.MuteGroupReq, group.MuteGroupResp]("/group/mute_group")
 	CancelMuteGroup                = newApi[group.CancelMuteGroupReq, group.CancelMuteGroupResp]("/group/cancel_mute_group")
 	SetGroupMemberInfo             = newApi[group.SetGroupMemberInfoReq, group.SetGroupMemberInfoResp]("/group/set_group_member_info")
+	SetGroupAdminPermissions       = newApi[group.SetGroupAdminPermissionsReq, group.SetGroupAdminPermissionsResp]("/group/set_group_admin_permissions")
 	GetIncrementalJoinGroup        = newApi[group.GetIncrementalJoinGroupReq, group.GetIncrementalJoinGroupResp]("/group/get_incremental_join_groups")
 	GetIncrementalGroupMemberBatch = newApi[group.BatchGetIncrementalGroupMemberReq, group.BatchGetIncrementalGroupMemberResp]("/group/get_incremental_group_members_batch")
 	GetFullJoinedGroupIDs          = newApi[group.GetFullJoinGroupIDsReq, group.GetFullJoinGroupIDsResp]("/group/get_full_join_group_ids")
 	GetFullGroupMemberUserIDs      = newApi[group.GetFullGroupMemberUserIDsReq, group.GetFullGroupMemberUserIDsResp]("/group/get_full_group_member_user_ids")
+	CreateGroupInvite              = newApi[group.CreateGroupInviteReq, group.CreateGroupInviteResp]("/group/create_group_invite")
+	GetGroupInvites                = newApi[group.GetGroupInvitesReq, group.GetGroupInvitesResp]("/group/get_group_invites")
+	RevokeGroupInvite              = newApi[group.RevokeGroupInviteReq, group.RevokeGroupInviteResp]("/group/revoke_group_invite")
+	GetGroupInviteInfo             = newApi[group.GetGroupInviteInfoReq, group.GetGroupInviteInfoResp]("/group/get_group_invite_info")
+	JoinGroupByInvite              = newApi[group.JoinGroupByInviteReq, group.JoinGroupByInviteResp]("/group/join_group_by_invite")
+	PublishGroupAnnouncement       = newApi[group.PublishGroupAnnouncementReq, group.PublishGroupAnnouncementResp]("/group/publish_group_announcement")
+	GetGroupAnnouncements          = newApi[group.GetGroupAnnouncementsReq, group.GetGroupAnnouncementsResp]("/group/get_group_announcements")
+	ConfirmGroupAnnouncement       = newApi[group.ConfirmGroupAnnouncementReq, group.ConfirmGroupAnnouncementResp]("/group/confirm_group_announcement")
+	GetGroupAnnouncementConfirms   = newApi[group.GetGroupAnnouncementConfirmsReq, group.GetGroupAnnouncementConfirmsResp]("/group/get_group_announcement_confirms")
+	RemindGroupAnnouncement        = newApi[group.RemindGroupAnnouncementReq, group.RemindGroupAnnouncementResp]("/group/remind_group_announcement")
 )
 
 var (
-	GetConversations            = newApi[conversation.GetConversationsReq, conversation.GetConversationsResp]("/conversation/get_conversations")
-	GetAllConversations         = newApi[conversation.GetAllConversationsReq, conversation.GetAllConversationsResp]("/conversation/get_all_conversations")
-	SetConversations            = newApi[conversation.SetConversationsReq, conversation.SetConversationsResp]("/conversation/set_conversations")
-	GetIncrementalConversation  = newApi[conversation.GetIncrementalConversationReq, conversation.GetIncrementalConversationResp]("/conversation/get_incremental_conversations")
-	GetFullConversationIDs      = newApi[conversation.GetFullOwnerConversationIDsReq, conversation.GetFullOwnerConversationIDsResp]("/conversation/get_full_conversation_ids")
-	GetOwnerConversation        = newApi[conversation.GetOwnerConversationReq, conversation.GetOwnerConversationResp]("/conversation/get_owner_conversation")
-	GetConversationReadCursors  = newApi[conversation.GetConversationReadCursorsReq, conversation.GetConversationReadCursorsResp]("/conversation/get_conversation_read_cursors")
+	GetConversations              = newApi[conversation.GetConversationsReq, conversation.GetConversationsResp]("/conversation/get_conversations")
+	GetAllConversations           = newApi[conversation.GetAllConversationsReq, conversation.GetAllConversationsResp]("/conversation/get_all_conversations")
+	SetConversations              = newApi[conversation.SetConversationsReq, conversation.SetConversationsResp]("/conversation/set_conversations")
+	GetIncrementalConversation    = newApi[conversation.GetIncrementalConversationReq, conversation.GetIncrementalConversationResp]("/conversation/get_incremental_conversations")
+	GetFullConversationIDs        = newApi[conversation.GetFullOwnerConversationIDsReq, conversation.GetFullOwnerConversationIDsResp]("/conversation/get_full_conversation_ids")
+	GetOwnerConversation          = newApi[conversation.GetOwnerConversationReq, conversation.GetOwnerConversationResp]("/conversation/get_owner_conversation")
+	GetConversationReadCursors    = newApi[conversation.GetConversationReadCursorsReq, conversation.GetConversationReadCursorsResp]("/conversation/get_conversation_read_cursors")
+	CreateConversationFolder      = newApi[conversation.CreateConversationFolderReq, conversation.CreateConversationFolderResp]("/conversation/create_folder")
+	UpdateConversationFolder      = newApi[conversation.UpdateConversationFolderReq, conversation.UpdateConversationFolderResp]("/conversation/update_folder")
+	DeleteConversationFolder      = newApi[conversation.DeleteConversationFolderReq, conversation.DeleteConversationFolderResp]("/conversation/delete_folder")
+	GetConversationFolders        = newApi[conversation.GetConversationFoldersReq, conversation.GetConversationFoldersResp]("/conversation/get_folders")
+	AddConversationsToFolder      = newApi[conversation.AddConversationsToFolderReq, conversation.AddConversationsToFolderResp]("/conversation/add_conversations_to_folder")
+	RemoveConversationsFromFolder = newApi[conversation.RemoveConversationsFromFolderReq, conversation.RemoveConversationsFromFolderResp]("/conversation/remove_conversations_from_folder")
 )
 
 var (
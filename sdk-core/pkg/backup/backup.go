@@ -0,0 +1,342 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backup reads and writes local chat history backups. A backup is a versioned header
+// followed by gzip compressed JSON records, encrypted with AES-GCM in chunks so that files of
+// any size can be streamed. The key is derived from a password chosen by the user.
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/tools/errs"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// FormatVersion is the version written to new backups. Backups of a newer version are rejected.
+const FormatVersion = 1
+
+const (
+	magic           = "OIMBAK"
+	saltSize        = 16
+	noncePrefixSize = 4
+	headerSize      = len(magic) + 2 + 4 + saltSize + noncePrefixSize
+	keySize         = 32
+	kdfIterations   = 100000
+	// bounds of the iteration count read from a backup, so a crafted header can neither weaken
+	// the key derivation nor make it run for minutes
+	minKdfIterations = kdfIterations / 10
+	maxKdfIterations = kdfIterations * 10
+	chunkSize        = 64 << 10
+	finalChunk       = 1 << 31
+)
+
+const (
+	RecordManifest     = "manifest"
+	RecordConversation = "conversation"
+	RecordMessages     = "messages"
+	RecordReadCursors  = "readCursors"
+	RecordReadState    = "readState"
+)
+
+// Manifest is the first record of every backup.
+type Manifest struct {
+	UserID     string `json:"userID"`
+	SDKVersion string `json:"sdkVersion"`
+	CreateTime int64  `json:"createTime"`
+}
+
+// Record is one line of a backup. Messages, cursors and the read state belong to ConversationID.
+type Record struct {
+	Type           string                          `json:"type"`
+	Manifest       *Manifest                       `json:"manifest,omitempty"`
+	Conversation   *model_struct.LocalConversation `json:"conversation,omitempty"`
+	ConversationID string                          `json:"conversationID,omitempty"`
+	Messages       []*model_struct.LocalChatLog    `json:"messages,omitempty"`
+	ReadCursors    []*model_struct.LocalReadCursor `json:"readCursors,omitempty"`
+	ReadState      *model_struct.LocalReadState    `json:"readState,omitempty"`
+}
+
+type header struct {
+	version     uint16
+	iterations  uint32
+	salt        [saltSize]byte
+	noncePrefix [noncePrefixSize]byte
+}
+
+func (h *header) marshal() []byte {
+	buf := make([]byte, 0, headerSize)
+	buf = append(buf, magic...)
+	buf = binary.BigEndian.AppendUint16(buf, h.version)
+	buf = binary.BigEndian.AppendUint32(buf, h.iterations)
+	buf = append(buf, h.salt[:]...)
+	return append(buf, h.noncePrefix[:]...)
+}
+
+func (h *header) unmarshal(buf []byte) error {
+	if len(buf) != headerSize || string(buf[:len(magic)]) != magic {
+		return sdkerrs.ErrBackupFormat.WrapMsg("not a backup file")
+	}
+	buf = buf[len(magic):]
+	h.version = binary.BigEndian.Uint16(buf)
+	h.iterations = binary.BigEndian.Uint32(buf[2:])
+	copy(h.salt[:], buf[6:])
+	copy(h.noncePrefix[:], buf[6+saltSize:])
+	if h.version == 0 || h.version > FormatVersion {
+		return sdkerrs.ErrBackupFormat.WrapMsg("unsupported backup version", "version", h.version)
+	}
+	if h.iterations < minKdfIterations || h.iterations > maxKdfIterations {
+		return sdkerrs.ErrBackupFormat.WrapMsg("unsupported key derivation iterations", "iterations", h.iterations)
+	}
+	return nil
+}
+
+func (h *header) aead(password string) (cipher.AEAD, error) {
+	if password == "" {
+		return nil, sdkerrs.ErrArgs.WrapMsg("backup password is empty")
+	}
+	key := pbkdf2.Key([]byte(password), h.salt[:], int(h.iterations), keySize, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return aead, nil
+}
+
+// nonce of the chunk with the given index, unique per backup thanks to the random prefix.
+func (h *header) nonce(index uint64) []byte {
+	nonce := make([]byte, noncePrefixSize+8)
+	copy(nonce, h.noncePrefix[:])
+	binary.BigEndian.PutUint64(nonce[noncePrefixSize:], index)
+	return nonce
+}
+
+// additionalData binds every chunk to the header and marks the last one, so that a backup
+// cut short or with chunks swapped fails to decrypt.
+func additionalData(header []byte, final bool) []byte {
+	ad := append(bytes.Clone(header), 0)
+	if final {
+		ad[len(ad)-1] = 1
+	}
+	return ad
+}
+
+type chunkWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	header header
+	raw    []byte
+	buf    []byte
+	index  uint64
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	c.buf = append(c.buf, p...)
+	for len(c.buf) > chunkSize {
+		if err := c.seal(c.buf[:chunkSize], false); err != nil {
+			return 0, err
+		}
+		c.buf = append(c.buf[:0], c.buf[chunkSize:]...)
+	}
+	return len(p), nil
+}
+
+func (c *chunkWriter) seal(plain []byte, final bool) error {
+	sealed := c.aead.Seal(nil, c.header.nonce(c.index), plain, additionalData(c.raw, final))
+	c.index++
+	length := uint32(len(sealed))
+	if final {
+		length |= finalChunk
+	}
+	if err := binary.Write(c.w, binary.BigEndian, length); err != nil {
+		return errs.Wrap(err)
+	}
+	_, err := c.w.Write(sealed)
+	return errs.Wrap(err)
+}
+
+func (c *chunkWriter) Close() error {
+	return c.seal(c.buf, true)
+}
+
+type chunkReader struct {
+	r      io.Reader
+	aead   cipher.AEAD
+	header header
+	raw    []byte
+	buf    []byte
+	index  uint64
+	done   bool
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+		if err := c.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+func (c *chunkReader) open() error {
+	var length uint32
+	if err := binary.Read(c.r, binary.BigEndian, &length); err != nil {
+		return sdkerrs.ErrBackupFormat.WrapMsg("backup file is truncated")
+	}
+	final := length&finalChunk != 0
+	length &^= finalChunk
+	if int(length) > chunkSize+c.aead.Overhead() {
+		return sdkerrs.ErrBackupFormat.WrapMsg("backup chunk is too large", "length", length)
+	}
+	sealed := make([]byte, length)
+	if _, err := io.ReadFull(c.r, sealed); err != nil {
+		return sdkerrs.ErrBackupFormat.WrapMsg("backup file is truncated")
+	}
+	plain, err := c.aead.Open(sealed[:0], c.header.nonce(c.index), sealed, additionalData(c.raw, final))
+	if err != nil {
+		if c.index == 0 {
+			return sdkerrs.ErrBackupPassword.Wrap()
+		}
+		return sdkerrs.ErrBackupFormat.WrapMsg("backup chunk is damaged", "index", c.index)
+	}
+	c.index++
+	c.buf = plain
+	c.done = final
+	return nil
+}
+
+// Writer writes a backup. Close must be called to complete the file.
+type Writer struct {
+	chunks *chunkWriter
+	gz     *gzip.Writer
+	enc    *json.Encoder
+}
+
+// NewWriter writes the header and the manifest of a backup encrypted with password to w.
+func NewWriter(w io.Writer, password string, manifest *Manifest) (*Writer, error) {
+	h := header{version: FormatVersion, iterations: kdfIterations}
+	if _, err := rand.Read(h.salt[:]); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if _, err := rand.Read(h.noncePrefix[:]); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	aead, err := h.aead(password)
+	if err != nil {
+		return nil, err
+	}
+	raw := h.marshal()
+	if _, err := w.Write(raw); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	chunks := &chunkWriter{w: w, aead: aead, header: h, raw: raw}
+	gz := gzip.NewWriter(chunks)
+	bw := &Writer{chunks: chunks, gz: gz, enc: json.NewEncoder(gz)}
+	if err := bw.Write(&Record{Type: RecordManifest, Manifest: manifest}); err != nil {
+		return nil, err
+	}
+	return bw, nil
+}
+
+func (w *Writer) Write(record *Record) error {
+	return errs.Wrap(w.enc.Encode(record))
+}
+
+func (w *Writer) Close() error {
+	if err := w.gz.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+	return w.chunks.Close()
+}
+
+// Reader reads the records of a backup in the order they were written.
+type Reader struct {
+	Manifest *Manifest
+	dec      *json.Decoder
+}
+
+// NewReader checks the header of the backup in r and reads its manifest. A wrong password
+// fails with sdkerrs.ErrBackupPassword.
+func NewReader(r io.Reader, password string) (*Reader, error) {
+	raw := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		return nil, sdkerrs.ErrBackupFormat.WrapMsg("not a backup file")
+	}
+	var h header
+	if err := h.unmarshal(raw); err != nil {
+		return nil, err
+	}
+	aead, err := h.aead(password)
+	if err != nil {
+		return nil, err
+	}
+	chunks := &chunkReader{r: r, aead: aead, header: h, raw: raw}
+	gz, err := gzip.NewReader(chunks)
+	if err != nil {
+		return nil, backupError(err)
+	}
+	br := &Reader{dec: json.NewDecoder(gz)}
+	record, err := br.Next()
+	if err != nil {
+		if err == io.EOF {
+			return nil, sdkerrs.ErrBackupFormat.WrapMsg("backup has no manifest")
+		}
+		return nil, err
+	}
+	if record.Type != RecordManifest || record.Manifest == nil {
+		return nil, sdkerrs.ErrBackupFormat.WrapMsg("backup has no manifest")
+	}
+	br.Manifest = record.Manifest
+	return br, nil
+}
+
+// Next returns the next record, or io.EOF after the last one.
+func (r *Reader) Next() (*Record, error) {
+	var record Record
+	if err := r.dec.Decode(&record); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, backupError(err)
+	}
+	return &record, nil
+}
+
+// backupError keeps the errors of the chunk reader and reports anything else, e.g. a bad
+// gzip stream, as a damaged backup.
+func backupError(err error) error {
+	if sdkerrs.ErrBackupPassword.Is(err) || sdkerrs.ErrBackupFormat.Is(err) {
+		return err
+	}
+	return sdkerrs.ErrBackupFormat.WrapMsg(err.Error())
+}
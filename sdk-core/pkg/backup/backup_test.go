@@ -0,0 +1,130 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+)
+
+func writeBackup(t *testing.T, password string, messages int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, password, &Manifest{UserID: "u1", CreateTime: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(&Record{Type: RecordConversation, Conversation: &model_struct.LocalConversation{ConversationID: "si_u1_u2", DraftText: "draft"}}); err != nil {
+		t.Fatal(err)
+	}
+	batch := make([]*model_struct.LocalChatLog, 0, messages)
+	for i := 0; i < messages; i++ {
+		// random-looking content so gzip cannot shrink the backup into a single chunk
+		batch = append(batch, &model_struct.LocalChatLog{ClientMsgID: fmt.Sprintf("%x", i*2654435761), Seq: int64(i + 1), Content: fmt.Sprintf(`{"content":"%x"}`, i*40503*i)})
+	}
+	if err := w.Write(&Record{Type: RecordMessages, ConversationID: "si_u1_u2", Messages: batch}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	data := writeBackup(t, "secret", 20000)
+	if len(data) < 2*chunkSize {
+		t.Fatalf("backup of %d bytes does not span several chunks", len(data))
+	}
+	r, err := NewReader(bytes.NewReader(data), "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Manifest.UserID != "u1" {
+		t.Fatalf("manifest %+v", r.Manifest)
+	}
+	var records []*Record
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 || records[0].Conversation.DraftText != "draft" || len(records[1].Messages) != 20000 || records[1].Messages[19999].Seq != 20000 {
+		t.Fatalf("unexpected records %d", len(records))
+	}
+}
+
+func readAll(data []byte, password string) error {
+	r, err := NewReader(bytes.NewReader(data), password)
+	if err != nil {
+		return err
+	}
+	for {
+		if _, err := r.Next(); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	data := writeBackup(t, "secret", 20000)
+
+	if err := readAll(data, "wrong"); !sdkerrs.ErrBackupPassword.Is(err) {
+		t.Errorf("wrong password: %v", err)
+	}
+	if err := readAll(data[:len(data)-100], "secret"); !sdkerrs.ErrBackupFormat.Is(err) {
+		t.Errorf("truncated backup: %v", err)
+	}
+	damaged := bytes.Clone(data)
+	damaged[len(damaged)-100] ^= 1
+	if err := readAll(damaged, "secret"); !sdkerrs.ErrBackupFormat.Is(err) {
+		t.Errorf("damaged backup: %v", err)
+	}
+	newer := bytes.Clone(data)
+	newer[len(magic)+1] = FormatVersion + 1
+	if err := readAll(newer, "secret"); !sdkerrs.ErrBackupFormat.Is(err) {
+		t.Errorf("newer backup version: %v", err)
+	}
+	for _, iterations := range []uint32{0, minKdfIterations - 1, maxKdfIterations + 1, math.MaxUint32} {
+		crafted := bytes.Clone(data)
+		binary.BigEndian.PutUint32(crafted[len(magic)+2:], iterations)
+		start := time.Now()
+		if err := readAll(crafted, "secret"); !sdkerrs.ErrBackupFormat.Is(err) {
+			t.Errorf("%d iterations: %v", iterations, err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("%d iterations rejected after %s", iterations, elapsed)
+		}
+	}
+	if _, err := NewWriter(io.Discard, "", &Manifest{}); !sdkerrs.ErrArgs.Is(err) {
+		t.Errorf("empty password: %v", err)
+	}
+}
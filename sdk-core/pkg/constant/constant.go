@@ -90,6 +90,8 @@ const (
 	UserCommandDeleteNotification = 1306
 	UserCommandUpdateNotification = 1307
 
+	UserFavoriteChangedNotification = 1309
+
 	UserNotificationEnd = 1399
 
 	GroupNotificationBegin = 1500
@@ -114,6 +116,8 @@ const (
 	GroupMemberSetToOrdinaryUserNotification = 1518
 	GroupInfoSetAnnouncementNotification     = 1519
 	GroupInfoSetNameNotification             = 1520
+	GroupAnnouncementRemindNotification      = 1521
+	GroupAnnouncementConfirmedNotification   = 1522
 	GroupNotificationEnd                     = 1599
 
 	ConversationPrivateChatNotification = 1701
@@ -203,11 +207,14 @@ const (
 	PullMsgBySeqList      = 1005
 	GetConvMaxReadSeq     = 1006
 	PullConvLastMessage   = 1007
+	PushMsgAck            = 1008
 	PushMsg               = 2001
 	KickOnlineMsg         = 2002
 	LogoutMsg             = 2003
 	SetBackgroundStatus   = 2004
 	WsSubUserOnlineStatus = 2005
+	ResyncMsg             = 2006
+	SessionResume         = 2007
 )
 
 // conversation
@@ -250,6 +257,12 @@ const (
 
 )
 
+const (
+	FolderUnreadAny  = 0 // Smart folder: unread state not checked
+	FolderUnreadOnly = 1 // Smart folder: conversations with unread messages
+	FolderUnreadAtMe = 2 // Smart folder: unread conversations that mention me
+)
+
 const (
 	KeywordMatchOr  = 0 // Keyword match mode: match any keyword
 	KeywordMatchAnd = 1 // Keyword match mode: match all keywords
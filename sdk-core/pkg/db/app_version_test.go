@@ -10,7 +10,7 @@ import (
 
 func Test_GetAppSDKVersion(t *testing.T) {
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -25,7 +25,7 @@ func Test_GetAppSDKVersion(t *testing.T) {
 
 func Test_SetAppSDKVersion(t *testing.T) {
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -76,6 +76,12 @@ func (d *DataBase) initChatLog(ctx context.Context, conversationID string) error
 		if result.Error != nil {
 			return errs.WrapMsg(result.Error, "Create index_send_time failed", "table", tableName, "index", "index_send_time_"+conversationID)
 		}
+		if d.fullTextSearch {
+			if err := createSearchIndex(d.conn, conversationID); err != nil {
+				return err
+			}
+			d.tableChecker.UpdateTable(searchIndexName(conversationID) + "_ai")
+		}
 		d.tableChecker.UpdateTable(tableName)
 	}
 	return nil
@@ -188,6 +194,21 @@ func (d *DataBase) GetMessageList(ctx context.Context, conversationID string, co
 	return result, err
 }
 
+// GetMessagesAfter pages through all messages of a conversation in send time order, starting
+// after the message identified by sendTime and clientMsgID. Pass 0 and "" for the first page.
+func (d *DataBase) GetMessagesAfter(ctx context.Context, conversationID string, sendTime int64, clientMsgID string, count int) (result []*model_struct.LocalChatLog, err error) {
+	if err = d.initChatLog(ctx, conversationID); err != nil {
+		log.ZWarn(ctx, "initChatLog err", err)
+		return nil, err
+	}
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	err = d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).
+		Where("send_time > ? OR (send_time = ? AND client_msg_id > ?)", sendTime, sendTime, clientMsgID).
+		Order("send_time ASC, client_msg_id ASC").Limit(count).Find(&result).Error
+	return result, errs.WrapMsg(err, "GetMessagesAfter failed")
+}
+
 func (d *DataBase) DeleteConversationAllMessages(ctx context.Context, conversationID string) error {
 	d.mRWMutex.Lock()
 	defer d.mRWMutex.Unlock()
@@ -222,35 +243,10 @@ func (d *DataBase) SearchMessageByContentType(ctx context.Context, contentType [
 func (d *DataBase) SearchMessageByKeyword(ctx context.Context, contentType []int, keywordList []string, keywordListMatchType int, conversationID string, startTime, endTime int64, offset, count int) (result []*model_struct.LocalChatLog, err error) {
 	d.mRWMutex.RLock()
 	defer d.mRWMutex.RUnlock()
-	var condition string
-	var subCondition string
-	if keywordListMatchType == constant.KeywordMatchOr {
-		for i := 0; i < len(keywordList); i++ {
-			if i == 0 {
-				subCondition += "And ("
-			}
-			if i+1 >= len(keywordList) {
-				subCondition += "content like " + "'%" + keywordList[i] + "%') "
-			} else {
-				subCondition += "content like " + "'%" + keywordList[i] + "%' " + "or "
-
-			}
-		}
-	} else {
-		for i := 0; i < len(keywordList); i++ {
-			if i == 0 {
-				subCondition += "And ("
-			}
-			if i+1 >= len(keywordList) {
-				subCondition += "content like " + "'%" + keywordList[i] + "%') "
-			} else {
-				subCondition += "content like " + "'%" + keywordList[i] + "%' " + "and "
-			}
-		}
-	}
-	condition = fmt.Sprintf(" send_time  between %d and %d AND status <=%d  And content_type IN ? ", startTime, endTime, constant.MsgStatusSendFailed)
-	condition += subCondition
-	err = errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Where(condition, contentType).Order("send_time DESC").Offset(offset).Limit(count).Find(&result).Error, "SearchMessage failed")
+	condition, args := keywordCondition(keywordList, keywordListMatchType)
+	err = errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).
+		Where("send_time BETWEEN ? AND ? AND status <= ? AND content_type IN ?", startTime, endTime, constant.MsgStatusSendFailed, contentType).
+		Where(condition, args...).Order("send_time DESC").Offset(offset).Limit(count).Find(&result).Error, "SearchMessage failed")
 	return result, err
 }
 
@@ -258,43 +254,11 @@ func (d *DataBase) SearchMessageByKeyword(ctx context.Context, contentType []int
 func (d *DataBase) SearchMessageByContentTypeAndKeyword(ctx context.Context, contentType []int, conversationID string, keywordList []string, keywordListMatchType int, startTime, endTime int64) (result []*model_struct.LocalChatLog, err error) {
 	d.mRWMutex.RLock()
 	defer d.mRWMutex.RUnlock()
-	var condition string
-	var subCondition string
-
-	// Construct a sub-condition for SQL query based on keyword list and match type
-	if keywordListMatchType == constant.KeywordMatchOr {
-		// Use OR logic if keywordListMatchType is KeywordMatchOr
-		for i := 0; i < len(keywordList); i++ {
-			if i == 0 {
-				subCondition += "And ("
-			}
-			if i+1 >= len(keywordList) {
-				subCondition += "content like " + "'%" + keywordList[i] + "%') "
-			} else {
-				subCondition += "content like " + "'%" + keywordList[i] + "%' " + "or "
-			}
-		}
-	} else {
-		// Use AND logic for other keywordListMatchType
-		for i := 0; i < len(keywordList); i++ {
-			if i == 0 {
-				subCondition += "And ("
-			}
-			if i+1 >= len(keywordList) {
-				subCondition += "content like " + "'%" + keywordList[i] + "%') "
-			} else {
-				subCondition += "content like " + "'%" + keywordList[i] + "%' " + "and "
-			}
-		}
-	}
-
-	// Construct the main SQL condition string
-	condition = fmt.Sprintf("send_time between %d and %d AND status <=%d  And content_type IN ? ", startTime, endTime, constant.MsgStatusSendFailed)
-	condition += subCondition
-
-	// Execute the query using the constructed condition and handle errors
-	err = errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Where(condition, contentType).Order("send_time DESC").Find(&result).Error, "SearchMessage failed")
-
+	// Keywords are bound as LIKE patterns, never spliced into the SQL
+	condition, args := keywordCondition(keywordList, keywordListMatchType)
+	err = errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).
+		Where("send_time BETWEEN ? AND ? AND status <= ? AND content_type IN ?", startTime, endTime, constant.MsgStatusSendFailed, contentType).
+		Where(condition, args...).Order("send_time DESC").Find(&result).Error, "SearchMessage failed")
 	return result, err
 }
 
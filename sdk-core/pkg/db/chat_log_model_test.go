@@ -2,12 +2,15 @@ package db
 
 import (
 	"context"
+	"strings"
 	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
 )
 
 func TestGetLatestValidateServerMessage(t *testing.T) {
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "../../", 6)
+	db, err := NewDataBase(ctx, "1695766238", "../../", 6, "")
 	if err != nil {
 		return
 	}
@@ -19,3 +22,42 @@ func TestGetLatestValidateServerMessage(t *testing.T) {
 	}
 	t.Log("message", message)
 }
+
+func Test_GetMessagesAfter(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewDataBase(ctx, "1695766238", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	const conversationID = "si_1695766238_2"
+	// local messages have no seq, several of them may share a send time
+	err = db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{
+		{ClientMsgID: "b", SendTime: 1}, {ClientMsgID: "a", SendTime: 1}, {ClientMsgID: "c", SendTime: 1},
+		{ClientMsgID: "e", SendTime: 3, Seq: 2}, {ClientMsgID: "d", SendTime: 2, Seq: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		got         []string
+		sendTime    int64
+		clientMsgID string
+	)
+	for {
+		msgs, err := db.GetMessagesAfter(ctx, conversationID, sendTime, clientMsgID, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(msgs) == 0 {
+			break
+		}
+		for _, msg := range msgs {
+			got = append(got, msg.ClientMsgID)
+		}
+		sendTime, clientMsgID = msgs[len(msgs)-1].SendTime, msgs[len(msgs)-1].ClientMsgID
+	}
+	if strings.Join(got, "") != "abcde" {
+		t.Fatalf("got %v, want abcde", got)
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/mattn/go-sqlite3"
+	"gorm.io/gorm"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/search"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// driverName is sqlite3 with the functions used by the full-text index registered on every
+// connection. FTS5 itself is compiled in with the sqlite_fts5 build tag; without it search
+// falls back to LIKE.
+const driverName = "sqlite3_openim"
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("msg_search_text", msgSearchText, true)
+		},
+	})
+}
+
+// msgSearchText is the text of a message as the full-text index sees it.
+func msgSearchText(contentType, content interface{}) string {
+	t, _ := contentType.(int64)
+	var c string
+	switch v := content.(type) {
+	case string:
+		c = v
+	case []byte:
+		c = string(v)
+	}
+	return search.Segment(search.Text(int32(t), c))
+}
+
+func searchIndexName(conversationID string) string {
+	return utils.GetTableName(conversationID) + "_fts"
+}
+
+// checkFullTextSearch detects FTS5. Without it the triggers of an index built by another build
+// would fail every write, so they are dropped and the index is rebuilt once FTS5 is back.
+func (d *DataBase) checkFullTextSearch(ctx context.Context) error {
+	var enabled int
+	if err := d.conn.WithContext(ctx).Raw("SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&enabled).Error; err != nil {
+		return errs.WrapMsg(err, "check fts5 failed")
+	}
+	d.fullTextSearch = enabled == 1
+	if d.fullTextSearch {
+		return nil
+	}
+	log.ZWarn(ctx, "fts5 is not compiled in, search messages with like", nil)
+	var triggers []string
+	err := d.conn.WithContext(ctx).Raw(`SELECT name FROM sqlite_master WHERE type = 'trigger' AND name LIKE 'chat\_logs\_%\_fts\_a_' ESCAPE '\'`).
+		Scan(&triggers).Error
+	if err != nil {
+		return errs.WrapMsg(err, "get search index triggers failed")
+	}
+	for _, trigger := range triggers {
+		if err := d.conn.WithContext(ctx).Exec(fmt.Sprintf(`DROP TRIGGER "%s"`, trigger)).Error; err != nil {
+			return errs.WrapMsg(err, "drop search index trigger failed", "trigger", trigger)
+		}
+	}
+	return nil
+}
+
+// createSearchIndex creates the FTS5 index of a chat log table and fills it. The index reads
+// the searchable text through a view, and triggers keep it in sync with the table.
+func createSearchIndex(tx *gorm.DB, conversationID string) error {
+	tableName := utils.GetTableName(conversationID)
+	indexName := searchIndexName(conversationID)
+	viewName := tableName + "_search"
+	sqls := []string{
+		fmt.Sprintf(`CREATE VIEW IF NOT EXISTS "%s" AS SELECT rowid AS msg_rowid, msg_search_text(content_type, content) AS text FROM "%s"`,
+			viewName, tableName),
+		fmt.Sprintf(`CREATE VIRTUAL TABLE IF NOT EXISTS "%s" USING fts5(text, content='%s', content_rowid='msg_rowid', tokenize='unicode61 remove_diacritics 2')`,
+			indexName, viewName),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS "%[1]s_ai" AFTER INSERT ON "%[2]s" BEGIN
+			INSERT INTO "%[1]s"(rowid, text) VALUES (new.rowid, msg_search_text(new.content_type, new.content));
+		END`, indexName, tableName),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS "%[1]s_ad" AFTER DELETE ON "%[2]s" BEGIN
+			INSERT INTO "%[1]s"("%[1]s", rowid, text) VALUES ('delete', old.rowid, msg_search_text(old.content_type, old.content));
+		END`, indexName, tableName),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS "%[1]s_au" AFTER UPDATE OF content_type, content ON "%[2]s" BEGIN
+			INSERT INTO "%[1]s"("%[1]s", rowid, text) VALUES ('delete', old.rowid, msg_search_text(old.content_type, old.content));
+			INSERT INTO "%[1]s"(rowid, text) VALUES (new.rowid, msg_search_text(new.content_type, new.content));
+		END`, indexName, tableName),
+		fmt.Sprintf(`INSERT INTO "%[1]s"("%[1]s") VALUES ('rebuild')`, indexName),
+	}
+	for _, s := range sqls {
+		if err := tx.Exec(s).Error; err != nil {
+			return errs.WrapMsg(err, "create search index failed", "table", tableName)
+		}
+	}
+	return nil
+}
+
+// initSearchIndex makes sure the chat log table of the conversation, if any, has an up to date
+// full-text index. Tables from before the index existed are indexed on first search.
+func (d *DataBase) initSearchIndex(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	trigger := searchIndexName(conversationID) + "_ai"
+	if d.tableChecker.HasTable(trigger) || !d.tableChecker.HasTable(utils.GetTableName(conversationID)) {
+		return nil
+	}
+	var count int64
+	err := d.conn.WithContext(ctx).Raw("SELECT count(*) FROM sqlite_master WHERE type = 'trigger' AND name = ?", trigger).Scan(&count).Error
+	if err != nil {
+		return errs.WrapMsg(err, "check search index failed")
+	}
+	if count == 0 {
+		if err := d.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error { return createSearchIndex(tx, conversationID) }); err != nil {
+			return err
+		}
+	}
+	d.tableChecker.UpdateTable(trigger)
+	return nil
+}
+
+// rebuildSearchIndexes refills every full-text index. Exporting the database renumbers the
+// rowids of the chat log tables, which the indexes refer to.
+func (d *DataBase) rebuildSearchIndexes(ctx context.Context) error {
+	if !d.fullTextSearch {
+		return nil
+	}
+	var indexes []string
+	err := d.conn.WithContext(ctx).Raw(`SELECT name FROM sqlite_master WHERE type = 'table' AND name LIKE 'chat\_logs\_%\_fts' ESCAPE '\' AND sql LIKE 'CREATE VIRTUAL TABLE%'`).
+		Scan(&indexes).Error
+	if err != nil {
+		return errs.WrapMsg(err, "get search indexes failed")
+	}
+	for _, index := range indexes {
+		if err := d.conn.WithContext(ctx).Exec(fmt.Sprintf(`INSERT INTO "%[1]s"("%[1]s") VALUES ('rebuild')`, index)).Error; err != nil {
+			return errs.WrapMsg(err, "rebuild search index failed", "index", index)
+		}
+	}
+	return nil
+}
+
+// SearchMessageFullText searches the messages of a conversation with the full-text index and
+// orders them by bm25 relevance, then by send time. A count of 0 returns all matches.
+func (d *DataBase) SearchMessageFullText(ctx context.Context, conversationID string, contentType []int, keywordList []string, keywordListMatchType int, startTime, endTime int64, offset, count int) ([]*model_struct.LocalChatLogSearchResult, error) {
+	if !d.fullTextSearch {
+		return d.searchMessageByLike(ctx, conversationID, contentType, keywordList, keywordListMatchType, startTime, endTime, offset, count)
+	}
+	if err := d.initSearchIndex(ctx, conversationID); err != nil {
+		return nil, err
+	}
+	query := search.Query(keywordList, keywordListMatchType)
+	tableName := utils.GetTableName(conversationID)
+	if query == "" || !d.tableChecker.HasTable(tableName) {
+		return nil, nil
+	}
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	indexName := searchIndexName(conversationID)
+	db := d.conn.WithContext(ctx).Table(fmt.Sprintf(`"%s" AS c`, tableName)).
+		Select(fmt.Sprintf(`c.*, -bm25("%s") AS score`, indexName)).
+		Joins(fmt.Sprintf(`JOIN "%[1]s" ON "%[1]s".rowid = c.rowid`, indexName)).
+		Where(fmt.Sprintf(`"%s" MATCH ?`, indexName), query).
+		Where("c.send_time BETWEEN ? AND ? AND c.status <= ? AND c.content_type IN ?", startTime, endTime, constant.MsgStatusSendFailed, contentType).
+		Order("score DESC, c.send_time DESC")
+	if count > 0 {
+		db = db.Offset(offset).Limit(count)
+	}
+	var result []*model_struct.LocalChatLogSearchResult
+	return result, errs.WrapMsg(db.Find(&result).Error, "SearchMessageFullText failed")
+}
+
+// searchMessageByLike is SearchMessageFullText without FTS5. All matches score 0, so they are
+// ordered by send time.
+func (d *DataBase) searchMessageByLike(ctx context.Context, conversationID string, contentType []int, keywordList []string, keywordListMatchType int, startTime, endTime int64, offset, count int) ([]*model_struct.LocalChatLogSearchResult, error) {
+	tableName := utils.GetTableName(conversationID)
+	if !d.tableChecker.HasTable(tableName) {
+		return nil, nil
+	}
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	condition, args := keywordCondition(keywordList, keywordListMatchType)
+	db := d.conn.WithContext(ctx).Table(tableName).
+		Where("send_time BETWEEN ? AND ? AND status <= ? AND content_type IN ?", startTime, endTime, constant.MsgStatusSendFailed, contentType).
+		Where(condition, args...).
+		Order("send_time DESC")
+	if count > 0 {
+		db = db.Offset(offset).Limit(count)
+	}
+	var list []*model_struct.LocalChatLog
+	if err := db.Find(&list).Error; err != nil {
+		return nil, errs.WrapMsg(err, "SearchMessageFullText failed")
+	}
+	result := make([]*model_struct.LocalChatLogSearchResult, 0, len(list))
+	for _, msg := range list {
+		result = append(result, &model_struct.LocalChatLogSearchResult{LocalChatLog: *msg})
+	}
+	return result, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// keywordCondition matches the content against the keywords with bound LIKE patterns.
+func keywordCondition(keywordList []string, keywordListMatchType int) (string, []interface{}) {
+	if len(keywordList) == 0 {
+		return "1 = 1", nil
+	}
+	op := " AND "
+	if keywordListMatchType == constant.KeywordMatchOr {
+		op = " OR "
+	}
+	conditions := make([]string, 0, len(keywordList))
+	args := make([]interface{}, 0, len(keywordList))
+	for _, keyword := range keywordList {
+		conditions = append(conditions, `content LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+likeEscaper.Replace(keyword)+"%")
+	}
+	return "(" + strings.Join(conditions, op) + ")", args
+}
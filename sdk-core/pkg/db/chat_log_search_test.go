@@ -0,0 +1,135 @@
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+)
+
+func textMsg(clientMsgID string, text string, sendTime int64) *model_struct.LocalChatLog {
+	return &model_struct.LocalChatLog{
+		ClientMsgID: clientMsgID,
+		ContentType: constant.Text,
+		Content:     utils.StructToJsonString(map[string]string{"content": text}),
+		Status:      constant.MsgStatusSendSuccess,
+		SendTime:    sendTime,
+	}
+}
+
+func Test_SearchMessageFullText(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewDataBase(ctx, "1695766238", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	if !db.fullTextSearch {
+		t.Skip("FTS5 needs -tags sqlite_fts5")
+	}
+	const conversationID = "si_1695766238_2"
+	err = db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{
+		textMsg("1", "hello world", 1),
+		textMsg("2", "Hello again, hello", 2),
+		textMsg("3", "it's 100% done", 3),
+		{ClientMsgID: "4", ContentType: constant.File, Content: `{"fileName":"report.pdf"}`, Status: constant.MsgStatusSendSuccess, SendTime: 4},
+		textMsg("5", "今天天气不错", 5),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	contentType := []int{constant.Text, constant.File}
+	check := func(keywordList []string, matchType int, want ...string) {
+		t.Helper()
+		result, err := db.SearchMessageFullText(ctx, conversationID, contentType, keywordList, matchType, 0, 10, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, msg := range result {
+			got = append(got, msg.ClientMsgID)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("search %q: got %v, want %v", keywordList, got, want)
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("search %q: got %v, want %v", keywordList, got, want)
+			}
+		}
+	}
+	check([]string{"hello"}, constant.KeywordMatchAnd, "2", "1") // two matches rank first
+	check([]string{"wor"}, constant.KeywordMatchAnd, "1")
+	check([]string{"天气"}, constant.KeywordMatchAnd, "5")
+	check([]string{"气天"}, constant.KeywordMatchAnd)
+	check([]string{"report"}, constant.KeywordMatchAnd, "4")
+	check([]string{"hello", "again"}, constant.KeywordMatchAnd, "2")
+	check([]string{"hello", "天气"}, constant.KeywordMatchAnd)
+	check([]string{"天气", "天气"}, constant.KeywordMatchOr, "5")
+	check([]string{`' OR 1=1 --`}, constant.KeywordMatchAnd)
+	check([]string{"100%"}, constant.KeywordMatchAnd, "3")
+	check([]string{`"`}, constant.KeywordMatchAnd)
+
+	if err := db.UpdateColumnsMessage(ctx, conversationID, "1", map[string]interface{}{"content": `{"content":"goodbye"}`}); err != nil {
+		t.Fatal(err)
+	}
+	check([]string{"world"}, constant.KeywordMatchAnd)
+	check([]string{"goodbye"}, constant.KeywordMatchAnd, "1")
+	if err := db.DeleteConversationMsgs(ctx, conversationID, []string{"2"}); err != nil {
+		t.Fatal(err)
+	}
+	check([]string{"hello"}, constant.KeywordMatchAnd)
+
+	// rowids change when the database is exported
+	if err := db.ChangeEncryptKey(ctx, "key"); err != nil {
+		t.Fatal(err)
+	}
+	check([]string{"goodbye"}, constant.KeywordMatchAnd, "1")
+	check([]string{"report"}, constant.KeywordMatchAnd, "4")
+
+	// a chat log table from before the index is indexed on first search
+	db.fullTextSearch = false
+	if err := db.BatchInsertMessageList(ctx, "sg_1", []*model_struct.LocalChatLog{textMsg("6", "legacy", 6)}); err != nil {
+		t.Fatal(err)
+	}
+	db.fullTextSearch = true
+	result, err := db.SearchMessageFullText(ctx, "sg_1", contentType, []string{"legacy"}, constant.KeywordMatchAnd, 0, 10, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0].Score <= 0 {
+		t.Fatalf("result = %+v", result)
+	}
+}
+
+func Test_SearchMessageByLike(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewDataBase(ctx, "1695766238", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	db.fullTextSearch = false
+	const conversationID = "si_1695766238_2"
+	err = db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{
+		textMsg("1", "hello world", 1),
+		textMsg("2", "100% done", 2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for keyword, want := range map[string]int{"hello": 1, "%": 1, "' OR '1'='1": 0} {
+		result, err := db.SearchMessageFullText(ctx, conversationID, []int{constant.Text}, []string{keyword}, constant.KeywordMatchAnd, 0, 10, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != want {
+			t.Fatalf("search %q: got %d, want %d", keyword, len(result), want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertConversationFolder(ctx context.Context, folder *model_struct.LocalConversationFolder) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Create(folder).Error, "InsertConversationFolder failed")
+}
+
+func (d *DataBase) UpdateConversationFolder(ctx context.Context, folder *model_struct.LocalConversationFolder) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Model(folder).Select("*").Updates(*folder).Error, "UpdateConversationFolder failed")
+}
+
+func (d *DataBase) DeleteConversationFolder(ctx context.Context, folderID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Where("folder_id = ?", folderID).Delete(&model_struct.LocalConversationFolder{}).Error, "DeleteConversationFolder failed")
+}
+
+// GetConversationFolders returns the folders in the order the user sorted them.
+func (d *DataBase) GetConversationFolders(ctx context.Context) ([]*model_struct.LocalConversationFolder, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	var folders []*model_struct.LocalConversationFolder
+	err := d.conn.WithContext(ctx).Order("sort ASC, create_time ASC").Find(&folders).Error
+	return folders, errs.WrapMsg(err, "GetConversationFolders failed")
+}
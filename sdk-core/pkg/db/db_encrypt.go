@@ -0,0 +1,185 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// sqliteHeader starts every plaintext sqlite file; SQLCipher encrypts the header as well.
+var sqliteHeader = []byte("SQLite format 3\000")
+
+// rawKey turns the key provided by the app into a SQLCipher raw key. The app key is expected
+// to be random, e.g. kept in the keychain, so it is hashed instead of stretched.
+func rawKey(encryptKey string) string {
+	if encryptKey == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(encryptKey))
+	return "x'" + hex.EncodeToString(sum[:]) + "'"
+}
+
+func encryptedDSN(dbFileName string, encryptKey string) string {
+	if encryptKey == "" {
+		return dbFileName
+	}
+	return dbFileName + "?_pragma_key=" + url.QueryEscape(rawKey(encryptKey))
+}
+
+func isNotADatabase(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code == sqlite3.ErrNotADB
+}
+
+// isPlaintextDB reports whether dbFileName is an unencrypted database. A missing or empty
+// file is neither plaintext nor encrypted and is created with the current key.
+func isPlaintextDB(dbFileName string) (exists bool, plaintext bool, err error) {
+	file, err := os.Open(dbFileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, false, nil
+		}
+		return false, false, errs.WrapMsg(err, "open db file failed")
+	}
+	defer file.Close()
+	header := make([]byte, len(sqliteHeader))
+	n, err := io.ReadFull(file, header)
+	if n == 0 && (err == io.EOF || err == io.ErrUnexpectedEOF) {
+		return false, false, nil
+	}
+	if err != nil {
+		return true, false, errs.WrapMsg(err, "read db header failed")
+	}
+	return true, bytes.Equal(header, sqliteHeader), nil
+}
+
+// prepareEncryption migrates an existing plaintext database when a key is configured, and
+// rejects an encrypted database opened without one. It reports whether the file was exported.
+func (d *DataBase) prepareEncryption(ctx context.Context) (bool, error) {
+	// a leftover from an interrupted migration or key change, the original file is intact
+	if err := os.Remove(d.exportFileName()); err != nil && !os.IsNotExist(err) {
+		return false, errs.WrapMsg(err, "remove db export file failed")
+	}
+	exists, plaintext, err := isPlaintextDB(d.dbFileName)
+	if err != nil || !exists {
+		return false, err
+	}
+	switch {
+	case d.encryptKey == "" && !plaintext:
+		return false, sdkerrs.ErrDBKey.WrapMsg("db is encrypted but no key is configured")
+	case d.encryptKey != "" && plaintext:
+		log.ZInfo(ctx, "encrypt plaintext db", "dbFileName", d.dbFileName)
+		db, err := sql.Open(driverName, d.dbFileName)
+		if err != nil {
+			return false, errs.WrapMsg(err, "open plaintext db failed")
+		}
+		defer db.Close()
+		if err := d.exportDB(ctx, db, d.encryptKey); err != nil {
+			return false, err
+		}
+		if err := db.Close(); err != nil {
+			return false, errs.WrapMsg(err, "close plaintext db failed")
+		}
+		return true, d.replaceWithExport()
+	}
+	return false, nil
+}
+
+func (d *DataBase) exportFileName() string {
+	return d.dbFileName + ".export"
+}
+
+// exportDB copies every table of db into a new file encrypted with encryptKey, plaintext when
+// the key is empty.
+func (d *DataBase) exportDB(ctx context.Context, db *sql.DB, encryptKey string) error {
+	// ATTACH applies to a single connection, so the export must stay on one
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return errs.WrapMsg(err, "get db conn failed")
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "ATTACH DATABASE ? AS export KEY ?", d.exportFileName(), rawKey(encryptKey)); err != nil {
+		if isNotADatabase(err) {
+			return sdkerrs.ErrDBKey.WrapMsg("attach db export failed")
+		}
+		return errs.WrapMsg(err, "attach db export failed")
+	}
+	if _, err := conn.ExecContext(ctx, "SELECT sqlcipher_export('export')"); err != nil {
+		_, _ = conn.ExecContext(ctx, "DETACH DATABASE export")
+		return errs.WrapMsg(err, "export db failed")
+	}
+	if _, err := conn.ExecContext(ctx, "DETACH DATABASE export"); err != nil {
+		return errs.WrapMsg(err, "detach db export failed")
+	}
+	return nil
+}
+
+func (d *DataBase) replaceWithExport() error {
+	if err := os.Rename(d.exportFileName(), d.dbFileName); err != nil {
+		return errs.WrapMsg(err, "replace db with export failed")
+	}
+	return nil
+}
+
+// ChangeEncryptKey re-encrypts the local database with newKey. An empty newKey decrypts it,
+// and a plaintext database is encrypted. All database access waits until it is done.
+func (d *DataBase) ChangeEncryptKey(ctx context.Context, newKey string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if newKey == d.encryptKey {
+		return nil
+	}
+	sqlDB, err := d.conn.WithContext(ctx).DB()
+	if err != nil {
+		return errs.WrapMsg(err, "get sql db failed")
+	}
+	if err := d.exportDB(ctx, sqlDB, newKey); err != nil {
+		_ = os.Remove(d.exportFileName())
+		return err
+	}
+	if err := sqlDB.Close(); err != nil {
+		return errs.WrapMsg(err, "close db failed")
+	}
+	if err := d.replaceWithExport(); err != nil {
+		// the old file is still in place, reopen it with the old key
+		if openErr := d.open(ctx); openErr != nil {
+			log.ZError(ctx, "reopen db failed", openErr)
+		}
+		return err
+	}
+	d.encryptKey = newKey
+	log.ZInfo(ctx, "db encrypt key changed", "dbFileName", d.dbFileName, "encrypted", newKey != "")
+	if err := d.open(ctx); err != nil {
+		return err
+	}
+	return d.rebuildSearchIndexes(ctx)
+}
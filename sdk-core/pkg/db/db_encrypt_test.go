@@ -0,0 +1,70 @@
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+)
+
+func Test_EncryptDB(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	reopen := func(key string) *DataBase {
+		db, err := NewDataBase(ctx, "1695766238", dir, 0, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return db
+	}
+	checkPlaintext := func(want bool) {
+		_, plaintext, err := isPlaintextDB(dir + "/OpenIM_v3_1695766238.db")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if plaintext != want {
+			t.Fatalf("plaintext = %v, want %v", plaintext, want)
+		}
+	}
+
+	db := reopen("")
+	if err := db.InsertUpload(ctx, &model_struct.LocalUpload{PartHash: "hash", UploadID: "upload"}); err != nil {
+		t.Fatal(err)
+	}
+	_ = db.Close(ctx)
+	checkPlaintext(true)
+
+	// an existing plaintext db is migrated
+	db = reopen("key1")
+	_ = db.Close(ctx)
+	checkPlaintext(false)
+
+	for _, key := range []string{"", "key2"} {
+		if _, err := NewDataBase(ctx, "1695766238", dir, 0, key); !sdkerrs.ErrDBKey.Is(err) {
+			t.Fatalf("open with key %q: %v", key, err)
+		}
+	}
+
+	db = reopen("key1")
+	if err := db.ChangeEncryptKey(ctx, "key2"); err != nil {
+		t.Fatal(err)
+	}
+	_ = db.Close(ctx)
+	db = reopen("key2")
+	upload, err := db.GetUpload(ctx, "hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upload.UploadID != "upload" {
+		t.Fatalf("upload = %+v", upload)
+	}
+	if err := db.ChangeEncryptKey(ctx, ""); err != nil {
+		t.Fatal(err)
+	}
+	_ = db.Close(ctx)
+	checkPlaintext(true)
+}
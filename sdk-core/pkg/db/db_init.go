@@ -25,8 +25,7 @@ import (
 	"time"
 
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
-	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
-	"github.com/openimsdk/openim-sdk-core/v3/version"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -71,9 +70,14 @@ func (tc *TableChecker) UpdateTable(tableName string) {
 type DataBase struct {
 	loginUserID  string
 	dbDir        string
+	dbFileName   string
+	encryptKey   string
+	logLevel     int
 	conn         *gorm.DB
 	tableChecker *TableChecker
 	mRWMutex     sync.RWMutex
+	// fullTextSearch is whether FTS5 is available to index chat logs
+	fullTextSearch bool
 }
 
 func (d *DataBase) InitDB(ctx context.Context, userID string, dataDir string) error {
@@ -95,9 +99,11 @@ func (d *DataBase) Close(ctx context.Context) error {
 	return nil
 }
 
-func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel int) (*DataBase, error) {
-	dataBase := &DataBase{loginUserID: loginUserID, dbDir: dbDir}
-	err := dataBase.initDB(ctx, logLevel)
+// NewDataBase opens the local database of the user. With a non-empty encryptKey the file is
+// encrypted at rest, and an existing plaintext file is encrypted in place first.
+func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel int, encryptKey string) (*DataBase, error) {
+	dataBase := &DataBase{loginUserID: loginUserID, dbDir: dbDir, encryptKey: encryptKey, logLevel: logLevel}
+	err := dataBase.initDB(ctx)
 	if err != nil {
 		return dataBase, errs.WrapMsg(err, "initDB failed "+dbDir)
 	}
@@ -110,8 +116,7 @@ func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel
 	return dataBase, nil
 }
 
-func (d *DataBase) initDB(ctx context.Context, logLevel int) error {
-	var zLogLevel logger.LogLevel
+func (d *DataBase) initDB(ctx context.Context) error {
 	if d.loginUserID == "" {
 		return errors.New("no uid")
 	}
@@ -123,23 +128,50 @@ func (d *DataBase) initDB(ctx context.Context, logLevel int) error {
 	if err != nil {
 		return err
 	}
-	log.ZInfo(ctx, "sqlite", "path", dbFileName)
+	d.dbFileName = dbFileName
+	log.ZInfo(ctx, "sqlite", "path", dbFileName, "encrypted", d.encryptKey != "")
+	exported, err := d.prepareEncryption(ctx)
+	if err != nil {
+		return err
+	}
+	if err := d.open(ctx); err != nil {
+		return err
+	}
+	if err := d.migrate(ctx); err != nil {
+		return err
+	}
+	if exported {
+		if err := d.rebuildSearchIndexes(ctx); err != nil {
+			return err
+		}
+	}
+
+	//if err := db.Table(constant.SuperGroupTableName).AutoMigrate(superGroup); err != nil {
+	//	return err
+	//}
+
+	return nil
+}
+
+// open connects to dbFileName with the current encryption key.
+func (d *DataBase) open(ctx context.Context) error {
+	var zLogLevel logger.LogLevel
 	// slowThreshold := 500
 	// sqlLogger := log.NewSqlLogger(logger.LogLevel(sdk_struct.ServerConf.LogLevel), true, time.Duration(slowThreshold)*time.Millisecond)
-	if logLevel > 5 {
+	if d.logLevel > 5 {
 		zLogLevel = logger.Info
 	} else {
 		zLogLevel = logger.Silent
 	}
-	var (
-		db *gorm.DB
-	)
-	db, err = gorm.Open(sqlite.Open(dbFileName), &gorm.Config{Logger: log.NewSqlLogger(zLogLevel, false, time.Millisecond*200)})
+	db, err := gorm.Open(&sqlite.Dialector{DriverName: driverName, DSN: encryptedDSN(d.dbFileName, d.encryptKey)}, &gorm.Config{Logger: log.NewSqlLogger(zLogLevel, false, time.Millisecond*200)})
 	if err != nil {
-		return errs.WrapMsg(err, "open db failed "+dbFileName)
+		if isNotADatabase(err) {
+			return sdkerrs.ErrDBKey.WrapMsg("open db failed "+d.dbFileName, "encrypted", d.encryptKey != "")
+		}
+		return errs.WrapMsg(err, "open db failed "+d.dbFileName)
 	}
 
-	log.ZDebug(ctx, "open db success", "dbFileName", dbFileName)
+	log.ZDebug(ctx, "open db success", "dbFileName", d.dbFileName)
 	sqlDB, err := db.DB()
 	if err != nil {
 		return errs.WrapMsg(err, "get sql db failed")
@@ -150,70 +182,5 @@ func (d *DataBase) initDB(ctx context.Context, logLevel int) error {
 	sqlDB.SetMaxIdleConns(2)
 	sqlDB.SetConnMaxIdleTime(time.Minute * 10)
 	d.conn = db
-
-	// base
-	if err = db.AutoMigrate(&model_struct.LocalAppSDKVersion{}); err != nil {
-		return err
-	}
-
-	if err = d.versionDataMigrate(ctx); err != nil {
-		return err
-	}
-
-	//if err := db.Table(constant.SuperGroupTableName).AutoMigrate(superGroup); err != nil {
-	//	return err
-	//}
-
-	return nil
-}
-
-func (d *DataBase) versionDataMigrate(ctx context.Context) error {
-	verModel, err := d.GetAppSDKVersion(ctx)
-	if errs.Unwrap(err) == errs.ErrRecordNotFound {
-		err = d.conn.AutoMigrate(
-			&model_struct.LocalAppSDKVersion{},
-			&model_struct.LocalFriend{},
-			&model_struct.LocalFriendRequest{},
-			&model_struct.LocalGroup{},
-			&model_struct.LocalGroupMember{},
-			&model_struct.LocalGroupRequest{},
-			&model_struct.LocalUser{},
-			&model_struct.LocalBlack{},
-			&model_struct.LocalConversation{},
-			&model_struct.NotificationSeqs{},
-			&model_struct.LocalChatLog{},
-			&model_struct.LocalAdminGroupRequest{},
-			&model_struct.LocalChatLogReactionExtensions{},
-			&model_struct.LocalUpload{},
-			&model_struct.LocalStranger{},
-			&model_struct.LocalSendingMessages{},
-			&model_struct.LocalUserCommand{},
-			&model_struct.LocalVersionSync{},
-			&model_struct.LocalReadCursor{},
-			&model_struct.LocalReadState{},
-		)
-		if err != nil {
-			return err
-		}
-		err = d.SetAppSDKVersion(ctx, &model_struct.LocalAppSDKVersion{Version: version.Version})
-		if err != nil {
-			return err
-		}
-
-		return nil
-	} else if err != nil {
-		return err
-	}
-	if verModel.Version != version.Version {
-		switch version.Version {
-		case "3.8.0":
-			d.conn.AutoMigrate(&model_struct.LocalAppSDKVersion{})
-		}
-		err = d.SetAppSDKVersion(ctx, &model_struct.LocalAppSDKVersion{Version: version.Version})
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return d.checkFullTextSearch(ctx)
 }
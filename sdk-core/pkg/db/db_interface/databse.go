@@ -61,6 +61,8 @@ type MessageModel interface {
 	SearchMessageByKeyword(ctx context.Context, contentType []int, keywordList []string, keywordListMatchType int, conversationID string, startTime, endTime int64, offset, count int) (result []*model_struct.LocalChatLog, err error)
 	SearchMessageByContentType(ctx context.Context, contentType []int, conversationID string, startTime, endTime int64, offset, count int) (result []*model_struct.LocalChatLog, err error)
 	SearchMessageByContentTypeAndKeyword(ctx context.Context, contentType []int, conversationID string, keywordList []string, keywordListMatchType int, startTime, endTime int64) (result []*model_struct.LocalChatLog, err error)
+	// SearchMessageFullText searches the messages of a conversation by relevance. A count of 0 returns all matches.
+	SearchMessageFullText(ctx context.Context, conversationID string, contentType []int, keywordList []string, keywordListMatchType int, startTime, endTime int64, offset, count int) ([]*model_struct.LocalChatLogSearchResult, error)
 	GetMessage(ctx context.Context, conversationID, clientMsgID string) (*model_struct.LocalChatLog, error)
 	GetMessageBySeq(ctx context.Context, conversationID string, seq int64) (*model_struct.LocalChatLog, error)
 	UpdateColumnsMessage(ctx context.Context, conversationID string, ClientMsgID string, args map[string]interface{}) error
@@ -68,6 +70,7 @@ type MessageModel interface {
 	UpdateMessageBySeq(ctx context.Context, conversationID string, c *model_struct.LocalChatLog) error
 	UpdateMessageTimeAndStatus(ctx context.Context, conversationID, clientMsgID string, serverMsgID string, sendTime int64, status int32) error
 	GetMessageList(ctx context.Context, conversationID string, count int, startTime, startSeq int64, startClientMsgID string, isReverse bool) (result []*model_struct.LocalChatLog, err error)
+	GetMessagesAfter(ctx context.Context, conversationID string, sendTime int64, clientMsgID string, count int) (result []*model_struct.LocalChatLog, err error)
 	MarkConversationMessageAsReadDB(ctx context.Context, conversationID string, msgIDs []string) (rowsAffected int64, err error)
 	MarkConversationMessageAsReadBySeqs(ctx context.Context, conversationID string, seqs []int64) (rowsAffected int64, err error)
 	GetUnreadMessage(ctx context.Context, conversationID string) (result []*model_struct.LocalChatLog, err error)
@@ -139,6 +142,21 @@ type UserModel interface {
 	ProcessUserCommandGetAll(ctx context.Context) ([]*model_struct.LocalUserCommand, error)
 }
 
+type ConversationFolderModel interface {
+	InsertConversationFolder(ctx context.Context, folder *model_struct.LocalConversationFolder) error
+	UpdateConversationFolder(ctx context.Context, folder *model_struct.LocalConversationFolder) error
+	DeleteConversationFolder(ctx context.Context, folderID string) error
+	GetConversationFolders(ctx context.Context) ([]*model_struct.LocalConversationFolder, error)
+}
+
+type FavoriteModel interface {
+	InsertFavorite(ctx context.Context, favorite *model_struct.LocalFavorite) error
+	UpdateFavorite(ctx context.Context, favorite *model_struct.LocalFavorite) error
+	DeleteFavorite(ctx context.Context, favoriteID string) error
+	GetAllFavorites(ctx context.Context) ([]*model_struct.LocalFavorite, error)
+	GetFavoriteList(ctx context.Context, offset, count int) ([]*model_struct.LocalFavorite, error)
+}
+
 type FriendModel interface {
 	InsertFriend(ctx context.Context, friend *model_struct.LocalFriend) error
 	DeleteFriendDB(ctx context.Context, friendUserID string) error
@@ -218,10 +236,14 @@ type ReadStateModel interface {
 type DataBase interface {
 	Close(ctx context.Context) error
 	InitDB(ctx context.Context, userID string, dataDir string) error
+	// ChangeEncryptKey re-encrypts the local database, an empty key stores it in plaintext.
+	ChangeEncryptKey(ctx context.Context, newKey string) error
 	GroupModel
 	MessageModel
 	ConversationModel
 	UserModel
+	FavoriteModel
+	ConversationFolderModel
 	FriendModel
 	S3Model
 	SendingMessagesModel
@@ -18,12 +18,15 @@ import (
 	"context"
 	"errors"
 
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
 	"github.com/openimsdk/openim-sdk-core/v3/wasm/exec"
 	"github.com/openimsdk/openim-sdk-core/v3/wasm/indexdb"
 )
 
 var ErrType = errors.New("from javascript data type err")
 
+var errLocalEncryptNotSupport = sdkerrs.ErrArgs.WrapMsg("local database encryption is not supported on web")
+
 type IndexDB struct {
 	*indexdb.LocalUsers
 	*indexdb.LocalConversations
@@ -39,6 +42,8 @@ type IndexDB struct {
 	*indexdb.LocalUpload
 	*indexdb.LocalSendingMessages
 	*indexdb.LocalUserCommand
+	*indexdb.LocalFavorites
+	*indexdb.LocalConversationFolders
 	*indexdb.LocalVersionSync
 	*indexdb.LocalAppSDKVersion
 	*indexdb.LocalReadCursor
@@ -57,7 +62,16 @@ func (i IndexDB) InitDB(ctx context.Context, userID string, dataDir string) erro
 	return err
 }
 
-func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel int) (*IndexDB, error) {
+func (i IndexDB) ChangeEncryptKey(ctx context.Context, newKey string) error {
+	return errLocalEncryptNotSupport
+}
+
+// NewDataBase opens the browser database, which is managed by javascript and cannot be
+// encrypted by the sdk.
+func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel int, encryptKey string) (*IndexDB, error) {
+	if encryptKey != "" {
+		return nil, errLocalEncryptNotSupport
+	}
 	i := &IndexDB{
 		LocalUsers:                      indexdb.NewLocalUsers(),
 		LocalConversations:              indexdb.NewLocalConversations(),
@@ -73,6 +87,8 @@ func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel
 		LocalUpload:                     indexdb.NewLocalUpload(),
 		LocalSendingMessages:            indexdb.NewLocalSendingMessages(),
 		LocalUserCommand:                indexdb.NewLocalUserCommand(),
+		LocalFavorites:                  indexdb.NewLocalFavorites(),
+		LocalConversationFolders:        indexdb.NewLocalConversationFolders(),
 		LocalVersionSync:                indexdb.NewLocalVersionSync(),
 		LocalAppSDKVersion:              indexdb.NewLocalAppSDKVersion(),
 		LocalReadCursor:                 indexdb.NewLocalReadCursor(),
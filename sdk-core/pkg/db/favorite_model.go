@@ -0,0 +1,59 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertFavorite(ctx context.Context, favorite *model_struct.LocalFavorite) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Create(favorite).Error, "InsertFavorite failed")
+}
+
+func (d *DataBase) UpdateFavorite(ctx context.Context, favorite *model_struct.LocalFavorite) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Model(favorite).Select("*").Updates(*favorite).Error, "UpdateFavorite failed")
+}
+
+func (d *DataBase) DeleteFavorite(ctx context.Context, favoriteID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Where("favorite_id = ?", favoriteID).Delete(&model_struct.LocalFavorite{}).Error, "DeleteFavorite failed")
+}
+
+func (d *DataBase) GetAllFavorites(ctx context.Context) ([]*model_struct.LocalFavorite, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	var favorites []*model_struct.LocalFavorite
+	return favorites, errs.WrapMsg(d.conn.WithContext(ctx).Find(&favorites).Error, "GetAllFavorites failed")
+}
+
+// GetFavoriteList returns a page of the favorites, the latest first.
+func (d *DataBase) GetFavoriteList(ctx context.Context, offset, count int) ([]*model_struct.LocalFavorite, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	var favorites []*model_struct.LocalFavorite
+	err := d.conn.WithContext(ctx).Order("create_time DESC").Offset(offset).Limit(count).Find(&favorites).Error
+	return favorites, errs.WrapMsg(err, "GetFavoriteList failed")
+}
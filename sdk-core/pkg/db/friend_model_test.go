@@ -10,7 +10,7 @@ import (
 
 func Test_GetFriendListCount(t *testing.T) {
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -24,7 +24,7 @@ func Test_GetFriendListCount(t *testing.T) {
 
 func Test_BatchInsertFriend(t *testing.T) {
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -78,7 +78,7 @@ func Test_BatchInsertFriend(t *testing.T) {
 
 func Test_DeleteAllFriend(t *testing.T) {
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -93,7 +93,7 @@ func Test_DeleteAllFriend(t *testing.T) {
 
 // func Test_UpdateColumnsFriend(t *testing.T) {
 // 	ctx := context.Background()
-// 	db, err := db.NewDataBase(ctx, "1695766238", "./", 6)
+// 	db, err := db.NewDataBase(ctx, "1695766238", "./", 6, "")
 // 	if err != nil {
 // 		return
 // 	}
@@ -9,7 +9,7 @@ import (
 
 func Test_GetGroupMemberListByUserIDs(t *testing.T) {
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -24,7 +24,7 @@ func Test_GetGroupMemberListByUserIDs(t *testing.T) {
 func Test_BatchInsertGroup(t *testing.T) {
 
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -81,7 +81,7 @@ func Test_BatchInsertGroup(t *testing.T) {
 
 func Test_DeleteAllGroup(t *testing.T) {
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -0,0 +1,212 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/version"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"gorm.io/gorm"
+)
+
+// migration is one numbered step of the local schema. Steps run in order at login, each in
+// its own transaction together with the bump of LocalAppSDKVersion.SchemaVersion, so a failed
+// step leaves the database at the previous version.
+//
+// Step 1 brings every table to the current model definitions and only adds tables, columns
+// and indexes. Later steps run after it on upgraders but never on fresh databases' empty
+// tables in a meaningful way, so they must be idempotent, e.g. check HasColumn first.
+// Append new steps at the end and never renumber or edit shipped ones, and add the
+// testdata/schema-v<version>.sql dump of the new version for the upgrade tests.
+type migration struct {
+	version int
+	name    string
+	up      func(tx *gorm.DB) error
+}
+
+var migrations = []migration{
+	{version: 1, name: "create or complete tables", up: migrateTables},
+	{version: 2, name: "backfill columns added by alter table", up: backfillAlteredColumns},
+	{version: 3, name: "create favorite table", up: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&model_struct.LocalFavorite{})
+	}},
+	{version: 4, name: "add conversation folders", up: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&model_struct.LocalConversation{}, &model_struct.LocalConversationFolder{})
+	}},
+	{version: 5, name: "complete chat log tables", up: migrateChatLogTables},
+}
+
+func latestSchemaVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+func migrateTables(tx *gorm.DB) error {
+	return tx.AutoMigrate(
+		&model_struct.LocalAppSDKVersion{},
+		&model_struct.LocalFriend{},
+		&model_struct.LocalFriendRequest{},
+		&model_struct.LocalGroup{},
+		&model_struct.LocalGroupMember{},
+		&model_struct.LocalGroupRequest{},
+		&model_struct.LocalUser{},
+		&model_struct.LocalBlack{},
+		&model_struct.LocalConversation{},
+		&model_struct.NotificationSeqs{},
+		&model_struct.LocalChatLog{},
+		&model_struct.LocalAdminGroupRequest{},
+		&model_struct.LocalChatLogReactionExtensions{},
+		&model_struct.LocalUpload{},
+		&model_struct.LocalStranger{},
+		&model_struct.LocalSendingMessages{},
+		&model_struct.LocalUserCommand{},
+		&model_struct.LocalVersionSync{},
+		&model_struct.LocalReadCursor{},
+		&model_struct.LocalReadState{},
+	)
+}
+
+// backfillAlteredColumns sets the columns that older versions added to existing rows, which
+// are NULL there. A database created before the installed flag existed was fully synced.
+func backfillAlteredColumns(tx *gorm.DB) error {
+	for _, c := range []struct {
+		table, column, value string
+	}{
+		{"local_friends", "is_pinned", "0"},
+		{"local_app_sdk_version", "installed", "1"},
+	} {
+		if !tx.Migrator().HasColumn(c.table, c.column) {
+			continue
+		}
+		sql := "UPDATE " + c.table + " SET " + c.column + " = " + c.value + " WHERE " + c.column + " IS NULL"
+		if err := tx.Exec(sql).Error; err != nil {
+			return errs.WrapMsg(err, "backfill failed", "sql", sql)
+		}
+	}
+	return nil
+}
+
+// migrateChatLogTables adds the LocalChatLog columns missing from the per-conversation chat log
+// tables, which initChatLog creates only once and step 1 does not know about. AutoMigrate is
+// not used on them because the index names of the model are shared by all tables.
+func migrateChatLogTables(tx *gorm.DB) error {
+	var tables []struct {
+		Name string
+		SQL  string
+	}
+	err := tx.Raw(`SELECT name, sql FROM sqlite_master WHERE type = 'table' AND name LIKE 'chat\_logs\_%' ESCAPE '\'`).
+		Scan(&tables).Error
+	if err != nil {
+		return errs.WrapMsg(err, "get chat log tables failed")
+	}
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(&model_struct.LocalChatLog{}); err != nil {
+		return errs.WrapMsg(err, "parse chat log model failed")
+	}
+	for _, table := range tables {
+		// skip the full-text indexes and their shadow tables
+		if strings.HasPrefix(strings.ToUpper(table.SQL), "CREATE VIRTUAL") ||
+			!tx.Migrator().HasColumn(table.Name, "client_msg_id") {
+			continue
+		}
+		migrator := tx.Table(table.Name).Migrator()
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" || migrator.HasColumn(&model_struct.LocalChatLog{}, field.DBName) {
+				continue
+			}
+			if err := migrator.AddColumn(&model_struct.LocalChatLog{}, field.DBName); err != nil {
+				return errs.WrapMsg(err, "add chat log column failed", "table", table.Name, "column", field.DBName)
+			}
+		}
+	}
+	return nil
+}
+
+// migrate applies the pending migrations. If one fails, the database is moved aside and
+// recreated, and the SDK syncs it again from the server like after a reinstall.
+func (d *DataBase) migrate(ctx context.Context) error {
+	err := d.applyMigrations(ctx)
+	if err == nil {
+		return nil
+	}
+	log.ZError(ctx, "migrate db failed, resync from server", err, "dbFileName", d.dbFileName)
+	if err := d.resetDB(ctx); err != nil {
+		return err
+	}
+	return d.applyMigrations(ctx)
+}
+
+func (d *DataBase) applyMigrations(ctx context.Context) error {
+	if err := d.conn.WithContext(ctx).AutoMigrate(&model_struct.LocalAppSDKVersion{}); err != nil {
+		return errs.WrapMsg(err, "migrate local_app_sdk_version failed")
+	}
+	var appVersion model_struct.LocalAppSDKVersion
+	err := d.conn.WithContext(ctx).Take(&appVersion).Error
+	if err == gorm.ErrRecordNotFound {
+		appVersion = model_struct.LocalAppSDKVersion{Version: version.Version}
+		if err := d.conn.WithContext(ctx).Create(&appVersion).Error; err != nil {
+			return errs.Wrap(err)
+		}
+	} else if err != nil {
+		return errs.Wrap(err)
+	}
+	for _, m := range migrations {
+		if m.version <= appVersion.SchemaVersion {
+			continue
+		}
+		err := d.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := m.up(tx); err != nil {
+				return err
+			}
+			return tx.Model(&model_struct.LocalAppSDKVersion{}).Where("version = ?", appVersion.Version).
+				Update("schema_version", m.version).Error
+		})
+		if err != nil {
+			return errs.WrapMsg(err, "migration failed", "version", m.version, "name", m.name)
+		}
+		log.ZInfo(ctx, "migration applied", "version", m.version, "name", m.name)
+	}
+	if appVersion.Version != version.Version {
+		err := d.conn.WithContext(ctx).Model(&model_struct.LocalAppSDKVersion{}).Where("version = ?", appVersion.Version).
+			Update("version", version.Version).Error
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// resetDB keeps the current file as <db>.bak for diagnosis and opens an empty database. Only the
+// last failed database is kept, so repeated failures do not pile up copies of the user's data.
+func (d *DataBase) resetDB(ctx context.Context) error {
+	sqlDB, err := d.conn.DB()
+	if err != nil {
+		return errs.WrapMsg(err, "get sql db failed")
+	}
+	if err := sqlDB.Close(); err != nil {
+		return errs.WrapMsg(err, "close db failed")
+	}
+	if err := os.Rename(d.dbFileName, d.dbFileName+".bak"); err != nil {
+		return errs.WrapMsg(err, "move db aside failed")
+	}
+	return d.open(ctx)
+}
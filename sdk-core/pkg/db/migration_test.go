@@ -0,0 +1,231 @@
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/version"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// legacySchemas are the databases released SDK versions left behind, before migrations existed,
+// and the databases at each shipped schema version. Each one loads testdata/<dump>.sql and
+// applies the edits that turn it into that version.
+var legacySchemas = map[string]struct {
+	version       string
+	schemaVersion int
+	dump          string
+	edits         map[string]string
+}{
+	"v3.8.3-kids.2": {version: "v3.8.3-kids.2", dump: "v3.8.3-kids.2"},
+	// Versions before 3.8.0 had no installed flag: it was added by the 3.8.0 upgrade step.
+	"before v3.8.0": {version: "v3.7.0", dump: "v3.8.3-kids.2", edits: map[string]string{"`installed` numeric,": ""}},
+	// A chat log table missing a column of the model, which only migration step 5 adds.
+	"chat log without local_ex": {version: "v3.8.3-kids.2", dump: "v3.8.3-kids.2", edits: map[string]string{"local_ex VARCHAR(1024),": ""}},
+	// Fresh databases of each shipped schema version.
+	"schema v2": {version: "v3.8.3-kids.2", schemaVersion: 2, dump: "schema-v2"},
+	"schema v3": {version: "v3.8.3-kids.2", schemaVersion: 3, dump: "schema-v3"},
+	"schema v4": {version: "v3.8.3-kids.2", schemaVersion: 4, dump: "schema-v4"},
+	"schema v5": {version: "v3.8.3-kids.2", schemaVersion: 5, dump: "schema-v5"},
+}
+
+const legacyChatLogTable = "chat_logs_si_1695766238_friend"
+
+func createLegacyDB(t *testing.T, dir string, name string) {
+	legacy, ok := legacySchemas[name]
+	if !ok {
+		t.Fatalf("unknown legacy schema %s", name)
+	}
+	dump, err := os.ReadFile("testdata/" + legacy.dump + ".sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := string(dump)
+	for old, repl := range legacy.edits {
+		if !strings.Contains(schema, old) {
+			t.Fatalf("%s not in %s", old, legacy.dump)
+		}
+		schema = strings.ReplaceAll(schema, old, repl)
+	}
+	conn, err := gorm.Open(sqlite.Open(dir+"/OpenIM_v3_1695766238.db"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sqls []string
+	var lines []string
+	for _, line := range strings.Split(schema, "\n") {
+		if !strings.HasPrefix(line, "--") {
+			lines = append(lines, line)
+		}
+	}
+	for _, sql := range strings.Split(strings.Join(lines, "\n"), ";\n") {
+		if sql = strings.TrimSpace(sql); sql != "" {
+			sqls = append(sqls, sql)
+		}
+	}
+	appVersion := "INSERT INTO local_app_sdk_version (version) VALUES ('" + legacy.version + "')"
+	if legacy.schemaVersion > 0 {
+		appVersion = "INSERT INTO local_app_sdk_version (version, installed, schema_version) VALUES ('" +
+			legacy.version + "', 1, " + strconv.Itoa(legacy.schemaVersion) + ")"
+	}
+	sqls = append(sqls,
+		appVersion,
+		"INSERT INTO local_friends (owner_user_id, friend_user_id, name) VALUES ('1695766238', 'friend', 'nick')",
+		"INSERT INTO local_groups (group_id, name) VALUES ('group', 'name')",
+		"INSERT INTO "+legacyChatLogTable+" (client_msg_id, seq, content) VALUES ('msg', 1, 'hello')",
+	)
+	for _, sql := range sqls {
+		if err := conn.Exec(sql).Error; err != nil {
+			t.Fatal(sql, err)
+		}
+	}
+	sqlDB, _ := conn.DB()
+	_ = sqlDB.Close()
+}
+
+func Test_MigrateFromLegacy(t *testing.T) {
+	ctx := context.Background()
+	for name := range legacySchemas {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			createLegacyDB(t, dir, name)
+			db, err := NewDataBase(ctx, "1695766238", dir, 0, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close(ctx)
+			appVersion, err := db.GetAppSDKVersion(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if appVersion.Version != version.Version || appVersion.SchemaVersion != latestSchemaVersion() || !appVersion.Installed {
+				t.Fatalf("app version = %+v", appVersion)
+			}
+			friends, err := db.GetFriendInfoList(ctx, []string{"friend"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(friends) != 1 || friends[0].Nickname != "nick" || friends[0].IsPinned {
+				t.Fatalf("friends = %+v", friends)
+			}
+			group, err := db.GetGroupInfoByGroupID(ctx, "group")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if group.GroupName != "name" {
+				t.Fatalf("group = %+v", group)
+			}
+			for _, table := range []any{&model_struct.LocalFavorite{}, &model_struct.LocalConversationFolder{}} {
+				if !db.conn.Migrator().HasTable(table) {
+					t.Fatalf("table of %T not created", table)
+				}
+			}
+			if !db.conn.Migrator().HasColumn(&model_struct.LocalConversation{}, "folder_ids") {
+				t.Fatal("folder_ids not added to local_conversations")
+			}
+			if !db.conn.Migrator().HasColumn(legacyChatLogTable, "local_ex") {
+				t.Fatal("local_ex not added to", legacyChatLogTable)
+			}
+			msg, err := db.GetMessage(ctx, "si_1695766238_friend", "msg")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if msg.Seq != 1 || msg.Content != "hello" {
+				t.Fatalf("msg = %+v", msg)
+			}
+		})
+	}
+}
+
+func Test_MigrateFresh(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	for i := 0; i < 2; i++ {
+		db, err := NewDataBase(ctx, "1695766238", dir, 0, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		appVersion, err := db.GetAppSDKVersion(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if appVersion.SchemaVersion != latestSchemaVersion() || appVersion.Installed {
+			t.Fatalf("app version = %+v", appVersion)
+		}
+		_ = db.Close(ctx)
+	}
+}
+
+func Test_MigrateFailureResync(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	createLegacyDB(t, dir, "v3.8.3-kids.2")
+
+	saved := migrations
+	defer func() { migrations = saved }()
+	migrations = append(migrations[:len(migrations):len(migrations)], migration{
+		version: latestSchemaVersion() + 1,
+		name:    "fails on existing data",
+		up: func(tx *gorm.DB) error {
+			var count int64
+			if err := tx.Model(&model_struct.LocalFriend{}).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return errors.New("bad data")
+			}
+			return nil
+		},
+	})
+
+	db, err := NewDataBase(ctx, "1695766238", dir, 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = db.Close(ctx)
+	// a second failure replaces the backup of the first one
+	if err := os.Rename(dir+"/OpenIM_v3_1695766238.db.bak", dir+"/OpenIM_v3_1695766238.db"); err != nil {
+		t.Fatal(err)
+	}
+	db, err = NewDataBase(ctx, "1695766238", dir, 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var baks []string
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), ".bak") {
+			baks = append(baks, entry.Name())
+		}
+	}
+	if len(baks) != 1 || baks[0] != "OpenIM_v3_1695766238.db.bak" {
+		t.Fatal(baks)
+	}
+	appVersion, err := db.GetAppSDKVersion(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if appVersion.SchemaVersion != latestSchemaVersion() || appVersion.Installed {
+		t.Fatalf("app version = %+v", appVersion)
+	}
+	friends, err := db.GetFriendInfoList(ctx, []string{"friend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(friends) != 0 {
+		t.Fatalf("friends = %+v", friends)
+	}
+}
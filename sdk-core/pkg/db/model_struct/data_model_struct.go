@@ -191,6 +191,13 @@ type LocalChatLog struct {
 	LocalEx          string `gorm:"column:local_ex;type:varchar(1024)" json:"localEx"`
 }
 
+// LocalChatLogSearchResult is a message found by full-text search. A higher Score is a more
+// relevant match.
+type LocalChatLogSearchResult struct {
+	LocalChatLog
+	Score float64 `gorm:"column:score" json:"score"`
+}
+
 type LocalConversation struct {
 	ConversationID        string `gorm:"column:conversation_id;primary_key;type:char(128)" json:"conversationID"`
 	ConversationType      int32  `gorm:"column:conversation_type" json:"conversationType"`
@@ -216,6 +223,7 @@ type LocalConversation struct {
 	MinSeq                int64  `gorm:"column:min_seq" json:"minSeq"`
 	MsgDestructTime       int64  `gorm:"column:msg_destruct_time;default:604800" json:"msgDestructTime"`
 	IsMsgDestruct         bool   `gorm:"column:is_msg_destruct;default:false" json:"isMsgDestruct"`
+	FolderIDs             string `gorm:"column:folder_ids;type:text" json:"folderIDs"`
 }
 
 func (LocalConversation) TableName() string {
@@ -297,6 +305,39 @@ func (LocalUserCommand) TableName() string {
 	return "local_user_command"
 }
 
+// LocalFavorite is a starred message. Msg is the snapshot of the message as a MsgStruct JSON,
+// its links to uploaded files point to copies kept for the favorite.
+type LocalFavorite struct {
+	FavoriteID     string      `gorm:"column:favorite_id;primary_key;type:varchar(64)" json:"favoriteID"`
+	ConversationID string      `gorm:"column:conversation_id;type:char(128)" json:"conversationID"`
+	Seq            int64       `gorm:"column:seq" json:"seq"`
+	Msg            string      `gorm:"column:msg;type:text" json:"msg"`
+	Attachments    string      `gorm:"column:attachments;type:text" json:"attachments"`
+	Tags           StringArray `gorm:"column:tags;type:text" json:"tags"`
+	Note           string      `gorm:"column:note;type:text" json:"note"`
+	CreateTime     int64       `gorm:"column:create_time;index:index_favorite_create_time" json:"createTime"`
+	UpdateTime     int64       `gorm:"column:update_time" json:"updateTime"`
+}
+
+func (LocalFavorite) TableName() string {
+	return "local_favorites"
+}
+
+// LocalConversationFolder is a conversation folder of the user. Rule is the smart folder rule
+// as a JSON object, empty for a regular folder whose conversations list it in FolderIDs.
+type LocalConversationFolder struct {
+	FolderID   string `gorm:"column:folder_id;primary_key;type:varchar(64)" json:"folderID"`
+	Name       string `gorm:"column:name;type:varchar(255)" json:"name"`
+	Sort       int32  `gorm:"column:sort" json:"sort"`
+	Rule       string `gorm:"column:rule;type:text" json:"rule"`
+	CreateTime int64  `gorm:"column:create_time" json:"createTime"`
+	UpdateTime int64  `gorm:"column:update_time" json:"updateTime"`
+}
+
+func (LocalConversationFolder) TableName() string {
+	return "local_conversation_folders"
+}
+
 type StringArray []string
 
 func (a StringArray) Value() (driver.Value, error) {
@@ -327,6 +368,8 @@ func (LocalVersionSync) TableName() string {
 type LocalAppSDKVersion struct {
 	Version   string `gorm:"column:version;type:varchar(255);primary_key" json:"version"`
 	Installed bool   `gorm:"column:installed" json:"installed"` // Mark whether it has already been loaded
+	// SchemaVersion is the number of the last applied schema migration.
+	SchemaVersion int `gorm:"column:schema_version" json:"schemaVersion,omitempty"`
 }
 
 func (LocalAppSDKVersion) TableName() string {
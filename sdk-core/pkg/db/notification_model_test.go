@@ -9,7 +9,7 @@ import (
 
 func Test_BatchInsertNotificationSeq(t *testing.T) {
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
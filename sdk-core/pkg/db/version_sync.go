@@ -67,6 +67,7 @@ func (d *DataBase) SetVersionSync(ctx context.Context, lv *model_struct.LocalVer
 func (d *DataBase) DeleteVersionSync(ctx context.Context, tableName, entityID string) error {
 	d.mRWMutex.Lock()
 	defer d.mRWMutex.Unlock()
-	localVersionSync := model_struct.LocalVersionSync{Table: tableName, EntityID: entityID}
-	return errs.WrapMsg(d.conn.WithContext(ctx).Delete(&localVersionSync).Error, "DeleteVersionSync failed")
+	// a condition on each key column, the bundled SQLite has no row values for the composite key
+	err := d.conn.WithContext(ctx).Where("table_name = ? AND entity_id = ?", tableName, entityID).Delete(&model_struct.LocalVersionSync{}).Error
+	return errs.WrapMsg(err, "DeleteVersionSync failed")
 }
@@ -10,7 +10,7 @@ import (
 func Test_GetVersionSync(t *testing.T) {
 
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -28,7 +28,7 @@ func Test_GetVersionSync(t *testing.T) {
 func Test_SetVersionSync(t *testing.T) {
 
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -55,7 +55,7 @@ func Test_SetVersionSync(t *testing.T) {
 func Test_DeleteVersionSync(t *testing.T) {
 
 	ctx := context.Background()
-	db, err := NewDataBase(ctx, "1695766238", "./", 6)
+	db, err := NewDataBase(ctx, "1695766238", "./", 6, "")
 	if err != nil {
 		return
 	}
@@ -0,0 +1,289 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+const (
+	tagOrientation = 0x0112
+	tagGPSIFD      = 0x8825
+)
+
+var (
+	exifHeader = []byte("Exif\x00\x00")
+	xmpHeader  = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	pngMagic   = []byte("\x89PNG\r\n\x1a\n")
+	xmpKeyword = []byte("XML:com.adobe.xmp\x00")
+)
+
+// tiffTypeSize is the byte size of a value of each TIFF field type.
+var tiffTypeSize = map[uint16]uint32{1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8}
+
+// jpegSegment is a marker segment before the image data, data excludes the marker and length.
+type jpegSegment struct {
+	marker byte
+	start  int // offset of the 0xFF of the marker
+	end    int
+	data   []byte
+}
+
+// jpegSegments returns the segments from SOI up to the start of scan, or nil if data is not a
+// JPEG.
+func jpegSegments(data []byte) []jpegSegment {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil
+	}
+	var segments []jpegSegment
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return segments
+		}
+		marker := data[i+1]
+		if marker == 0xFF {
+			i++ // fill byte
+			continue
+		}
+		if marker == 0xDA || marker == 0xD9 {
+			return segments
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return segments
+		}
+		segments = append(segments, jpegSegment{marker: marker, start: i, end: i + 2 + length, data: data[i+4 : i+2+length]})
+		i += 2 + length
+	}
+	return segments
+}
+
+// tiff is the TIFF structure inside an EXIF segment.
+type tiff struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+func parseTIFF(data []byte) (*tiff, uint32, bool) {
+	if len(data) < 8 {
+		return nil, 0, false
+	}
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, 0, false
+	}
+	if order.Uint16(data[2:]) != 42 {
+		return nil, 0, false
+	}
+	return &tiff{data: data, order: order}, order.Uint32(data[4:]), true
+}
+
+// entries returns the offsets of the 12 byte entries of the IFD at offset.
+func (t *tiff) entries(offset uint32) []uint32 {
+	if uint64(offset)+2 > uint64(len(t.data)) {
+		return nil
+	}
+	n := uint32(t.order.Uint16(t.data[offset:]))
+	if uint64(offset)+2+uint64(n)*12 > uint64(len(t.data)) {
+		return nil
+	}
+	entries := make([]uint32, n)
+	for i := range entries {
+		entries[i] = offset + 2 + uint32(i)*12
+	}
+	return entries
+}
+
+func (t *tiff) find(ifd uint32, tag uint16) (uint32, bool) {
+	for _, e := range t.entries(ifd) {
+		if t.order.Uint16(t.data[e:]) == tag {
+			return e, true
+		}
+	}
+	return 0, false
+}
+
+// clearIFD zeroes the values of an IFD and leaves it with no entries.
+func (t *tiff) clearIFD(offset uint32) {
+	entries := t.entries(offset)
+	for _, e := range entries {
+		size := tiffTypeSize[t.order.Uint16(t.data[e+2:])] * t.order.Uint32(t.data[e+4:])
+		if size > 4 {
+			valueOffset := uint64(t.order.Uint32(t.data[e+8:]))
+			if valueOffset+uint64(size) <= uint64(len(t.data)) {
+				clear(t.data[valueOffset : valueOffset+uint64(size)])
+			}
+		}
+		clear(t.data[e : e+12])
+	}
+	if len(entries) > 0 {
+		t.order.PutUint16(t.data[offset:], 0)
+	}
+}
+
+// Orientation returns the EXIF orientation of a JPEG from 1 to 8, 1 if it has none.
+func Orientation(data []byte) int {
+	for _, s := range jpegSegments(data) {
+		if s.marker != 0xE1 || !bytes.HasPrefix(s.data, exifHeader) {
+			continue
+		}
+		t, ifd0, ok := parseTIFF(s.data[len(exifHeader):])
+		if !ok {
+			return 1
+		}
+		e, ok := t.find(ifd0, tagOrientation)
+		if !ok {
+			return 1
+		}
+		if o := int(t.order.Uint16(t.data[e+8:])); o >= 1 && o <= 8 {
+			return o
+		}
+		return 1
+	}
+	return 1
+}
+
+// StripLocation removes the GPS data of a JPEG, PNG or WebP without re-encoding it: the GPS
+// IFD of the EXIF is emptied, and XMP, which may repeat it, is dropped. It reports whether
+// anything was removed.
+func StripLocation(data []byte, format string) ([]byte, bool) {
+	switch format {
+	case "jpeg":
+		return stripJPEG(data)
+	case "png":
+		return stripPNG(data)
+	case "webp":
+		return stripWebP(data)
+	}
+	return data, false
+}
+
+func stripJPEG(data []byte) ([]byte, bool) {
+	segments := jpegSegments(data)
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(data[:2])
+	pos, changed := 2, false
+	for _, s := range segments {
+		out.Write(data[pos:s.start])
+		pos = s.end
+		if s.marker == 0xE1 && bytes.HasPrefix(s.data, xmpHeader) {
+			changed = true
+			continue
+		}
+		seg := data[s.start:s.end]
+		if s.marker == 0xE1 && bytes.HasPrefix(s.data, exifHeader) {
+			exif := bytes.Clone(s.data)
+			if stripEXIF(exif[len(exifHeader):]) {
+				changed = true
+				seg = append(bytes.Clone(data[s.start:s.start+4]), exif...)
+			}
+		}
+		out.Write(seg)
+	}
+	if !changed {
+		return data, false
+	}
+	out.Write(data[pos:])
+	return out.Bytes(), true
+}
+
+// stripEXIF empties the GPS IFD of a TIFF structure in place.
+func stripEXIF(data []byte) bool {
+	t, ifd0, ok := parseTIFF(data)
+	if !ok {
+		return false
+	}
+	e, ok := t.find(ifd0, tagGPSIFD)
+	if !ok {
+		return false
+	}
+	gps := t.order.Uint32(t.data[e+8:])
+	if len(t.entries(gps)) == 0 {
+		return false
+	}
+	t.clearIFD(gps)
+	return true
+}
+
+func stripPNG(data []byte) ([]byte, bool) {
+	if !bytes.HasPrefix(data, pngMagic) {
+		return data, false
+	}
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(pngMagic)
+	changed := false
+	for i := len(pngMagic); i < len(data); {
+		if i+12 > len(data) {
+			out.Write(data[i:])
+			break
+		}
+		end := i + 12 + int(binary.BigEndian.Uint32(data[i:]))
+		if end > len(data) || end < i {
+			out.Write(data[i:])
+			break
+		}
+		typ, body := string(data[i+4:i+8]), data[i+8:end-4]
+		if typ == "eXIf" || (typ == "iTXt" && bytes.HasPrefix(body, xmpKeyword)) {
+			changed = true
+		} else {
+			out.Write(data[i:end])
+		}
+		i = end
+	}
+	if !changed {
+		return data, false
+	}
+	return out.Bytes(), true
+}
+
+func stripWebP(data []byte) ([]byte, bool) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return data, false
+	}
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(data[:12])
+	changed := false
+	for i := 12; i+8 <= len(data); {
+		size := int(binary.LittleEndian.Uint32(data[i+4:]))
+		end := i + 8 + size + size&1
+		if end > len(data) || end < i {
+			out.Write(data[i:])
+			break
+		}
+		switch string(data[i : i+4]) {
+		case "EXIF", "XMP ":
+			changed = true
+		default:
+			out.Write(data[i:end])
+		}
+		i = end
+	}
+	if !changed {
+		return data, false
+	}
+	webp := out.Bytes()
+	// VP8X flags the metadata chunks it has
+	if len(webp) >= 21 && string(webp[12:16]) == "VP8X" {
+		webp[20] &^= 0x04 | 0x08
+	}
+	binary.LittleEndian.PutUint32(webp[4:], uint32(len(webp)-8))
+	return webp, true
+}
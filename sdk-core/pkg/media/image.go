@@ -0,0 +1,258 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package media processes pictures on the device before they are sent: compression, EXIF
+// orientation, location stripping and thumbnails.
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/image/draw"
+
+	_ "golang.org/x/image/bmp"
+	_ "golang.org/x/image/tiff"
+	_ "golang.org/x/image/webp"
+
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	defaultMaxDimension       = 2560
+	defaultQuality            = 80
+	defaultThumbnailDimension = 640
+	// orientationQuality is used when a picture is re-encoded only to make it upright.
+	orientationQuality = 95
+	thumbnailQuality   = 75
+)
+
+// WebPEncoder converts the PNG at srcPath into a WebP at dstPath.
+type WebPEncoder func(srcPath, dstPath string, quality int) error
+
+// Image is a picture file and its info.
+type Image struct {
+	Path   string
+	Width  int32
+	Height int32
+	Type   string
+	Size   int64
+}
+
+// Result is the picture to send, the original path if nothing changed, and its thumbnail.
+type Result struct {
+	Source    Image
+	Thumbnail *Image
+}
+
+// ProcessImage applies opts to the picture at path. New files are written in dir, named name
+// plus an extension; webp may be nil, then JPEG is used instead.
+func ProcessImage(path, dir, name string, opts *sdk_struct.ImageProcessOptions, webp WebPEncoder) (*Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "read image failed", "path", path)
+	}
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "image decode config failed", "path", path)
+	}
+	res := &Result{Source: Image{Path: path, Width: int32(config.Width), Height: int32(config.Height),
+		Type: "image/" + format, Size: int64(len(data))}}
+	if opts == nil {
+		return res, nil
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errs.WrapMsg(err, "create media dir failed")
+	}
+	orientation := 1
+	if format == "jpeg" {
+		orientation = Orientation(data)
+	}
+	var img image.Image
+	decode := func() (image.Image, error) {
+		if img == nil {
+			if img, _, err = image.Decode(bytes.NewReader(data)); err != nil {
+				return nil, errs.WrapMsg(err, "image decode failed", "path", path)
+			}
+		}
+		return img, nil
+	}
+	// re-encoding an animation would keep only its first frame
+	reencode := format != "gif" && (opts.Compress || (opts.FixOrientation && orientation > 1))
+	if reencode {
+		if _, err := decode(); err != nil {
+			return nil, err
+		}
+		maxDimension, quality, target := 0, orientationQuality, "jpeg"
+		if opts.Compress {
+			maxDimension = orDefault(opts.MaxDimension, defaultMaxDimension)
+			quality = min(orDefault(opts.Quality, defaultQuality), 100)
+			if opts.Format == "webp" && webp != nil {
+				target = "webp"
+			}
+		}
+		source, err := encode(img, orientation, maxDimension, quality, target, filepath.Join(dir, name), webp)
+		if err != nil {
+			return nil, err
+		}
+		// compressing a small picture may grow it, send the original then
+		unchanged := orientation == 1 && format == target && source.Width == res.Source.Width && source.Height == res.Source.Height
+		if unchanged && source.Size >= res.Source.Size {
+			_ = os.Remove(source.Path)
+			reencode = false
+		} else {
+			res.Source = *source
+		}
+	}
+	if !reencode && opts.StripLocation {
+		if stripped, ok := StripLocation(data, format); ok {
+			dst := filepath.Join(dir, name+filepath.Ext(path))
+			if err := os.WriteFile(dst, stripped, 0644); err != nil {
+				return nil, errs.WrapMsg(err, "write image failed", "path", dst)
+			}
+			res.Source.Path, res.Source.Size = dst, int64(len(stripped))
+		}
+	}
+	if opts.Thumbnail {
+		if _, err := decode(); err != nil {
+			return nil, err
+		}
+		dimension := orDefault(opts.ThumbnailDimension, defaultThumbnailDimension)
+		thumbnail, err := encode(img, orientation, dimension, thumbnailQuality, "jpeg", filepath.Join(dir, name+"_thumb"), nil)
+		if err != nil {
+			return nil, err
+		}
+		res.Thumbnail = thumbnail
+	}
+	return res, nil
+}
+
+// encode writes img upright and scaled to fit maxDimension, 0 for no limit, as format at
+// base plus an extension. Encoding drops all metadata.
+func encode(img image.Image, orientation int, maxDimension int, quality int, format string, base string, webp WebPEncoder) (*Image, error) {
+	rgba := render(img, maxDimension, format == "jpeg")
+	rgba = orient(rgba, orientation)
+	var buf bytes.Buffer
+	var err error
+	dst := base + ".jpg"
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, rgba, &jpeg.Options{Quality: quality})
+	} else {
+		dst = base + ".webp"
+		err = png.Encode(&buf, rgba)
+	}
+	if err != nil {
+		return nil, errs.WrapMsg(err, "image encode failed")
+	}
+	size := rgba.Bounds().Size()
+	out := &Image{Path: dst, Width: int32(size.X), Height: int32(size.Y), Type: "image/" + format}
+	if format == "webp" {
+		tmp := base + ".png"
+		if err := os.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+			return nil, errs.WrapMsg(err, "write image failed", "path", tmp)
+		}
+		defer os.Remove(tmp)
+		if err := webp(tmp, dst, quality); err != nil {
+			return nil, errs.WrapMsg(err, "webp encode failed")
+		}
+		info, err := os.Stat(dst)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "webp encoder wrote no file", "path", dst)
+		}
+		out.Size = info.Size()
+		return out, nil
+	}
+	if err := os.WriteFile(dst, buf.Bytes(), 0644); err != nil {
+		return nil, errs.WrapMsg(err, "write image failed", "path", dst)
+	}
+	out.Size = int64(buf.Len())
+	return out, nil
+}
+
+// render scales img to fit maxDimension; opaque puts transparent pixels on white, for formats
+// without alpha.
+func render(img image.Image, maxDimension int, opaque bool) *image.RGBA {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if maxDimension > 0 && max(w, h) > maxDimension {
+		if w >= h {
+			w, h = maxDimension, max(h*maxDimension/w, 1)
+		} else {
+			w, h = max(w*maxDimension/h, 1), maxDimension
+		}
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	op := draw.Src
+	if opaque {
+		draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+		op = draw.Over
+	}
+	if w == b.Dx() && h == b.Dy() {
+		draw.Draw(dst, dst.Bounds(), img, b.Min, op)
+	} else {
+		draw.BiLinear.Scale(dst, dst.Bounds(), img, b, op, nil)
+	}
+	return dst
+}
+
+// orient turns an image stored with an EXIF orientation upright.
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored
+				dx, dy = w-1-x, y
+			case 3: // rotated 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				dx, dy = x, h-1-y
+			case 5: // transposed
+				dx, dy = y, x
+			case 6: // rotated 90 clockwise
+				dx, dy = h-1-y, x
+			case 7: // transversed
+				dx, dy = h-1-y, w-1-x
+			case 8: // rotated 90 counterclockwise
+				dx, dy = y, w-1-x
+			}
+			d, s := dst.PixOffset(dx, dy), src.PixOffset(src.Rect.Min.X+x, src.Rect.Min.Y+y)
+			copy(dst.Pix[d:d+4], src.Pix[s:s+4])
+		}
+	}
+	return dst
+}
+
+func orDefault(v, def int) int {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
@@ -0,0 +1,108 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+// testJPEG returns a w*h JPEG whose EXIF has the orientation and a GPS IFD with a latitude.
+func testJPEG(t *testing.T, w, h int, orientation uint16) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	le := binary.LittleEndian
+	tiff := []byte("II*\x00\x08\x00\x00\x00")
+	// IFD0 at 8: orientation and GPS pointer
+	tiff = le.AppendUint16(tiff, 2)
+	tiff = append(le.AppendUint16(le.AppendUint16(tiff, tagOrientation), 3), 1, 0, 0, 0)
+	tiff = le.AppendUint16(le.AppendUint16(tiff, orientation), 0)
+	tiff = append(le.AppendUint16(le.AppendUint16(tiff, tagGPSIFD), 4), 1, 0, 0, 0)
+	tiff = le.AppendUint32(tiff, 38)
+	tiff = le.AppendUint32(tiff, 0)
+	// GPS IFD at 38: latitude, 3 rationals at 56
+	tiff = le.AppendUint16(tiff, 1)
+	tiff = append(le.AppendUint16(le.AppendUint16(tiff, 2), 5), 3, 0, 0, 0)
+	tiff = le.AppendUint32(tiff, 56)
+	tiff = le.AppendUint32(tiff, 0)
+	for _, v := range []uint32{31, 1, 14, 1, 5, 1} {
+		tiff = le.AppendUint32(tiff, v)
+	}
+	exif := append([]byte("Exif\x00\x00"), tiff...)
+	segment := binary.BigEndian.AppendUint16([]byte{0xFF, 0xE1}, uint16(len(exif)+2))
+	jpg := buf.Bytes()
+	return append(append(append([]byte{}, jpg[:2]...), append(segment, exif...)...), jpg[2:]...)
+}
+
+func TestStripLocation(t *testing.T) {
+	data := testJPEG(t, 8, 8, 6)
+	if o := Orientation(data); o != 6 {
+		t.Fatalf("orientation %d, want 6", o)
+	}
+	stripped, ok := StripLocation(data, "jpeg")
+	if !ok {
+		t.Fatal("location not stripped")
+	}
+	if len(stripped) != len(data) || bytes.Contains(stripped, binary.LittleEndian.AppendUint32(nil, 31)) {
+		t.Fatal("gps values left")
+	}
+	if o := Orientation(stripped); o != 6 {
+		t.Errorf("orientation %d after strip, want 6", o)
+	}
+	if _, err := jpeg.Decode(bytes.NewReader(stripped)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := StripLocation(stripped, "jpeg"); ok {
+		t.Error("stripped twice")
+	}
+}
+
+func TestProcessImage(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.jpg")
+	if err := os.WriteFile(src, testJPEG(t, 200, 100, 6), 0644); err != nil {
+		t.Fatal(err)
+	}
+	res, err := ProcessImage(src, dir, "out", &sdk_struct.ImageProcessOptions{
+		Compress: true, MaxDimension: 100, Thumbnail: true, ThumbnailDimension: 20,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// stored 200x100 landscape, upright 50x100 portrait once scaled
+	if res.Source.Path == src || res.Source.Width != 50 || res.Source.Height != 100 {
+		t.Errorf("source %+v", res.Source)
+	}
+	if res.Thumbnail == nil || res.Thumbnail.Width != 10 || res.Thumbnail.Height != 20 {
+		t.Errorf("thumbnail %+v", res.Thumbnail)
+	}
+	out, err := os.ReadFile(res.Source.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(out, exifHeader) {
+		t.Error("metadata kept after compression")
+	}
+
+	res, err = ProcessImage(src, dir, "strip", &sdk_struct.ImageProcessOptions{StripLocation: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Source.Path == src || res.Source.Width != 200 || res.Thumbnail != nil {
+		t.Errorf("strip only %+v", res)
+	}
+}
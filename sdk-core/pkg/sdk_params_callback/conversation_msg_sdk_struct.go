@@ -62,6 +62,9 @@ type SearchLocalMessagesParams struct {
 	SearchTimePeriod     int64    `json:"searchTimePeriod"`
 	PageIndex            int      `json:"pageIndex"`
 	Count                int      `json:"count"`
+	// FullTextSearch ranks keyword matches by relevance and returns snippets with highlights.
+	// Without a ConversationID, PageIndex and Count then page through all conversations.
+	FullTextSearch bool `json:"fullTextSearch"`
 }
 
 type SearchLocalMessagesCallback struct {
@@ -77,4 +80,51 @@ type SearchByConversationResult struct {
 	LatestMsgSendTime int64                   `json:"latestMsgSendTime,omitempty"`
 	MessageCount      int                     `json:"messageCount"`
 	MessageList       []*sdk_struct.MsgStruct `json:"messageList"`
+	// MatchList is set by full-text search, in the same order as MessageList.
+	MatchList []*SearchMessageMatch `json:"matchList,omitempty"`
+}
+
+type SearchMessageMatch struct {
+	ClientMsgID string  `json:"clientMsgID"`
+	Score       float64 `json:"score"`
+	Snippet     string  `json:"snippet"`
+	// Highlights are the [start, end) ranges of the keywords in Snippet, in UTF-16 code units.
+	Highlights [][2]int `json:"highlights"`
+}
+
+type ExportLocalBackupParams struct {
+	// FilePath of the backup, a new file in the data dir if empty.
+	FilePath string `json:"filePath"`
+	Password string `json:"password"`
+	// Upload also stores the backup under the user's own object prefix.
+	Upload bool `json:"upload"`
+}
+
+type ExportLocalBackupResp struct {
+	FilePath          string `json:"filePath"`
+	URL               string `json:"url,omitempty"`
+	ConversationCount int    `json:"conversationCount"`
+	MessageCount      int    `json:"messageCount"`
+}
+
+type RestoreLocalBackupParams struct {
+	FilePath string `json:"filePath"`
+	Password string `json:"password"`
+}
+
+type RestoreLocalBackupResp struct {
+	ConversationCount   int `json:"conversationCount"`   // conversations added from the backup
+	MessageCount        int `json:"messageCount"`        // messages added from the backup
+	SkippedMessageCount int `json:"skippedMessageCount"` // messages already in the local db
+}
+
+type DownloadMessageFileParams struct {
+	ConversationID string `json:"conversationID"`
+	ClientMsgID    string `json:"clientMsgID"`
+	// Snapshot downloads the thumbnail of a picture or the cover of a video instead.
+	Snapshot bool `json:"snapshot"`
+}
+
+type DownloadMessageFileResp struct {
+	FilePath string `json:"filePath"`
 }
@@ -49,4 +49,9 @@ const (
 	// Group-related errors
 	GroupIDNotFoundError = 10400 // GroupID not found
 	GroupTypeErr         = 10401 // Invalid group type
+
+	// Local database errors
+	DBKeyError          = 10500 // Local database key is missing or wrong
+	BackupPasswordError = 10501 // Local backup password is wrong
+	BackupFormatError   = 10502 // Local backup file is damaged or of an unknown version
 )
@@ -47,6 +47,11 @@ var (
 	// Group-related errors
 	ErrGroupType = errs.NewCodeError(GroupTypeErr, "Invalid group type")
 
+	// Local database errors
+	ErrDBKey          = errs.NewCodeError(DBKeyError, "Local database key is missing or wrong")
+	ErrBackupPassword = errs.NewCodeError(BackupPasswordError, "Local backup password is wrong")
+	ErrBackupFormat   = errs.NewCodeError(BackupFormatError, "Local backup file is damaged or of an unknown version")
+
 	ErrLoginOut    = errs.NewCodeError(LoginOutError, "User has logged out")
 	ErrLoginRepeat = errs.NewCodeError(LoginRepeatError, "User has logged in repeatedly")
 )
@@ -0,0 +1,247 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package search holds the message full-text search logic shared by the native and wasm
+// databases: the searchable text of a message, its tokenization, and snippets with highlights.
+package search
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+const (
+	snippetContext = 12 // runes kept before the first match
+	snippetLength  = 48 // runes of a snippet, longer if the match is
+	ellipsis       = "…"
+)
+
+// Text returns the text of a message that search matches against, e.g. the typed text or the
+// file name. Merged and quoted messages only contribute their own title or text.
+func Text(contentType int32, content string) string {
+	switch contentType {
+	case constant.Text:
+		var elem sdk_struct.TextElem
+		_ = utils.JsonStringToStruct(content, &elem)
+		return elem.Content
+	case constant.AtText:
+		var elem sdk_struct.AtTextElem
+		_ = utils.JsonStringToStruct(content, &elem)
+		return elem.Text
+	case constant.File:
+		var elem sdk_struct.FileElem
+		_ = utils.JsonStringToStruct(content, &elem)
+		return elem.FileName
+	case constant.Merger:
+		var elem sdk_struct.MergeElem
+		_ = utils.JsonStringToStruct(content, &elem)
+		return elem.Title
+	case constant.Card:
+		var elem sdk_struct.CardElem
+		_ = utils.JsonStringToStruct(content, &elem)
+		return elem.Nickname
+	case constant.Location:
+		var elem sdk_struct.LocationElem
+		_ = utils.JsonStringToStruct(content, &elem)
+		return elem.Description
+	case constant.Custom:
+		var elem sdk_struct.CustomElem
+		_ = utils.JsonStringToStruct(content, &elem)
+		return elem.Description
+	case constant.Quote:
+		var elem sdk_struct.QuoteElem
+		_ = utils.JsonStringToStruct(content, &elem)
+		return elem.Text
+	default:
+		return ""
+	}
+}
+
+type token struct {
+	text       string
+	start, end int // rune offsets
+	cjk        bool
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+func isTokenRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.Is(unicode.Mn, r)
+}
+
+// tokenize splits text like the unicode61 tokenizer does after Segment: runs of letters and
+// digits, with every CJK character a token of its own.
+func tokenize(text string) []token {
+	var (
+		tokens []token
+		runes  = []rune(text)
+		start  = -1
+	)
+	flush := func(end int) {
+		if start >= 0 {
+			tokens = append(tokens, token{text: strings.ToLower(string(runes[start:end])), start: start, end: end})
+			start = -1
+		}
+	}
+	for i, r := range runes {
+		switch {
+		case isCJK(r):
+			flush(i)
+			tokens = append(tokens, token{text: string(r), start: i, end: i + 1, cjk: true})
+		case isTokenRune(r):
+			if start < 0 {
+				start = i
+			}
+		default:
+			flush(i)
+		}
+	}
+	flush(len(runes))
+	return tokens
+}
+
+// Segment prepares text for the unicode61 tokenizer, which would keep a run of CJK characters
+// as one token. Every CJK character is separated, so any substring of CJK text can be found
+// as a phrase of single-character tokens.
+func Segment(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		if isCJK(r) {
+			b.WriteByte(' ')
+			b.WriteRune(r)
+			b.WriteByte(' ')
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Query builds the FTS5 MATCH expression for the keywords. Each keyword is a phrase whose last
+// token also matches as a prefix. Only letters and digits reach the expression, so keywords
+// cannot inject query syntax. It returns "" if no keyword has a searchable character.
+func Query(keywordList []string, keywordListMatchType int) string {
+	var phrases []string
+	for _, keyword := range keywordList {
+		tokens := tokenize(keyword)
+		if len(tokens) == 0 {
+			continue
+		}
+		texts := make([]string, len(tokens))
+		for i, t := range tokens {
+			texts[i] = t.text
+		}
+		phrase := `"` + strings.Join(texts, " ") + `"`
+		if !tokens[len(tokens)-1].cjk {
+			phrase += "*"
+		}
+		phrases = append(phrases, phrase)
+	}
+	op := " AND "
+	if keywordListMatchType == constant.KeywordMatchOr {
+		op = " OR "
+	}
+	return strings.Join(phrases, op)
+}
+
+// matches returns the rune ranges of text where a keyword matches the way Query does.
+func matches(text string, keywordList []string) [][2]int {
+	textTokens := tokenize(text)
+	var spans [][2]int
+	for _, keyword := range keywordList {
+		query := tokenize(keyword)
+		if len(query) == 0 {
+			continue
+		}
+	next:
+		for i := 0; i+len(query) <= len(textTokens); i++ {
+			for j, q := range query {
+				t := textTokens[i+j]
+				if t.text != q.text && (j < len(query)-1 || q.cjk || !strings.HasPrefix(t.text, q.text)) {
+					continue next
+				}
+			}
+			spans = append(spans, [2]int{textTokens[i].start, textTokens[i+len(query)-1].end})
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+	var merged [][2]int
+	for _, s := range spans {
+		if n := len(merged); n > 0 && s[0] <= merged[n-1][1] {
+			if s[1] > merged[n-1][1] {
+				merged[n-1][1] = s[1]
+			}
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+func utf16Len(runes []rune) int {
+	n := 0
+	for _, r := range runes {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
+// Snippet returns the part of text around the first match of the keywords and the matched
+// ranges within it. Ranges are [start, end) in UTF-16 code units, the string indexes of JS,
+// Java and Objective-C.
+func Snippet(text string, keywordList []string) (string, [][2]int) {
+	runes := []rune(text)
+	spans := matches(text, keywordList)
+	start := 0
+	if len(spans) > 0 && spans[0][0] > snippetContext {
+		start = spans[0][0] - snippetContext
+	}
+	end := start + snippetLength
+	if len(spans) > 0 && end < spans[0][1] {
+		end = spans[0][1]
+	}
+	if end > len(runes) {
+		end = len(runes)
+	}
+	var prefix, suffix string
+	if start > 0 {
+		prefix = ellipsis
+	}
+	if end < len(runes) {
+		suffix = ellipsis
+	}
+	var highlights [][2]int
+	for _, s := range spans {
+		if s[0] >= end {
+			break
+		}
+		if s[1] > end {
+			s[1] = end
+		}
+		offset := utf16Len([]rune(prefix)) - utf16Len(runes[:start])
+		highlights = append(highlights, [2]int{utf16Len(runes[:s[0]]) + offset, utf16Len(runes[:s[1]]) + offset})
+	}
+	return prefix + string(runes[start:end]) + suffix, highlights
+}
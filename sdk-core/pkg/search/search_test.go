@@ -0,0 +1,41 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+)
+
+func TestQuery(t *testing.T) {
+	for _, c := range []struct {
+		keywords  []string
+		matchType int
+		want      string
+	}{
+		{[]string{"Hello wor"}, constant.KeywordMatchAnd, `"hello wor"*`},
+		{[]string{"天气", "a"}, constant.KeywordMatchOr, `"天 气" OR "a"*`},
+		{[]string{`x" OR "y`}, constant.KeywordMatchAnd, `"x or y"*`},
+		{[]string{`"*()`}, constant.KeywordMatchAnd, ``},
+	} {
+		if got := Query(c.keywords, c.matchType); got != c.want {
+			t.Errorf("Query(%q) = %s, want %s", c.keywords, got, c.want)
+		}
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	snippet, highlights := Snippet("Hello 😀 世界, hello world", []string{"hello", "世界"})
+	if snippet != "Hello 😀 世界, hello world" {
+		t.Fatalf("snippet = %q", snippet)
+	}
+	// 😀 is two UTF-16 code units
+	if want := [][2]int{{0, 5}, {9, 11}, {13, 18}}; !reflect.DeepEqual(highlights, want) {
+		t.Fatalf("highlights = %v, want %v", highlights, want)
+	}
+
+	snippet, highlights = Snippet("one two three four five six seven eight nine ten eleven twelve", []string{"twelve"})
+	if snippet != "… ten eleven twelve" || !reflect.DeepEqual(highlights, [][2]int{{13, 19}}) {
+		t.Fatalf("snippet = %q, highlights = %v", snippet, highlights)
+	}
+}
@@ -67,6 +67,41 @@ type ImageInfo struct {
 	Type   string `json:"type,omitempty"`
 	Size   int64  `json:"size"`
 }
+
+// ImageProcessOptions selects the steps applied to a picture on the device before it is sent.
+// Each step is off unless set.
+type ImageProcessOptions struct {
+	// Compress re-encodes the picture, scaled down to MaxDimension. The original is kept if it
+	// is already smaller and needs no other change.
+	Compress bool `json:"compress"`
+	// Format of the compressed picture, "jpeg" or "webp". WebP needs a MediaProcessor, JPEG is
+	// used without one.
+	Format string `json:"format,omitempty"`
+	// MaxDimension bounds the longest side of the compressed picture, 2560 if zero.
+	MaxDimension int `json:"maxDimension"`
+	// Quality of the compressed picture from 1 to 100, 80 if zero.
+	Quality int `json:"quality"`
+	// FixOrientation rotates the pixels as the EXIF orientation says, so that receivers that
+	// ignore EXIF show the picture upright. Re-encoded pictures are always upright.
+	FixOrientation bool `json:"fixOrientation"`
+	// StripLocation removes GPS data from the EXIF of the picture.
+	StripLocation bool `json:"stripLocation"`
+	// Thumbnail generates a thumbnail on the device, sent as the snapshot picture instead of
+	// the one resized by the server.
+	Thumbnail bool `json:"thumbnail"`
+	// ThumbnailDimension bounds the longest side of the thumbnail, 640 if zero.
+	ThumbnailDimension int `json:"thumbnailDimension"`
+}
+
+// VideoProcessOptions selects the steps applied to a video on the device before it is sent.
+type VideoProcessOptions struct {
+	// ExtractSnapshot asks the MediaProcessor for the first frame as the snapshot when none is
+	// given.
+	ExtractSnapshot bool `json:"extractSnapshot"`
+	// Snapshot processes the snapshot like a picture; its thumbnail step is ignored.
+	Snapshot *ImageProcessOptions `json:"snapshot,omitempty"`
+}
+
 type PictureBaseInfo struct {
 	UUID   string `json:"uuid,omitempty"`
 	Type   string `json:"type,omitempty"`
@@ -74,6 +109,7 @@ type PictureBaseInfo struct {
 	Width  int32  `json:"width"`
 	Height int32  `json:"height"`
 	Url    string `json:"url,omitempty"`
+	MD5    string `json:"md5,omitempty"` // hex md5 of the uploaded file, checked on download
 }
 type SoundBaseInfo struct {
 	UUID      string `json:"uuid,omitempty"`
@@ -120,6 +156,7 @@ type CardElem struct {
 
 type PictureElem struct {
 	SourcePath      string           `json:"sourcePath,omitempty"`
+	SnapshotPath    string           `json:"snapshotPath,omitempty"`
 	SourcePicture   *PictureBaseInfo `json:"sourcePicture,omitempty"`
 	BigPicture      *PictureBaseInfo `json:"bigPicture,omitempty"`
 	SnapshotPicture *PictureBaseInfo `json:"snapshotPicture,omitempty"`
@@ -132,6 +169,7 @@ type SoundElem struct {
 	DataSize  int64  `json:"dataSize"`
 	Duration  int64  `json:"duration"`
 	SoundType string `json:"soundType,omitempty"`
+	MD5       string `json:"md5,omitempty"`
 }
 
 type VideoElem struct {
@@ -148,6 +186,8 @@ type VideoElem struct {
 	SnapshotWidth  int32  `json:"snapshotWidth"`
 	SnapshotHeight int32  `json:"snapshotHeight"`
 	SnapshotType   string `json:"snapshotType,omitempty"`
+	VideoMD5       string `json:"videoMD5,omitempty"`
+	SnapshotMD5    string `json:"snapshotMD5,omitempty"`
 }
 
 type FileElem struct {
@@ -157,6 +197,7 @@ type FileElem struct {
 	FileName  string `json:"fileName,omitempty"`
 	FileSize  int64  `json:"fileSize"`
 	FileType  string `json:"fileType,omitempty"`
+	MD5       string `json:"md5,omitempty"`
 }
 
 type MergeElem struct {
@@ -263,7 +304,16 @@ type AttachedInfoElem struct {
 	IsEncryption      bool             `json:"isEncryption"`
 	InEncryptStatus   bool             `json:"inEncryptStatus"`
 	//MessageReactionElem       []*ReactionElem  `json:"messageReactionElem,omitempty"`
-	Progress *UploadProgress `json:"uploadProgress,omitempty"`
+	Progress    *UploadProgress `json:"uploadProgress,omitempty"`
+	ForwardInfo *ForwardInfo    `json:"forwardInfo,omitempty"`
+}
+
+// ForwardInfo is the origin of a message forwarded by the server.
+type ForwardInfo struct {
+	SendID         string `json:"sendID"`
+	SenderNickname string `json:"senderNickname"`
+	SenderFaceURL  string `json:"senderFaceUrl"`
+	SendTime       int64  `json:"sendTime"`
 }
 
 type UploadProgress struct {
@@ -325,6 +375,12 @@ type IMConfig struct {
 	IsLogStandardOutput  bool   `json:"isLogStandardOutput"`
 	LogFilePath          string `json:"logFilePath"`
 	IsExternalExtensions bool   `json:"isExternalExtensions"`
+	// DBEncryptKey encrypts the local database at rest when set, including cached upload
+	// state. Not supported on web.
+	DBEncryptKey string `json:"dbEncryptKey"`
+	// MediaCacheSize bounds the cache of downloaded message files in DataDir, in bytes.
+	// 1GB if zero.
+	MediaCacheSize int64 `json:"mediaCacheSize"`
 }
 
 type CmdNewMsgComeToConversation struct {
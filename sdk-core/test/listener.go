@@ -83,6 +83,10 @@ func (o *onConversationListener) OnConversationUserInputStatusChanged(change str
 	log.ZInfo(o.ctx, "OnConversationUserInputStatusChanged", "change", change)
 }
 
+func (o *onConversationListener) OnConversationFoldersChanged(folderList string) {
+	log.ZInfo(o.ctx, "OnConversationFoldersChanged", "folderList", folderList)
+}
+
 type onGroupListener struct {
 	ctx context.Context
 }
@@ -131,6 +135,14 @@ func (o *onGroupListener) OnGroupApplicationRejected(groupApplication string) {
 	log.ZInfo(o.ctx, "OnGroupApplicationRejected", "groupApplication", groupApplication)
 }
 
+func (o *onGroupListener) OnGroupAnnouncementRemind(announcementTips string) {
+	log.ZInfo(o.ctx, "OnGroupAnnouncementRemind", "announcementTips", announcementTips)
+}
+
+func (o *onGroupListener) OnGroupAnnouncementConfirmed(confirmedTips string) {
+	log.ZInfo(o.ctx, "OnGroupAnnouncementConfirmed", "confirmedTips", confirmedTips)
+}
+
 type onAdvancedMsgListener struct {
 	ctx context.Context
 }
@@ -244,6 +256,15 @@ func (o *onUserListener) OnUserCommandDelete(userInfo string) {
 func (o *onUserListener) OnUserCommandUpdate(userInfo string) {
 	log.ZDebug(context.Background(), "OnUserCommandUpdate", "blackInfo", userInfo)
 }
+func (o *onUserListener) OnFavoriteAdded(favorite string) {
+	log.ZDebug(context.Background(), "OnFavoriteAdded", "favorite", favorite)
+}
+func (o *onUserListener) OnFavoriteDeleted(favorite string) {
+	log.ZDebug(context.Background(), "OnFavoriteDeleted", "favorite", favorite)
+}
+func (o *onUserListener) OnFavoriteUpdated(favorite string) {
+	log.ZDebug(context.Background(), "OnFavoriteUpdated", "favorite", favorite)
+}
 func (o *onUserListener) OnUserStatusChanged(statusMap string) {
 	log.ZDebug(context.Background(), "OnUserStatusChanged", "OnUserStatusChanged", statusMap)
 }
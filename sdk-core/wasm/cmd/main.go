@@ -124,6 +124,15 @@ func registerFunc() {
 
 	js.Global().Set("changeInputStates", js.FuncOf(wrapperConMsg.ChangeInputStates))
 	js.Global().Set("getInputStates", js.FuncOf(wrapperConMsg.GetInputStates))
+	js.Global().Set("createConversationFolder", js.FuncOf(wrapperConMsg.CreateConversationFolder))
+	js.Global().Set("updateConversationFolder", js.FuncOf(wrapperConMsg.UpdateConversationFolder))
+	js.Global().Set("deleteConversationFolder", js.FuncOf(wrapperConMsg.DeleteConversationFolder))
+	js.Global().Set("getConversationFolders", js.FuncOf(wrapperConMsg.GetConversationFolders))
+	js.Global().Set("addConversationsToFolder", js.FuncOf(wrapperConMsg.AddConversationsToFolder))
+	js.Global().Set("removeConversationsFromFolder", js.FuncOf(wrapperConMsg.RemoveConversationsFromFolder))
+	js.Global().Set("getConversationListSplitByFolder", js.FuncOf(wrapperConMsg.GetConversationListSplitByFolder))
+	js.Global().Set("getConversationFolderUnreadCounts", js.FuncOf(wrapperConMsg.GetConversationFolderUnreadCounts))
+	js.Global().Set("forwardMessages", js.FuncOf(wrapperConMsg.ForwardMessages))
 
 	//register group func
 	wrapperGroup := wasm_wrapper.NewWrapperGroup(globalFuc)
@@ -136,6 +145,12 @@ func registerFunc() {
 	js.Global().Set("changeGroupMemberMute", js.FuncOf(wrapperGroup.ChangeGroupMemberMute))
 	//js.Global().Set("setGroupMemberRoleLevel", js.FuncOf(wrapperGroup.SetGroupMemberRoleLevel))
 	js.Global().Set("setGroupMemberInfo", js.FuncOf(wrapperGroup.SetGroupMemberInfo))
+	js.Global().Set("setGroupAdminPermissions", js.FuncOf(wrapperGroup.SetGroupAdminPermissions))
+	js.Global().Set("publishGroupAnnouncement", js.FuncOf(wrapperGroup.PublishGroupAnnouncement))
+	js.Global().Set("getGroupAnnouncements", js.FuncOf(wrapperGroup.GetGroupAnnouncements))
+	js.Global().Set("confirmGroupAnnouncement", js.FuncOf(wrapperGroup.ConfirmGroupAnnouncement))
+	js.Global().Set("getGroupAnnouncementConfirms", js.FuncOf(wrapperGroup.GetGroupAnnouncementConfirms))
+	js.Global().Set("remindGroupAnnouncement", js.FuncOf(wrapperGroup.RemindGroupAnnouncement))
 	js.Global().Set("getJoinedGroupList", js.FuncOf(wrapperGroup.GetJoinedGroupList))
 	js.Global().Set("getJoinedGroupListPage", js.FuncOf(wrapperGroup.GetJoinedGroupListPage))
 	js.Global().Set("searchGroups", js.FuncOf(wrapperGroup.SearchGroups))
@@ -158,6 +173,11 @@ func registerFunc() {
 	js.Global().Set("searchGroupMembers", js.FuncOf(wrapperGroup.SearchGroupMembers))
 	js.Global().Set("isJoinGroup", js.FuncOf(wrapperGroup.IsJoinGroup))
 	js.Global().Set("getUsersInGroup", js.FuncOf(wrapperGroup.GetUsersInGroup))
+	js.Global().Set("createGroupInvite", js.FuncOf(wrapperGroup.CreateGroupInvite))
+	js.Global().Set("getGroupInvites", js.FuncOf(wrapperGroup.GetGroupInvites))
+	js.Global().Set("revokeGroupInvite", js.FuncOf(wrapperGroup.RevokeGroupInvite))
+	js.Global().Set("getGroupInviteInfo", js.FuncOf(wrapperGroup.GetGroupInviteInfo))
+	js.Global().Set("joinGroupByInvite", js.FuncOf(wrapperGroup.JoinGroupByInvite))
 
 	wrapperUser := wasm_wrapper.NewWrapperUser(globalFuc)
 	js.Global().Set("getSelfUserInfo", js.FuncOf(wrapperUser.GetSelfUserInfo))
@@ -171,6 +191,11 @@ func registerFunc() {
 	js.Global().Set("addUserCommand", js.FuncOf(wrapperUser.AddUserCommand))
 	js.Global().Set("deleteUserCommand", js.FuncOf(wrapperUser.DeleteUserCommand))
 	js.Global().Set("getAllUserCommands", js.FuncOf(wrapperUser.GetAllUserCommands))
+	js.Global().Set("addFavorite", js.FuncOf(wrapperUser.AddFavorite))
+	js.Global().Set("deleteFavorites", js.FuncOf(wrapperUser.DeleteFavorites))
+	js.Global().Set("updateFavorite", js.FuncOf(wrapperUser.UpdateFavorite))
+	js.Global().Set("getFavorites", js.FuncOf(wrapperUser.GetFavorites))
+	js.Global().Set("searchFavorites", js.FuncOf(wrapperUser.SearchFavorites))
 
 	wrapperFriend := wasm_wrapper.NewWrapperFriend(globalFuc)
 	js.Global().Set("getSpecifiedFriendsInfo", js.FuncOf(wrapperFriend.GetSpecifiedFriendsInfo))
@@ -110,6 +110,10 @@ func (c ConversationCallback) OnConversationUserInputStatusChanged(change string
 	c.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(change).SendMessage()
 }
 
+func (c ConversationCallback) OnConversationFoldersChanged(folderList string) {
+	c.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(folderList).SendMessage()
+}
+
 type AdvancedMsgCallback struct {
 	CallbackWriter
 }
@@ -401,6 +405,12 @@ func (f *GroupCallback) OnGroupApplicationAccepted(groupApplication string) {
 func (f *GroupCallback) OnGroupApplicationRejected(groupApplication string) {
 	f.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(groupApplication).SendMessage()
 }
+func (f *GroupCallback) OnGroupAnnouncementRemind(announcementTips string) {
+	f.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(announcementTips).SendMessage()
+}
+func (f *GroupCallback) OnGroupAnnouncementConfirmed(confirmedTips string) {
+	f.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(confirmedTips).SendMessage()
+}
 func (f *GroupCallback) OnGroupDismissed(groupInfo string) {
 	f.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(groupInfo).SendMessage()
 }
@@ -432,6 +442,15 @@ func (u UserCallback) OnUserCommandDelete(userInfo string) {
 func (u UserCallback) OnUserCommandUpdate(userInfo string) {
 	u.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(userInfo).SendMessage()
 }
+func (u UserCallback) OnFavoriteAdded(favorite string) {
+	u.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(favorite).SendMessage()
+}
+func (u UserCallback) OnFavoriteDeleted(favorite string) {
+	u.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(favorite).SendMessage()
+}
+func (u UserCallback) OnFavoriteUpdated(favorite string) {
+	u.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(favorite).SendMessage()
+}
 
 type CustomBusinessCallback struct {
 	CallbackWriter
@@ -460,6 +460,22 @@ func (i *LocalChatLogs) SearchMessageByKeyword(ctx context.Context, contentType
 	}
 }
 
+// SearchMessageFullText searches the messages of a conversation with the inverted index kept by the JS side.
+func (i *LocalChatLogs) SearchMessageFullText(ctx context.Context, conversationID string, contentType []int, keywordList []string, keywordListMatchType int, startTime, endTime int64, offset, count int) (result []*model_struct.LocalChatLogSearchResult, err error) {
+	msgList, err := exec.Exec(conversationID, utils.StructToJsonString(contentType), utils.StructToJsonString(keywordList), keywordListMatchType, startTime, endTime, offset, count)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := msgList.(string)
+	if !ok {
+		return nil, exec.ErrType
+	}
+	if err := utils.JsonStringToStruct(v, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // GetSuperGroupAbnormalMsgSeq get super group abnormal msg seq
 func (i *LocalChatLogs) GetSuperGroupAbnormalMsgSeq(ctx context.Context, groupID string) (uint32, error) {
 	isExist, err := exec.Exec(groupID)
@@ -616,6 +632,28 @@ func (i *LocalChatLogs) GetMessagesByClientMsgIDs(ctx context.Context, conversat
 }
 
 // GetMessagesBySeqs gets messages by seqs
+func (i *LocalChatLogs) GetMessagesAfter(ctx context.Context, conversationID string, sendTime int64, clientMsgID string, count int) (result []*model_struct.LocalChatLog, err error) {
+	msgs, err := exec.Exec(conversationID, sendTime, clientMsgID, count)
+	if err != nil {
+		return nil, err
+	} else {
+		if v, ok := msgs.(string); ok {
+			var temp []model_struct.LocalChatLog
+			err := utils.JsonStringToStruct(v, &temp)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range temp {
+				v1 := v
+				result = append(result, &v1)
+			}
+			return result, err
+		} else {
+			return nil, exec.ErrType
+		}
+	}
+}
+
 func (i *LocalChatLogs) GetMessagesBySeqs(ctx context.Context, conversationID string, seqs []int64) (result []*model_struct.LocalChatLog, err error) {
 	msgs, err := exec.Exec(conversationID, utils.StructToJsonString(seqs))
 	if err != nil {
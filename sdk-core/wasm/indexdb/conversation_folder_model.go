@@ -0,0 +1,80 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+// +build js,wasm
+
+package indexdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/wasm/exec"
+)
+
+// LocalConversationFolders calls the JS functions with a DB suffix, the names without it are
+// the WASM API functions of the folders.
+type LocalConversationFolders struct{}
+
+func NewLocalConversationFolders() *LocalConversationFolders {
+	return &LocalConversationFolders{}
+}
+
+func (l *LocalConversationFolders) InsertConversationFolderDB(ctx context.Context, folder *model_struct.LocalConversationFolder) error {
+	_, err := exec.Exec(utils.StructToJsonString(folder))
+	return err
+}
+
+func (l *LocalConversationFolders) InsertConversationFolder(ctx context.Context, folder *model_struct.LocalConversationFolder) error {
+	return l.InsertConversationFolderDB(ctx, folder)
+}
+
+func (l *LocalConversationFolders) UpdateConversationFolderDB(ctx context.Context, folder *model_struct.LocalConversationFolder) error {
+	_, err := exec.Exec(utils.StructToJsonString(folder))
+	return err
+}
+
+func (l *LocalConversationFolders) UpdateConversationFolder(ctx context.Context, folder *model_struct.LocalConversationFolder) error {
+	return l.UpdateConversationFolderDB(ctx, folder)
+}
+
+func (l *LocalConversationFolders) DeleteConversationFolderDB(ctx context.Context, folderID string) error {
+	_, err := exec.Exec(folderID)
+	return err
+}
+
+func (l *LocalConversationFolders) DeleteConversationFolder(ctx context.Context, folderID string) error {
+	return l.DeleteConversationFolderDB(ctx, folderID)
+}
+
+func (l *LocalConversationFolders) GetConversationFoldersDB(ctx context.Context) ([]*model_struct.LocalConversationFolder, error) {
+	folders, err := exec.Exec()
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := folders.(string); ok {
+		var result []*model_struct.LocalConversationFolder
+		if err := utils.JsonStringToStruct(v, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	return nil, exec.ErrType
+}
+
+func (l *LocalConversationFolders) GetConversationFolders(ctx context.Context) ([]*model_struct.LocalConversationFolder, error) {
+	return l.GetConversationFoldersDB(ctx)
+}
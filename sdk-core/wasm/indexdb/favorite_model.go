@@ -0,0 +1,107 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+// +build js,wasm
+
+package indexdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/wasm/exec"
+)
+
+type LocalFavorites struct{}
+
+func NewLocalFavorites() *LocalFavorites {
+	return &LocalFavorites{}
+}
+
+// InsertFavoriteDB uses DB suffix to avoid name conflict with WASM API functions
+func (l *LocalFavorites) InsertFavoriteDB(ctx context.Context, favorite *model_struct.LocalFavorite) error {
+	_, err := exec.Exec(utils.StructToJsonString(favorite))
+	return err
+}
+
+// InsertFavorite wraps InsertFavoriteDB for interface compatibility
+func (l *LocalFavorites) InsertFavorite(ctx context.Context, favorite *model_struct.LocalFavorite) error {
+	return l.InsertFavoriteDB(ctx, favorite)
+}
+
+// UpdateFavoriteDB uses DB suffix to avoid name conflict with WASM API function updateFavorite
+func (l *LocalFavorites) UpdateFavoriteDB(ctx context.Context, favorite *model_struct.LocalFavorite) error {
+	_, err := exec.Exec(utils.StructToJsonString(favorite))
+	return err
+}
+
+// UpdateFavorite wraps UpdateFavoriteDB for interface compatibility
+func (l *LocalFavorites) UpdateFavorite(ctx context.Context, favorite *model_struct.LocalFavorite) error {
+	return l.UpdateFavoriteDB(ctx, favorite)
+}
+
+// DeleteFavoriteDB uses DB suffix to avoid name conflict with WASM API functions
+func (l *LocalFavorites) DeleteFavoriteDB(ctx context.Context, favoriteID string) error {
+	_, err := exec.Exec(favoriteID)
+	return err
+}
+
+// DeleteFavorite wraps DeleteFavoriteDB for interface compatibility
+func (l *LocalFavorites) DeleteFavorite(ctx context.Context, favoriteID string) error {
+	return l.DeleteFavoriteDB(ctx, favoriteID)
+}
+
+// GetAllFavoritesDB uses DB suffix to avoid name conflict with WASM API functions
+func (l *LocalFavorites) GetAllFavoritesDB(ctx context.Context) ([]*model_struct.LocalFavorite, error) {
+	favorites, err := exec.Exec()
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := favorites.(string); ok {
+		var result []*model_struct.LocalFavorite
+		if err := utils.JsonStringToStruct(v, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	return nil, exec.ErrType
+}
+
+// GetAllFavorites wraps GetAllFavoritesDB for interface compatibility
+func (l *LocalFavorites) GetAllFavorites(ctx context.Context) ([]*model_struct.LocalFavorite, error) {
+	return l.GetAllFavoritesDB(ctx)
+}
+
+// GetFavoriteListDB uses DB suffix to avoid name conflict with WASM API functions
+func (l *LocalFavorites) GetFavoriteListDB(ctx context.Context, offset, count int) ([]*model_struct.LocalFavorite, error) {
+	favorites, err := exec.Exec(offset, count)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := favorites.(string); ok {
+		var result []*model_struct.LocalFavorite
+		if err := utils.JsonStringToStruct(v, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	return nil, exec.ErrType
+}
+
+// GetFavoriteList wraps GetFavoriteListDB for interface compatibility
+func (l *LocalFavorites) GetFavoriteList(ctx context.Context, offset, count int) ([]*model_struct.LocalFavorite, error) {
+	return l.GetFavoriteListDB(ctx, offset, count)
+}
@@ -326,3 +326,48 @@ func (w *WrapperConMsg) GetInputStates(_ js.Value, args []js.Value) interface{}
 	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
 	return event_listener.NewCaller(open_im_sdk.GetInputStates, callback, &args).AsyncCallWithCallback()
 }
+
+func (w *WrapperConMsg) CreateConversationFolder(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.CreateConversationFolder, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) UpdateConversationFolder(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.UpdateConversationFolder, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) DeleteConversationFolder(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.DeleteConversationFolder, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetConversationFolders(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetConversationFolders, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) AddConversationsToFolder(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.AddConversationsToFolder, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) RemoveConversationsFromFolder(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.RemoveConversationsFromFolder, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetConversationListSplitByFolder(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetConversationListSplitByFolder, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetConversationFolderUnreadCounts(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetConversationFolderUnreadCounts, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) ForwardMessages(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.ForwardMessages, callback, &args).AsyncCallWithCallback()
+}
@@ -69,6 +69,36 @@ func (w *WrapperGroup) SetGroupMemberInfo(_ js.Value, args []js.Value) interface
 	return event_listener.NewCaller(open_im_sdk.SetGroupMemberInfo, callback, &args).AsyncCallWithCallback()
 }
 
+func (w *WrapperGroup) SetGroupAdminPermissions(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.SetGroupAdminPermissions, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperGroup) PublishGroupAnnouncement(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.PublishGroupAnnouncement, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperGroup) GetGroupAnnouncements(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetGroupAnnouncements, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperGroup) ConfirmGroupAnnouncement(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.ConfirmGroupAnnouncement, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperGroup) GetGroupAnnouncementConfirms(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetGroupAnnouncementConfirms, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperGroup) RemindGroupAnnouncement(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.RemindGroupAnnouncement, callback, &args).AsyncCallWithCallback()
+}
+
 func (w *WrapperGroup) GetJoinedGroupList(_ js.Value, args []js.Value) interface{} {
 	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
 	return event_listener.NewCaller(open_im_sdk.GetJoinedGroupList, callback, &args).AsyncCallWithCallback()
@@ -163,3 +193,28 @@ func (w *WrapperGroup) GetSpecifiedGroupsInfo(_ js.Value, args []js.Value) inter
 	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
 	return event_listener.NewCaller(open_im_sdk.GetSpecifiedGroupsInfo, callback, &args).AsyncCallWithCallback()
 }
+
+func (w *WrapperGroup) CreateGroupInvite(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.CreateGroupInvite, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperGroup) GetGroupInvites(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetGroupInvites, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperGroup) RevokeGroupInvite(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.RevokeGroupInvite, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperGroup) GetGroupInviteInfo(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetGroupInviteInfo, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperGroup) JoinGroupByInvite(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.JoinGroupByInvite, callback, &args).AsyncCallWithCallback()
+}
@@ -80,3 +80,28 @@ func (w *WrapperUser) GetAllUserCommands(_ js.Value, args []js.Value) interface{
 	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
 	return event_listener.NewCaller(open_im_sdk.GetAllUserCommands, callback, &args).AsyncCallWithCallback()
 }
+
+func (w *WrapperUser) AddFavorite(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.AddFavorite, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperUser) DeleteFavorites(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.DeleteFavorites, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperUser) UpdateFavorite(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.UpdateFavorite, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperUser) GetFavorites(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetFavorites, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperUser) SearchFavorites(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.SearchFavorites, callback, &args).AsyncCallWithCallback()
+}
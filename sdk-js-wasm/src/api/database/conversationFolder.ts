@@ -0,0 +1,108 @@
+import { DatabaseErrorCode } from '@/constant';
+import {
+  insertConversationFolder as databaseInsertConversationFolder,
+  updateConversationFolder as databaseUpdateConversationFolder,
+  deleteConversationFolder as databaseDeleteConversationFolder,
+  getConversationFolders as databaseGetConversationFolders,
+  LocalConversationFolder,
+} from '@/sqls';
+import {
+  converSqlExecResult,
+  convertObjectField,
+  convertToSnakeCaseObject,
+  formatResponse,
+} from '@/utils';
+import { getInstance } from './instance';
+
+function toLocalConversationFolder(
+  localFolderStr: string
+): LocalConversationFolder {
+  return convertToSnakeCaseObject(
+    convertObjectField(JSON.parse(localFolderStr))
+  ) as LocalConversationFolder;
+}
+
+// DB suffix avoids a name conflict with the WASM API functions on window.
+export async function insertConversationFolderDB(
+  localFolderStr: string
+): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    databaseInsertConversationFolder(
+      db,
+      toLocalConversationFolder(localFolderStr)
+    );
+
+    return formatResponse('');
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
+
+export async function updateConversationFolderDB(
+  localFolderStr: string
+): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    databaseUpdateConversationFolder(
+      db,
+      toLocalConversationFolder(localFolderStr)
+    );
+
+    return formatResponse('');
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
+
+export async function deleteConversationFolderDB(
+  folderID: string
+): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    databaseDeleteConversationFolder(db, folderID);
+
+    return formatResponse('');
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
+
+export async function getConversationFoldersDB(): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    const execResult = databaseGetConversationFolders(db);
+
+    return formatResponse(converSqlExecResult(execResult[0], 'CamelCase'));
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
@@ -0,0 +1,132 @@
+import { DatabaseErrorCode } from '@/constant';
+import {
+  insertFavorite as databaseInsertFavorite,
+  updateFavorite as databaseUpdateFavorite,
+  deleteFavorite as databaseDeleteFavorite,
+  getAllFavorites as databaseGetAllFavorites,
+  getFavoriteList as databaseGetFavoriteList,
+  LocalFavorite,
+} from '@/sqls';
+import {
+  converSqlExecResult,
+  convertObjectField,
+  convertToSnakeCaseObject,
+  formatResponse,
+} from '@/utils';
+import { QueryExecResult } from '@jlongster/sql.js';
+import { getInstance } from './instance';
+
+// tags is a string array on the Go side, kept as a JSON text column.
+function toLocalFavorite(localFavoriteStr: string): LocalFavorite {
+  const localFavorite = convertToSnakeCaseObject(
+    convertObjectField(JSON.parse(localFavoriteStr))
+  ) as LocalFavorite;
+  localFavorite.tags = JSON.stringify(localFavorite.tags ?? []);
+
+  return localFavorite;
+}
+
+function fromExecResult(execResult: QueryExecResult[]) {
+  return converSqlExecResult(execResult[0], 'CamelCase').map(v => ({
+    ...v,
+    tags: JSON.parse((v.tags as string) || '[]'),
+  }));
+}
+
+// DB suffix avoids a name conflict with the WASM API functions on window.
+export async function insertFavoriteDB(
+  localFavoriteStr: string
+): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    databaseInsertFavorite(db, toLocalFavorite(localFavoriteStr));
+
+    return formatResponse('');
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
+
+export async function updateFavoriteDB(
+  localFavoriteStr: string
+): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    databaseUpdateFavorite(db, toLocalFavorite(localFavoriteStr));
+
+    return formatResponse('');
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
+
+export async function deleteFavoriteDB(favoriteID: string): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    databaseDeleteFavorite(db, favoriteID);
+
+    return formatResponse('');
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
+
+export async function getAllFavoritesDB(): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    const execResult = databaseGetAllFavorites(db);
+
+    return formatResponse(fromExecResult(execResult));
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
+
+export async function getFavoriteListDB(
+  offset: number,
+  count: number
+): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    const execResult = databaseGetFavoriteList(db, offset, count);
+
+    return formatResponse(fromExecResult(execResult));
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
@@ -19,3 +19,5 @@ export * from './versionSync';
 export * from './notification';
 export * from './tableMaster';
 export * from './readCursor';
+export * from './favorite';
+export * from './conversationFolder';
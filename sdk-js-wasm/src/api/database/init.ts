@@ -20,11 +20,14 @@ import {
   localVersionSyncs,
   localReadCursor,
   localReadState,
+  localMsgSearchIndex,
+  localFavorites,
+  localConversationFolders,
 } from '@/sqls';
 import { formatResponse } from '@/utils';
 import { QueryExecResult } from '@jlongster/sql.js';
 import { getInstance, resetInstance } from './instance';
-import { alterTable } from './alter';
+import { migrate } from './migration';
 
 let sqlWasmPath: string;
 
@@ -66,7 +69,10 @@ export async function init(userId: string, dir: string): Promise<string> {
     const execResultLocalVersionSync = localVersionSyncs(db);
     const execResultLocalReadCursor = localReadCursor(db);
     const execResultLocalReadState = localReadState(db);
-    alterTable(db);
+    const execResultLocalMsgSearchIndex = localMsgSearchIndex(db);
+    const execResultLocalFavorites = localFavorites(db);
+    const execResultLocalConversationFolders = localConversationFolders(db);
+    migrate(db);
     results.push(
       ...[
         execResultLocalUploads,
@@ -89,6 +95,9 @@ export async function init(userId: string, dir: string): Promise<string> {
         execResultLocalVersionSync,
         execResultLocalReadCursor,
         execResultLocalReadState,
+        execResultLocalMsgSearchIndex,
+        execResultLocalFavorites,
+        execResultLocalConversationFolders,
       ]
     );
 
@@ -56,7 +56,11 @@ export async function setAppSDKVersion(
     const result = converSqlExecResult(execResult[0], 'CamelCase', [
       'installed',
     ]);
-    if (result[0] && result[0].version) {
+    if (result[0]) {
+      // the row may have been created by the migrations with an empty version
+      if (!localAppSDKVersion.version) {
+        delete localAppSDKVersion.version;
+      }
       databaseUpdateAppSDKVersion(
         db,
         result[0].version as string,
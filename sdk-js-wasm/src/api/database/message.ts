@@ -7,6 +7,7 @@ import {
   getMessageBySeq as databaseGetMessageBySeq,
   getMessagesByClientMsgIDs as databaseGetMessagesByClientMsgIDs,
   getMessagesBySeqs as databaseGetMessagesBySeqs,
+  getMessagesAfter as databaseGetMessagesAfter,
   getMessageListNoTime as databaseGetMessageListNoTime,
   getConversationNormalMsgSeq as databaseGetConversationNormalMsgSeq,
   getConversationPeerNormalMsgSeq as databaseGetConversationPeerNormalMsgSeq,
@@ -33,6 +34,10 @@ import {
   markConversationMessageAsReadBySeqs as databaseMarkConversationMessageAsReadBySeqs,
   markConversationMessageAsRead as databaseMarkConversationMessageAsRead,
   getLatestActiveMessage as databaseGetLatestActiveMessage,
+  ensureMessageSearchIndex,
+  flushMessageSearchQueue,
+  scoreMessageSearch,
+  getSearchedMessages,
 } from '@/sqls';
 import {
   converSqlExecResult,
@@ -265,6 +270,41 @@ export async function getMessagesByClientMsgIDs(
   }
 }
 
+export async function getMessagesAfter(
+  conversationID: string,
+  sendTime: number,
+  clientMsgID: string,
+  count: number
+): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    const execResult = databaseGetMessagesAfter(
+      db,
+      conversationID,
+      sendTime,
+      clientMsgID,
+      count
+    );
+
+    return formatResponse(
+      converSqlExecResult(execResult[0], 'CamelCase', [
+        'isRead',
+        'isReact',
+        'isExternalExtensions',
+      ])
+    );
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
+
 export async function getMessagesBySeqs(
   conversationID: string,
   seqListStr: string
@@ -633,6 +673,64 @@ export async function searchMessageByKeyword(
   }
 }
 
+export async function searchMessageFullText(
+  conversationID: string,
+  contentTypeStr: string,
+  keywordListStr: string,
+  keywordListMatchType: number,
+  startTime: number,
+  endTime: number,
+  offset: number,
+  count: number
+): Promise<string> {
+  try {
+    const db = await getInstance();
+
+    if (!ensureMessageSearchIndex(db, conversationID)) {
+      return formatResponse([]);
+    }
+    flushMessageSearchQueue(db);
+    const scores = scoreMessageSearch(
+      db,
+      conversationID,
+      JSON.parse(keywordListStr),
+      keywordListMatchType
+    );
+    const execResult = getSearchedMessages(
+      db,
+      conversationID,
+      [...scores.keys()],
+      JSON.parse(contentTypeStr),
+      startTime,
+      endTime ? endTime : new Date().getTime()
+    );
+    const messages = converSqlExecResult(execResult[0], 'CamelCase', [
+      'isRead',
+      'isReact',
+      'isExternalExtensions',
+    ]).map(message => ({
+      ...message,
+      score: scores.get(message.clientMsgID as string) ?? 0,
+    }));
+    messages.sort(
+      (a, b) =>
+        b.score - a.score || (b.sendTime as number) - (a.sendTime as number)
+    );
+
+    return formatResponse(
+      count > 0 ? messages.slice(offset, offset + count) : messages
+    );
+  } catch (e) {
+    console.error(e);
+
+    return formatResponse(
+      undefined,
+      DatabaseErrorCode.ErrorInit,
+      JSON.stringify(e)
+    );
+  }
+}
+
 export async function searchMessageByContentType(
   conversationID: string,
   contentTypeStr: string,
@@ -0,0 +1,100 @@
+import { Database } from '@jlongster/sql.js';
+
+// Numbered schema migrations, applied in order after the tables are created.
+// Each step runs in a transaction together with the bump of
+// local_app_sdk_version.schema_version. Steps also run on freshly created
+// tables, so they must be idempotent. Append new steps at the end and never
+// renumber or edit shipped ones.
+type Migration = {
+  version: number;
+  name: string;
+  up: (db: Database) => void;
+};
+
+const migrations: Migration[] = [
+  {
+    version: 1,
+    name: 'add columns of 3.5.1 - 3.8.1',
+    up: db => {
+      addColumn(db, 'local_friends', 'is_pinned', 'numeric');
+      addColumn(db, 'local_groups', 'display_is_read', 'numeric');
+      addColumn(db, 'local_app_sdk_version', 'installed', 'numeric');
+    },
+  },
+  {
+    version: 2,
+    name: 'backfill columns added by alter table',
+    up: db => {
+      db.exec(
+        `
+          UPDATE local_friends SET is_pinned = 0 WHERE is_pinned IS NULL;
+          UPDATE local_groups SET display_is_read = 0 WHERE display_is_read IS NULL;
+          UPDATE local_app_sdk_version SET installed = 1 WHERE installed IS NULL;
+        `
+      );
+    },
+  },
+  {
+    version: 3,
+    name: 'add conversation folders',
+    up: db => {
+      addColumn(db, 'local_conversations', 'folder_ids', 'text');
+    },
+  },
+];
+
+function hasColumn(db: Database, table: string, column: string): boolean {
+  const result = db.exec(`PRAGMA table_info('${table}')`);
+  if (result.length === 0) {
+    return false;
+  }
+  const nameIndex = result[0].columns.indexOf('name');
+  return result[0].values.some(row => row[nameIndex] === column);
+}
+
+function addColumn(db: Database, table: string, column: string, type: string) {
+  if (!hasColumn(db, table, column)) {
+    db.exec(`ALTER TABLE ${table} ADD COLUMN ${column} ${type}`);
+  }
+}
+
+function getSchemaVersion(db: Database): number {
+  addColumn(db, 'local_app_sdk_version', 'installed', 'numeric');
+  addColumn(db, 'local_app_sdk_version', 'schema_version', 'integer');
+  const result = db.exec(
+    'SELECT schema_version FROM local_app_sdk_version LIMIT 1'
+  );
+  if (result.length === 0 || result[0].values.length === 0) {
+    // a fresh database has not synced yet, installed must not be left NULL
+    // for the backfill of step 2 to mark it as synced
+    db.exec(
+      `INSERT INTO local_app_sdk_version (version, installed, schema_version) VALUES ('', 0, 0)`
+    );
+    return 0;
+  }
+  return Number(result[0].values[0][0] ?? 0);
+}
+
+// migrate applies the pending migrations. A failed step is rolled back and
+// rethrown, so init fails and the database stays at the last good version.
+export function migrate(db: Database) {
+  const schemaVersion = getSchemaVersion(db);
+  for (const m of migrations) {
+    if (m.version <= schemaVersion) {
+      continue;
+    }
+    db.exec('BEGIN');
+    try {
+      m.up(db);
+      db.exec(
+        `UPDATE local_app_sdk_version SET schema_version = ${m.version}`
+      );
+      db.exec('COMMIT');
+    } catch (error) {
+      db.exec('ROLLBACK');
+      throw new Error(
+        `migration ${m.version} (${m.name}) failed: ${String(error)}`
+      );
+    }
+  }
+}
@@ -201,6 +201,9 @@ export function initDatabaseAPI(isLogStandardOutput = true): void {
   window.searchMessageByContentTypeAndKeyword = registeMethodOnWindow(
     'searchMessageByContentTypeAndKeyword'
   );
+  window.searchMessageFullText = registeMethodOnWindow(
+    'searchMessageFullText'
+  );
   window.updateMsgSenderNickname = registeMethodOnWindow(
     'updateMsgSenderNickname'
   );
@@ -246,6 +249,7 @@ export function initDatabaseAPI(isLogStandardOutput = true): void {
     'getMessagesByClientMsgIDs'
   );
   window.getMessagesBySeqs = registeMethodOnWindow('getMessagesBySeqs');
+  window.getMessagesAfter = registeMethodOnWindow('getMessagesAfter');
   window.getConversationNormalMsgSeq = registeMethodOnWindow(
     'getConversationNormalMsgSeq'
   );
@@ -655,6 +659,26 @@ export function initDatabaseAPI(isLogStandardOutput = true): void {
     'deleteReadStateDB',
     'deleteReadStateDB'
   );
+  // favorites
+  window.insertFavoriteDB = registeMethodOnWindow('insertFavoriteDB');
+  window.updateFavoriteDB = registeMethodOnWindow('updateFavoriteDB');
+  window.deleteFavoriteDB = registeMethodOnWindow('deleteFavoriteDB');
+  window.getAllFavoritesDB = registeMethodOnWindow('getAllFavoritesDB');
+  window.getFavoriteListDB = registeMethodOnWindow('getFavoriteListDB');
+
+  // conversation folders
+  window.insertConversationFolderDB = registeMethodOnWindow(
+    'insertConversationFolderDB'
+  );
+  window.updateConversationFolderDB = registeMethodOnWindow(
+    'updateConversationFolderDB'
+  );
+  window.deleteConversationFolderDB = registeMethodOnWindow(
+    'deleteConversationFolderDB'
+  );
+  window.getConversationFoldersDB = registeMethodOnWindow(
+    'getConversationFoldersDB'
+  );
 
   // temp cache chat logs
   window.batchInsertTempCacheMessageList = registeMethodOnWindow(
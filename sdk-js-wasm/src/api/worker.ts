@@ -18,6 +18,7 @@ import {
   searchMessageByKeyword,
   searchMessageByContentType,
   searchMessageByContentTypeAndKeyword,
+  searchMessageFullText,
   updateMsgSenderFaceURLAndSenderNickname,
   insertSendingMessage,
   deleteSendingMessage,
@@ -171,6 +172,7 @@ import {
   deleteConversationAllMessages,
   getMessagesByClientMsgIDs,
   getMessagesBySeqs,
+  getMessagesAfter,
   getMessageBySeq,
   getAllConversations,
 
@@ -208,6 +210,17 @@ import {
   upsertReadStateDB,
   updateReadStateAllReadSeqDB,
   deleteReadStateDB,
+  // favorites
+  insertFavoriteDB,
+  updateFavoriteDB,
+  deleteFavoriteDB,
+  getAllFavoritesDB,
+  getFavoriteListDB,
+  // conversation folders
+  insertConversationFolderDB,
+  updateConversationFolderDB,
+  deleteConversationFolderDB,
+  getConversationFoldersDB,
 } from '../api/database';
 
 import { getInstance } from './database/instance';
@@ -260,6 +273,7 @@ rpc.registerMethod(
   'searchMessageByContentTypeAndKeyword',
   searchMessageByContentTypeAndKeyword
 );
+rpc.registerMethod('searchMessageFullText', searchMessageFullText);
 rpc.registerMethod(
   'updateMsgSenderFaceURLAndSenderNickname',
   updateMsgSenderFaceURLAndSenderNickname
@@ -269,6 +283,7 @@ rpc.registerMethod('getLatestValidServerMessage', getLatestValidServerMessage);
 rpc.registerMethod('getMessageBySeq', getMessageBySeq);
 rpc.registerMethod('getMessagesByClientMsgIDs', getMessagesByClientMsgIDs);
 rpc.registerMethod('getMessagesBySeqs', getMessagesBySeqs);
+rpc.registerMethod('getMessagesAfter', getMessagesAfter);
 rpc.registerMethod('getConversationNormalMsgSeq', getConversationNormalMsgSeq);
 rpc.registerMethod(
   'getConversationPeerNormalMsgSeq',
@@ -515,6 +530,17 @@ rpc.registerMethod('getReadStateDB', getReadStateDB);
 rpc.registerMethod('upsertReadStateDB', upsertReadStateDB);
 rpc.registerMethod('updateReadStateAllReadSeqDB', updateReadStateAllReadSeqDB);
 rpc.registerMethod('deleteReadStateDB', deleteReadStateDB);
+// favorites
+rpc.registerMethod('insertFavoriteDB', insertFavoriteDB);
+rpc.registerMethod('updateFavoriteDB', updateFavoriteDB);
+rpc.registerMethod('deleteFavoriteDB', deleteFavoriteDB);
+rpc.registerMethod('getAllFavoritesDB', getAllFavoritesDB);
+rpc.registerMethod('getFavoriteListDB', getFavoriteListDB);
+// conversation folders
+rpc.registerMethod('insertConversationFolderDB', insertConversationFolderDB);
+rpc.registerMethod('updateConversationFolderDB', updateConversationFolderDB);
+rpc.registerMethod('deleteConversationFolderDB', deleteConversationFolderDB);
+rpc.registerMethod('getConversationFoldersDB', getConversationFoldersDB);
 
 rpc.registerMethod('exec', async (sql: string) => {
   const db = await getInstance();
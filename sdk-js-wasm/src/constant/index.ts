@@ -28,6 +28,7 @@ export enum CbEvents {
   OnConversationChanged = 'OnConversationChanged',
   OnNewConversation = 'OnNewConversation',
   OnConversationUserInputStatusChanged = 'OnConversationUserInputStatusChanged',
+  OnConversationFoldersChanged = 'OnConversationFoldersChanged',
   OnSyncServerFailed = 'OnSyncServerFailed',
   OnSyncServerFinish = 'OnSyncServerFinish',
   OnSyncServerProgress = 'OnSyncServerProgress',
@@ -53,10 +54,15 @@ export enum CbEvents {
   OnGroupMemberInfoChanged = 'OnGroupMemberInfoChanged',
   OnGroupApplicationAccepted = 'OnGroupApplicationAccepted',
   OnGroupApplicationRejected = 'OnGroupApplicationRejected',
+  OnGroupAnnouncementRemind = 'OnGroupAnnouncementRemind',
+  OnGroupAnnouncementConfirmed = 'OnGroupAnnouncementConfirmed',
 
   UploadComplete = 'UploadComplete',
   OnRecvCustomBusinessMessage = 'OnRecvCustomBusinessMessage',
   OnUserStatusChanged = 'OnUserStatusChanged',
+  OnFavoriteAdded = 'OnFavoriteAdded',
+  OnFavoriteDeleted = 'OnFavoriteDeleted',
+  OnFavoriteUpdated = 'OnFavoriteUpdated',
   OnUploadLogsProgress = 'OnUploadLogsProgress',
 
   // rtc
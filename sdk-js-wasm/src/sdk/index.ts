@@ -33,10 +33,26 @@ import {
   AccessToGroupParams,
   SetConversationRecvOptParams,
   JoinGroupParams,
+  SetGroupAdminPermissionsParams,
+  PublishGroupAnnouncementParams,
+  GetGroupAnnouncementsParams,
+  GroupAnnouncementParams,
+  CreateGroupInviteParams,
+  RevokeGroupInviteParams,
+  JoinGroupByInviteParams,
   LocationMsgParams,
   UpdateMemberInfoParams,
   MergerMsgParams,
   PartialUserItem,
+  AddFavoriteParams,
+  UpdateFavoriteParams,
+  GetFavoritesParams,
+  SearchFavoritesParams,
+  CreateConversationFolderParams,
+  UpdateConversationFolderParams,
+  ConversationFolderMembersParams,
+  SplitConversationByFolderParams,
+  ForwardMessagesParams,
   SetConversationPinParams,
   QuoteMsgParams,
   RemarkFriendParams,
@@ -89,8 +105,19 @@ import {
   FriendUserItem,
   GroupApplicationItem,
   GroupItem,
+  GroupInviteItem,
+  GroupInviteInfo,
+  JoinGroupByInviteResult,
+  GroupAnnouncementItem,
+  GroupAnnouncementList,
+  GroupAnnouncementConfirms,
   GroupMemberItem,
   GroupMessageReceipt,
+  FavoriteItem,
+  FavoriteSearchResult,
+  ConversationFolderItem,
+  ConversationFolderUnreadCount,
+  ForwardMessageResult,
   IMConfig,
   MessageItem,
   OfflinePush,
@@ -106,6 +133,7 @@ import {
 } from '../types/entity';
 import {
   GroupAtType,
+  GroupJoinSource,
   LoginStatus,
   MessageReceiveOptType,
   Platform,
@@ -689,6 +717,54 @@ class SDK extends Emitter {
     ]);
   };
 
+  addFavorite = (data: AddFavoriteParams, operationID = uuidv4()) => {
+    return this._invoker<FavoriteItem>('addFavorite', window.addFavorite, [
+      operationID,
+      data.conversationID,
+      data.seq,
+      JSON.stringify(data.tags ?? []),
+      data.note ?? '',
+    ]);
+  };
+
+  deleteFavorites = <T>(favoriteIDs: string[], operationID = uuidv4()) => {
+    return this._invoker<T>('deleteFavorites', window.deleteFavorites, [
+      operationID,
+      JSON.stringify(favoriteIDs),
+    ]);
+  };
+
+  updateFavorite = <T>(data: UpdateFavoriteParams, operationID = uuidv4()) => {
+    return this._invoker<T>('updateFavorite', window.updateFavorite, [
+      operationID,
+      data.favoriteID,
+      JSON.stringify(data.tags),
+      data.note,
+    ]);
+  };
+
+  getFavorites = (data: GetFavoritesParams, operationID = uuidv4()) => {
+    return this._invoker<FavoriteItem[]>('getFavorites', window.getFavorites, [
+      operationID,
+      data.offset,
+      data.count,
+    ]);
+  };
+
+  searchFavorites = (data: SearchFavoritesParams, operationID = uuidv4()) => {
+    return this._invoker<FavoriteSearchResult>(
+      'searchFavorites',
+      window.searchFavorites,
+      [
+        operationID,
+        data.keyword,
+        JSON.stringify(data.tags ?? []),
+        data.pageNumber,
+        data.showNumber,
+      ]
+    );
+  };
+
   createTextAtMessage = (data: AtMsgParams, operationID = uuidv4()) => {
     return this._invoker<MessageItem>(
       'createTextAtMessage',
@@ -1077,6 +1153,100 @@ class SDK extends Emitter {
     );
   };
 
+  createConversationFolder = (
+    data: CreateConversationFolderParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<ConversationFolderItem>(
+      'createConversationFolder',
+      window.createConversationFolder,
+      [operationID, JSON.stringify(data)]
+    );
+  };
+
+  updateConversationFolder = <T>(
+    data: UpdateConversationFolderParams,
+    operationID = uuidv4()
+  ) => {
+    // name and sort are optional wrapper values on the Go side
+    const req = {
+      folderID: data.folderID,
+      name: data.name === undefined ? undefined : { value: data.name },
+      sort: data.sort === undefined ? undefined : { value: data.sort },
+      rule: data.rule,
+    };
+    return this._invoker<T>(
+      'updateConversationFolder',
+      window.updateConversationFolder,
+      [operationID, JSON.stringify(req)]
+    );
+  };
+
+  deleteConversationFolder = <T>(folderID: string, operationID = uuidv4()) => {
+    return this._invoker<T>(
+      'deleteConversationFolder',
+      window.deleteConversationFolder,
+      [operationID, folderID]
+    );
+  };
+
+  getConversationFolders = (operationID = uuidv4()) => {
+    return this._invoker<ConversationFolderItem[]>(
+      'getConversationFolders',
+      window.getConversationFolders,
+      [operationID]
+    );
+  };
+
+  addConversationsToFolder = <T>(
+    data: ConversationFolderMembersParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<T>(
+      'addConversationsToFolder',
+      window.addConversationsToFolder,
+      [operationID, data.folderID, JSON.stringify(data.conversationIDList)]
+    );
+  };
+
+  removeConversationsFromFolder = <T>(
+    data: ConversationFolderMembersParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<T>(
+      'removeConversationsFromFolder',
+      window.removeConversationsFromFolder,
+      [operationID, data.folderID, JSON.stringify(data.conversationIDList)]
+    );
+  };
+
+  getConversationListSplitByFolder = (
+    data: SplitConversationByFolderParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<ConversationItem[]>(
+      'getConversationListSplitByFolder',
+      window.getConversationListSplitByFolder,
+      [operationID, data.folderID, data.offset, data.count]
+    );
+  };
+
+  getConversationFolderUnreadCounts = (operationID = uuidv4()) => {
+    return this._invoker<ConversationFolderUnreadCount[]>(
+      'getConversationFolderUnreadCounts',
+      window.getConversationFolderUnreadCounts,
+      [operationID]
+    );
+  };
+
+  forwardMessages = (data: ForwardMessagesParams, operationID = uuidv4()) => {
+    return this._invoker<ForwardMessageResult[]>(
+      'forwardMessages',
+      window.forwardMessages,
+      [operationID, JSON.stringify(data)]
+    );
+  };
+
   getConversationRecvMessageOpt = (data: string[], operationID = uuidv4()) => {
     return this._invoker<ConversationItem[]>(
       'getConversationRecvMessageOpt ',
@@ -1422,6 +1592,72 @@ class SDK extends Emitter {
       JSON.stringify(data),
     ]);
   };
+  setGroupAdminPermissions = <T>(
+    data: SetGroupAdminPermissionsParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<T>(
+      'setGroupAdminPermissions ',
+      window.setGroupAdminPermissions,
+      [operationID, data.groupID, data.userID, data.permissions]
+    );
+  };
+  publishGroupAnnouncement = (
+    data: PublishGroupAnnouncementParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<GroupAnnouncementItem>(
+      'publishGroupAnnouncement ',
+      window.publishGroupAnnouncement,
+      [
+        operationID,
+        data.groupID,
+        data.content,
+        data.requireConfirm,
+        data.ex ?? '',
+      ]
+    );
+  };
+  getGroupAnnouncements = (
+    data: GetGroupAnnouncementsParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<GroupAnnouncementList>(
+      'getGroupAnnouncements ',
+      window.getGroupAnnouncements,
+      [operationID, data.groupID, data.pageNumber, data.showNumber]
+    );
+  };
+  confirmGroupAnnouncement = <T>(
+    data: GroupAnnouncementParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<T>(
+      'confirmGroupAnnouncement ',
+      window.confirmGroupAnnouncement,
+      [operationID, data.groupID, data.announcementID]
+    );
+  };
+  getGroupAnnouncementConfirms = (
+    data: GroupAnnouncementParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<GroupAnnouncementConfirms>(
+      'getGroupAnnouncementConfirms ',
+      window.getGroupAnnouncementConfirms,
+      [operationID, data.groupID, data.announcementID]
+    );
+  };
+  remindGroupAnnouncement = (
+    data: GroupAnnouncementParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<string[]>(
+      'remindGroupAnnouncement ',
+      window.remindGroupAnnouncement,
+      [operationID, data.groupID, data.announcementID]
+    );
+  };
   joinGroup = <T>(data: JoinGroupParams, operationID = uuidv4()) => {
     return this._invoker<T>('joinGroup ', window.joinGroup, [
       operationID,
@@ -1431,6 +1667,63 @@ class SDK extends Emitter {
       data.ex ?? '',
     ]);
   };
+  createGroupInvite = (
+    data: CreateGroupInviteParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<GroupInviteItem>(
+      'createGroupInvite ',
+      window.createGroupInvite,
+      [
+        operationID,
+        data.groupID,
+        data.expireTime ?? 0,
+        data.maxUses ?? 0,
+        data.needApproval ?? false,
+        data.ex ?? '',
+      ]
+    );
+  };
+  getGroupInvites = (groupID: string, operationID = uuidv4()) => {
+    return this._invoker<GroupInviteItem[]>(
+      'getGroupInvites ',
+      window.getGroupInvites,
+      [operationID, groupID]
+    );
+  };
+  revokeGroupInvite = <T>(
+    data: RevokeGroupInviteParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<T>('revokeGroupInvite ', window.revokeGroupInvite, [
+      operationID,
+      data.groupID,
+      data.token,
+    ]);
+  };
+  getGroupInviteInfo = (token: string, operationID = uuidv4()) => {
+    return this._invoker<GroupInviteInfo>(
+      'getGroupInviteInfo ',
+      window.getGroupInviteInfo,
+      [operationID, token]
+    );
+  };
+  joinGroupByInvite = (
+    data: JoinGroupByInviteParams,
+    operationID = uuidv4()
+  ) => {
+    return this._invoker<JoinGroupByInviteResult>(
+      'joinGroupByInvite ',
+      window.joinGroupByInvite,
+      [
+        operationID,
+        data.token,
+        data.reqMsg,
+        data.joinSource ?? GroupJoinSource.InviteLink,
+        data.ex ?? '',
+      ]
+    );
+  };
   searchGroups = (data: SearchGroupParams, operationID = uuidv4()) => {
     return this._invoker<GroupItem[]>('searchGroups ', window.searchGroups, [
       operationID,
@@ -1,4 +1,5 @@
 export * from './localChatLogsConversationID';
+export * from './localMsgSearchIndex';
 export * from './localConversations';
 export * from './localUsers';
 export * from './localSuperGroups';
@@ -20,3 +21,5 @@ export * from './localVersionSync';
 export * from './localTableMaster';
 export * from './localReadCursor';
 export * from './localReadState';
+export * from './localFavorites';
+export * from './localConversationFolders';
@@ -9,6 +9,7 @@ export function localAppSDKVersions(db: Database): QueryExecResult[] {
       create table if not exists 'local_app_sdk_version' (
         'version'         varchar(255),
         'installed'       numeric,
+        'schema_version'  integer,
         primary key  ('version')
     ) 
       `
@@ -1,5 +1,6 @@
 import squel from 'squel';
 import { Database, QueryExecResult } from '@jlongster/sql.js';
+import { ensureMessageSearchIndex } from './localMsgSearchIndex';
 
 export type ClientMessage = { [key: string]: any };
 
@@ -11,7 +12,7 @@ export function localChatLogsConversationID(
   db: Database,
   conversationID: string
 ): QueryExecResult[] {
-  return db.exec(
+  const result = db.exec(
     `
     create table if not exists 'chat_logs_${conversationID}' (
         'client_msg_id' char(32),
@@ -40,6 +41,8 @@ export function localChatLogsConversationID(
       );
       `
   );
+  ensureMessageSearchIndex(db, conversationID);
+  return result;
 }
 
 export function getMessage(
@@ -105,6 +108,25 @@ export function getMessageList(
   );
 }
 
+export function getMessagesAfter(
+  db: Database,
+  conversationID: string,
+  sendTime: number,
+  clientMsgID: string,
+  count: number
+): QueryExecResult[] {
+  _initLocalChatLogsTable(db, conversationID);
+  return db.exec(
+    `
+    SELECT * FROM 'chat_logs_${conversationID}'
+    WHERE send_time > ? OR (send_time = ? AND client_msg_id > ?)
+    ORDER BY send_time ASC, client_msg_id ASC
+    LIMIT ?
+    `,
+    [sendTime, sendTime, clientMsgID, count]
+  );
+}
+
 export function getMessageBySeq(
   db: Database,
   conversationID: string,
@@ -284,6 +306,24 @@ export function getMultipleMessage(
   );
 }
 
+// keywordCondition matches the content against the keywords with bound LIKE
+// patterns, so keywords cannot inject SQL.
+function keywordCondition(keywordList: string[], keywordListMatchType: number) {
+  if (keywordList.length === 0) {
+    return { condition: '', params: [] as string[] };
+  }
+  const connectStr = keywordListMatchType === 0 ? ' or ' : ' and ';
+  return {
+    condition:
+      'And (' +
+      keywordList.map(() => "content like ? escape '\\'").join(connectStr) +
+      ')',
+    params: keywordList.map(
+      keyword => '%' + keyword.replace(/[\\%_]/g, m => '\\' + m) + '%'
+    ),
+  };
+}
+
 export function searchMessageByKeyword(
   db: Database,
   conversationID: string,
@@ -296,29 +336,18 @@ export function searchMessageByKeyword(
   count: number
 ): QueryExecResult[] {
   const finalEndTime = endTime ? endTime : new Date().getTime();
-  let subCondition = '';
   const values = contentType.map(v => `${v}`).join(',');
-  const connectStr = keywordListMatchType === 0 ? 'or ' : 'and ';
-  keywordList.forEach((keyword, index) => {
-    if (index == 0) {
-      subCondition += 'And (';
-    }
-    if (index + 1 >= keywordList.length) {
-      subCondition += 'content like ' + "'%" + keyword + "%') ";
-    } else {
-      subCondition +=
-        'content like ' + "'%" + keyword + "%' " + connectStr;
-    }
-  });
+  const keyword = keywordCondition(keywordList, keywordListMatchType);
   return db.exec(
     `
     SELECT * FROM 'chat_logs_${conversationID}' 
           WHERE send_time  between ${startTime} and ${finalEndTime} 
           AND status <=3  
           And content_type IN (${values}) 
-          ${subCondition}
+          ${keyword.condition}
     ORDER BY send_time DESC LIMIT ${count} OFFSET ${offset};
-    `
+    `,
+    keyword.params
   );
 }
 
@@ -355,28 +384,17 @@ export function searchMessageByContentTypeAndKeyword(
 ): QueryExecResult[] {
   const values = contentType.map(v => `${v}`).join(',');
   const finalEndTime = endTime ? endTime : new Date().getTime();
-  let subCondition = '';
-  const connectStr = keywordListMatchType === 0 ? 'or ' : 'and ';
-  keywordList.forEach((keyword, index) => {
-    if (index == 0) {
-      subCondition += 'And (';
-    }
-    if (index + 1 >= keywordList.length) {
-      subCondition += 'content like ' + "'%" + keyword + "%') ";
-    } else {
-      subCondition +=
-        'content like ' + "'%" + keyword + "%' " + connectStr;
-    }
-  });
+  const keyword = keywordCondition(keywordList, keywordListMatchType);
   return db.exec(
     `
       SELECT * FROM 'chat_logs_${conversationID}' 
             WHERE send_time between ${startTime} and ${finalEndTime} 
             AND status <=3 
             And content_type IN (${values}) 
-            ${subCondition}
-      ORDER BY send_time DESC;
-      `
+            ${keyword.condition}
+      ORDER BY send_time DESC
+      `,
+    keyword.params
   );
 }
 
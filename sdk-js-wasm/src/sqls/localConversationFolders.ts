@@ -0,0 +1,68 @@
+import squel from 'squel';
+import { Database, QueryExecResult } from '@jlongster/sql.js';
+
+export type LocalConversationFolder = { [key: string]: any };
+
+export function localConversationFolders(db: Database): QueryExecResult[] {
+  return db.exec(
+    `
+      create table if not exists 'local_conversation_folders' (
+        'folder_id'         varchar(64),
+        'name'              varchar(255),
+        'sort'              integer,
+        'rule'              text,
+        'create_time'       integer,
+        'update_time'       integer,
+        primary key ('folder_id')
+      );
+    `
+  );
+}
+
+export function insertConversationFolder(
+  db: Database,
+  localFolder: LocalConversationFolder
+): QueryExecResult[] {
+  const sql = squel
+    .insert()
+    .into('local_conversation_folders')
+    .setFields(localFolder)
+    .toString();
+
+  return db.exec(sql);
+}
+
+export function updateConversationFolder(
+  db: Database,
+  localFolder: LocalConversationFolder
+): QueryExecResult[] {
+  const sql = squel
+    .update()
+    .table('local_conversation_folders')
+    .setFields(localFolder)
+    .where(`folder_id = '${localFolder.folder_id}'`)
+    .toString();
+
+  return db.exec(sql);
+}
+
+export function deleteConversationFolder(
+  db: Database,
+  folderID: string
+): QueryExecResult[] {
+  return db.exec(
+    `
+      delete from local_conversation_folders
+      where folder_id = '${folderID}'
+    `
+  );
+}
+
+export function getConversationFolders(db: Database): QueryExecResult[] {
+  return db.exec(
+    `
+      select * from local_conversation_folders
+      order by sort asc, create_time asc
+    `
+  );
+}
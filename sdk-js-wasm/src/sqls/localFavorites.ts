@@ -0,0 +1,85 @@
+import squel from 'squel';
+import { Database, QueryExecResult } from '@jlongster/sql.js';
+
+export type LocalFavorite = { [key: string]: any };
+
+export function localFavorites(db: Database): QueryExecResult[] {
+  return db.exec(
+    `
+      create table if not exists 'local_favorites' (
+        'favorite_id'       varchar(64),
+        'conversation_id'   char(128),
+        'seq'               integer,
+        'msg'               text,
+        'attachments'       text,
+        'tags'              text,
+        'note'              text,
+        'create_time'       integer,
+        'update_time'       integer,
+        primary key ('favorite_id')
+      );
+      create index if not exists 'index_favorite_create_time' on 'local_favorites' ('create_time');
+    `
+  );
+}
+
+export function insertFavorite(
+  db: Database,
+  localFavorite: LocalFavorite
+): QueryExecResult[] {
+  const sql = squel
+    .insert()
+    .into('local_favorites')
+    .setFields(localFavorite)
+    .toString();
+
+  return db.exec(sql);
+}
+
+export function updateFavorite(
+  db: Database,
+  localFavorite: LocalFavorite
+): QueryExecResult[] {
+  const sql = squel
+    .update()
+    .table('local_favorites')
+    .setFields(localFavorite)
+    .where(`favorite_id = '${localFavorite.favorite_id}'`)
+    .toString();
+
+  return db.exec(sql);
+}
+
+export function deleteFavorite(
+  db: Database,
+  favoriteID: string
+): QueryExecResult[] {
+  return db.exec(
+    `
+      delete from local_favorites
+      where favorite_id = '${favoriteID}'
+    `
+  );
+}
+
+export function getAllFavorites(db: Database): QueryExecResult[] {
+  return db.exec(
+    `
+      select * from local_favorites
+    `
+  );
+}
+
+export function getFavoriteList(
+  db: Database,
+  offset: number,
+  count: number
+): QueryExecResult[] {
+  return db.exec(
+    `
+      select * from local_favorites
+      order by create_time desc
+      limit ${count} offset ${offset}
+    `
+  );
+}
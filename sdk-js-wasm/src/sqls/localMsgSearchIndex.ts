@@ -0,0 +1,304 @@
+import { Database, QueryExecResult, SqlValue } from '@jlongster/sql.js';
+import { escapeString, matchPhrase, searchText, tokenize } from '@/utils';
+
+// An inverted index of the chat logs for full-text search, as FTS5 is not
+// compiled into sql.js. Triggers on each chat log table queue the changed
+// messages, and the queue is indexed before every search.
+
+const BM25_K1 = 1.2;
+const BM25_B = 0.75;
+// below the limit of bound parameters of sqlite
+const CHUNK_SIZE = 500;
+
+export function localMsgSearchIndex(db: Database): QueryExecResult[] {
+  return db.exec(
+    `
+      create table if not exists 'local_msg_search_queue' (
+        'conversation_id' varchar(128),
+        'client_msg_id'   char(64),
+        primary key ('conversation_id', 'client_msg_id')
+      );
+      create table if not exists 'local_msg_search_docs' (
+        'conversation_id' varchar(128),
+        'client_msg_id'   char(64),
+        'text'            varchar(1000),
+        'length'          int,
+        primary key ('conversation_id', 'client_msg_id')
+      );
+      create table if not exists 'local_msg_search_tokens' (
+        'conversation_id' varchar(128),
+        'token'           varchar(255),
+        'client_msg_id'   char(64),
+        'tf'              int,
+        primary key ('conversation_id', 'token', 'client_msg_id')
+      );
+      create index if not exists 'index_msg_search_tokens_msg'
+        on 'local_msg_search_tokens' ('conversation_id', 'client_msg_id');
+    `
+  );
+}
+
+function chunks<T>(list: T[]): T[][] {
+  const result: T[][] = [];
+  for (let i = 0; i < list.length; i += CHUNK_SIZE) {
+    result.push(list.slice(i, i + CHUNK_SIZE));
+  }
+  return result;
+}
+
+function placeholders(list: unknown[]): string {
+  return list.map(() => '?').join(',');
+}
+
+const indexedConversations = new WeakMap<Database, Set<string>>();
+
+// ensureMessageSearchIndex adds the queue triggers to the chat log table of the
+// conversation. A table from before the index has all its messages queued.
+// It returns false if the conversation has no chat log table.
+export function ensureMessageSearchIndex(
+  db: Database,
+  conversationID: string
+): boolean {
+  let indexed = indexedConversations.get(db);
+  if (!indexed) {
+    indexed = new Set();
+    indexedConversations.set(db, indexed);
+  }
+  if (indexed.has(conversationID)) {
+    return true;
+  }
+  const table = `chat_logs_${conversationID}`;
+  const exists = db.exec(
+    `SELECT name FROM sqlite_master WHERE type = 'table' AND name = ?`,
+    [table]
+  );
+  if (exists.length === 0) {
+    return false;
+  }
+  const triggers = db.exec(
+    `SELECT name FROM sqlite_master WHERE type = 'trigger' AND name = ?`,
+    [`${table}_search_ai`]
+  );
+  if (triggers.length === 0) {
+    const id = escapeString(conversationID);
+    db.exec(
+      `
+        create trigger if not exists '${table}_search_ai' after insert on '${table}' begin
+          insert or ignore into local_msg_search_queue values (${id}, new.client_msg_id);
+        end;
+        create trigger if not exists '${table}_search_ad' after delete on '${table}' begin
+          insert or ignore into local_msg_search_queue values (${id}, old.client_msg_id);
+        end;
+        create trigger if not exists '${table}_search_au' after update of client_msg_id, content_type, content on '${table}' begin
+          insert or ignore into local_msg_search_queue values (${id}, old.client_msg_id);
+          insert or ignore into local_msg_search_queue values (${id}, new.client_msg_id);
+        end;
+        insert or ignore into local_msg_search_queue select ${id}, client_msg_id from '${table}';
+      `
+    );
+  }
+  indexed.add(conversationID);
+  return true;
+}
+
+function indexMessages(
+  db: Database,
+  conversationID: string,
+  clientMsgIDs: string[]
+) {
+  const ids = placeholders(clientMsgIDs);
+  db.exec(
+    `DELETE FROM local_msg_search_docs WHERE conversation_id = ? AND client_msg_id IN (${ids})`,
+    [conversationID, ...clientMsgIDs]
+  );
+  db.exec(
+    `DELETE FROM local_msg_search_tokens WHERE conversation_id = ? AND client_msg_id IN (${ids})`,
+    [conversationID, ...clientMsgIDs]
+  );
+  let rows: QueryExecResult[] = [];
+  try {
+    rows = db.exec(
+      `SELECT client_msg_id, content_type, content FROM 'chat_logs_${conversationID}' WHERE client_msg_id IN (${ids})`,
+      clientMsgIDs
+    );
+  } catch (e) {
+    // the chat log table is gone, only drop its messages from the index
+  }
+  (rows[0]?.values ?? []).forEach(([clientMsgID, contentType, content]) => {
+    const text = searchText(Number(contentType), String(content ?? ''));
+    const tokens = tokenize(text);
+    if (tokens.length === 0) {
+      return;
+    }
+    db.exec('INSERT INTO local_msg_search_docs VALUES (?, ?, ?, ?)', [
+      conversationID,
+      clientMsgID,
+      text,
+      tokens.length,
+    ]);
+    const tf = new Map<string, number>();
+    tokens.forEach(t => tf.set(t.text, (tf.get(t.text) ?? 0) + 1));
+    tf.forEach((n, token) => {
+      db.exec('INSERT INTO local_msg_search_tokens VALUES (?, ?, ?, ?)', [
+        conversationID,
+        token,
+        clientMsgID,
+        n,
+      ]);
+    });
+  });
+  db.exec(
+    `DELETE FROM local_msg_search_queue WHERE conversation_id = ? AND client_msg_id IN (${ids})`,
+    [conversationID, ...clientMsgIDs]
+  );
+}
+
+// flushMessageSearchQueue indexes the messages changed since the last search.
+export function flushMessageSearchQueue(db: Database) {
+  const queued = db.exec(
+    'SELECT conversation_id, client_msg_id FROM local_msg_search_queue'
+  );
+  if (queued.length === 0) {
+    return;
+  }
+  const byConversation = new Map<string, string[]>();
+  queued[0].values.forEach(([conversationID, clientMsgID]) => {
+    const list = byConversation.get(String(conversationID)) ?? [];
+    list.push(String(clientMsgID));
+    byConversation.set(String(conversationID), list);
+  });
+  db.exec('BEGIN');
+  try {
+    byConversation.forEach((clientMsgIDs, conversationID) => {
+      chunks(clientMsgIDs).forEach(chunk =>
+        indexMessages(db, conversationID, chunk)
+      );
+    });
+    db.exec('COMMIT');
+  } catch (e) {
+    db.exec('ROLLBACK');
+    throw e;
+  }
+}
+
+// scoreMessageSearch returns the BM25 score of every message of the
+// conversation matching the keywords. A keyword matches as a phrase, and its
+// last token also as a prefix unless it is CJK.
+export function scoreMessageSearch(
+  db: Database,
+  conversationID: string,
+  keywordList: string[],
+  keywordListMatchType: number
+): Map<string, number> {
+  const stats = db.exec(
+    'SELECT count(*), avg(length) FROM local_msg_search_docs WHERE conversation_id = ?',
+    [conversationID]
+  );
+  const docCount = Number(stats[0]?.values[0][0] ?? 0);
+  const avgLength = Number(stats[0]?.values[0][1] ?? 1) || 1;
+  let result: Map<string, number> | undefined;
+  for (const keyword of keywordList) {
+    const query = tokenize(keyword);
+    if (query.length === 0) {
+      continue;
+    }
+    // the tf of each query token in the messages having all of them
+    let postings: Map<string, number[]> | undefined;
+    const idfs: number[] = [];
+    for (let j = 0; j < query.length; j++) {
+      const token = query[j];
+      const prefix = j === query.length - 1 && !token.cjk;
+      const rows = prefix
+        ? db.exec(
+            'SELECT client_msg_id, sum(tf) FROM local_msg_search_tokens WHERE conversation_id = ? AND token >= ? AND token < ? GROUP BY client_msg_id',
+            [conversationID, token.text, token.text + '\uffff']
+          )
+        : db.exec(
+            'SELECT client_msg_id, tf FROM local_msg_search_tokens WHERE conversation_id = ? AND token = ?',
+            [conversationID, token.text]
+          );
+      const values = rows[0]?.values ?? [];
+      idfs.push(
+        Math.log((docCount - values.length + 0.5) / (values.length + 0.5) + 1)
+      );
+      const next = new Map<string, number[]>();
+      for (const [clientMsgID, tf] of values) {
+        const id = String(clientMsgID);
+        const tfs = postings ? postings.get(id) : [];
+        if (tfs) {
+          next.set(id, [...tfs, Number(tf)]);
+        }
+      }
+      postings = next;
+    }
+    const matched = postings ?? new Map<string, number[]>();
+    const scores = new Map<string, number>();
+    for (const chunk of chunks([...matched.keys()])) {
+      const docs = db.exec(
+        `SELECT client_msg_id, text, length FROM local_msg_search_docs WHERE conversation_id = ? AND client_msg_id IN (${placeholders(
+          chunk
+        )})`,
+        [conversationID, ...chunk]
+      );
+      for (const [clientMsgID, text, length] of docs[0]?.values ?? []) {
+        const id = String(clientMsgID);
+        if (query.length > 1 && !matchPhrase(tokenize(String(text)), query)) {
+          continue;
+        }
+        const norm =
+          BM25_K1 * (1 - BM25_B + (BM25_B * Number(length)) / avgLength);
+        const score = (matched.get(id) ?? []).reduce(
+          (sum, tf, j) => sum + (idfs[j] * tf * (BM25_K1 + 1)) / (tf + norm),
+          0
+        );
+        scores.set(id, score);
+      }
+    }
+    if (!result) {
+      result = scores;
+    } else if (keywordListMatchType === 0) {
+      for (const [id, score] of scores) {
+        result.set(id, (result.get(id) ?? 0) + score);
+      }
+    } else {
+      const intersection = new Map<string, number>();
+      for (const [id, score] of scores) {
+        const previous = result.get(id);
+        if (previous !== undefined) {
+          intersection.set(id, previous + score);
+        }
+      }
+      result = intersection;
+    }
+  }
+  return result ?? new Map<string, number>();
+}
+
+export function getSearchedMessages(
+  db: Database,
+  conversationID: string,
+  clientMsgIDs: string[],
+  contentType: number[],
+  startTime: number,
+  endTime: number
+): QueryExecResult[] {
+  let columns: string[] = [];
+  const values: SqlValue[][] = [];
+  chunks(clientMsgIDs).forEach(chunk => {
+    const result = db.exec(
+      `
+        SELECT * FROM 'chat_logs_${conversationID}'
+          WHERE client_msg_id IN (${placeholders(chunk)})
+          AND send_time BETWEEN ? AND ?
+          AND status <= 3
+          AND content_type IN (${placeholders(contentType)})
+      `,
+      [...chunk, startTime, endTime, ...contentType]
+    );
+    if (result.length > 0) {
+      columns = result[0].columns;
+      values.push(...result[0].values);
+    }
+  });
+  return values.length > 0 ? [{ columns, values }] : [];
+}
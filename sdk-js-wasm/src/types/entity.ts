@@ -116,6 +116,23 @@ export type SelfUserInfo = {
 export type PartialUserInfo = {
   userID: string;
 } & Partial<Omit<SelfUserInfo, 'userID'>>;
+export type FavoriteItem = {
+  favoriteID: string;
+  conversationID: string;
+  seq: number;
+  // JSON of the MessageItem saved when the message was starred
+  msg: string;
+  // JSON of the { sourceURL, url } pairs of the copied files
+  attachments: string;
+  tags: string[];
+  note: string;
+  createTime: number;
+  updateTime: number;
+};
+export type FavoriteSearchResult = {
+  total: number;
+  favorites: FavoriteItem[];
+};
 export type FriendUserItem = {
   addSource: number;
   createTime: number;
@@ -166,6 +183,55 @@ export type GroupItem = {
   lookMemberInfo: AllowType;
   displayIsRead: boolean;
 };
+export type GroupInviteItem = {
+  token: string;
+  groupID: string;
+  creatorUserID: string;
+  createTime: number;
+  expireTime: number;
+  maxUses: number;
+  usedCount: number;
+  needApproval: boolean;
+  revoked: boolean;
+  ex: string;
+};
+export type GroupInviteInfo = {
+  invite: GroupInviteItem;
+  groupInfo: GroupItem;
+};
+export type JoinGroupByInviteResult = {
+  groupID: string;
+  needApproval: boolean;
+};
+export type GroupAnnouncementItem = {
+  announcementID: string;
+  groupID: string;
+  content: string;
+  creatorUserID: string;
+  createTime: number;
+  requireConfirm: boolean;
+  ex: string;
+};
+export type GroupAnnouncementList = {
+  total: number;
+  announcements: GroupAnnouncementItem[];
+  confirmedAnnouncementIDs: string[];
+};
+export type GroupAnnouncementConfirms = {
+  confirms: { userID: string; confirmTime: number }[];
+  unconfirmedUserIDs: string[];
+};
+export type GroupAnnouncementRemindInfo = {
+  opUser: GroupMemberItem;
+  group: GroupItem;
+  announcement: GroupAnnouncementItem;
+};
+export type GroupAnnouncementConfirmedInfo = {
+  confirmUser: GroupMemberItem;
+  group: GroupItem;
+  announcement: GroupAnnouncementItem;
+  confirmTime: number;
+};
 export type GroupMemberItem = {
   groupID: string;
   userID: string;
@@ -207,6 +273,40 @@ export type ConversationItem = {
   isMsgDestruct: boolean;
   attachedInfo: string;
   ex?: string;
+  // JSON array of the IDs of the regular folders the conversation is in
+  folderIDs?: string;
+};
+export type ConversationFolderRule = {
+  sessionTypes?: SessionType[];
+  groupIDPrefix?: string;
+  // 0 any, 1 unread only, 2 unread and mentioning me
+  unread?: number;
+};
+export type ConversationFolderItem = {
+  folderID: string;
+  name: string;
+  sort: number;
+  // JSON of the ConversationFolderRule, empty for a regular folder
+  rule: string;
+  createTime: number;
+  updateTime: number;
+};
+export type ConversationFolderUnreadCount = {
+  folderID: string;
+  unreadCount: number;
+};
+export type ForwardMessageTarget = {
+  sessionType: SessionType;
+  recvID?: string;
+  groupID?: string;
+};
+export type ForwardMessageResult = {
+  target: ForwardMessageTarget;
+  conversationID: string;
+  msgs?: { serverMsgID: string; clientMsgID: string; sendTime: number }[];
+  // 0 when the messages were sent to the target
+  errCode?: number;
+  errMsg?: string;
 };
 export type MessageItem = {
   clientMsgID: string;
@@ -323,6 +423,14 @@ export type AttachedInfoElem = {
   hasReadTime: number;
   messageEntityList?: MessageEntity[];
   uploadProgress?: UploadProgress;
+  // set on messages forwarded by the server
+  forwardInfo?: ForwardInfo;
+};
+export type ForwardInfo = {
+  sendID: string;
+  senderNickname: string;
+  senderFaceUrl: string;
+  sendTime: number;
 };
 export type UploadProgress = {
   total: number;
@@ -15,6 +15,15 @@ export enum GroupJoinSource {
   Invitation = 2,
   Search = 3,
   QrCode = 4,
+  InviteLink = 5,
+}
+export enum GroupAdminPermission {
+  Kick = 1 << 0,
+  Mute = 1 << 1,
+  ApproveJoin = 1 << 2,
+  EditInfo = 1 << 3,
+  PinMessage = 1 << 4,
+  RevokeMessage = 1 << 5,
 }
 export enum GroupMemberRole {
   Normal = 20,
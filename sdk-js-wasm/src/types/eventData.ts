@@ -1,10 +1,14 @@
 import { CbEvents } from '@/constant';
 import {
   BlackUserItem,
+  ConversationFolderItem,
   ConversationInputStatus,
   ConversationItem,
+  FavoriteItem,
   FriendApplicationItem,
   FriendUserItem,
+  GroupAnnouncementConfirmedInfo,
+  GroupAnnouncementRemindInfo,
   GroupApplicationItem,
   GroupItem,
   GroupMemberItem,
@@ -30,6 +34,8 @@ export type EventDataMap = {
   [CbEvents.OnGroupApplicationDeleted]: GroupApplicationItem;
   [CbEvents.OnGroupApplicationRejected]: GroupApplicationItem;
   [CbEvents.OnGroupApplicationAccepted]: GroupApplicationItem;
+  [CbEvents.OnGroupAnnouncementRemind]: GroupAnnouncementRemindInfo;
+  [CbEvents.OnGroupAnnouncementConfirmed]: GroupAnnouncementConfirmedInfo;
   [CbEvents.OnGroupDismissed]: GroupItem;
   [CbEvents.OnGroupMemberDeleted]: GroupMemberItem;
   [CbEvents.OnGroupMemberInfoChanged]: GroupMemberItem;
@@ -37,6 +43,7 @@ export type EventDataMap = {
   [CbEvents.OnJoinedGroupDeleted]: GroupItem;
   [CbEvents.OnNewConversation]: ConversationItem[];
   [CbEvents.OnConversationUserInputStatusChanged]: ConversationInputStatus;
+  [CbEvents.OnConversationFoldersChanged]: ConversationFolderItem[];
   [CbEvents.OnNewRecvMessageRevoked]: RevokedInfo;
   [CbEvents.OnRecvC2CReadReceipt]: ReceiptInfo[];
   [CbEvents.OnRecvNewMessage]: MessageItem;
@@ -56,6 +63,9 @@ export type EventDataMap = {
     allReadSeq: number;
   };
   [CbEvents.OnUserStatusChanged]: UserOnlineState;
+  [CbEvents.OnFavoriteAdded]: FavoriteItem;
+  [CbEvents.OnFavoriteDeleted]: FavoriteItem;
+  [CbEvents.OnFavoriteUpdated]: FavoriteItem;
   [CbEvents.OnConnectFailed]: void;
   [CbEvents.OnConnectSuccess]: void;
   [CbEvents.OnConnecting]: void;
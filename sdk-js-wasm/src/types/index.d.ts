@@ -43,6 +43,7 @@ declare global {
     searchMessageByKeyword: DatabaseApi;
     searchMessageByContentType: DatabaseApi;
     searchMessageByContentTypeAndKeyword: DatabaseApi;
+    searchMessageFullText: DatabaseApi;
     updateMsgSenderNickname: DatabaseApi;
     updateMsgSenderFaceURL: DatabaseApi;
     updateMsgSenderFaceURLAndSenderNickname: DatabaseApi;
@@ -60,6 +61,7 @@ declare global {
     getMessageBySeq: DatabaseApi;
     getMessagesByClientMsgIDs: DatabaseApi;
     getMessagesBySeqs: DatabaseApi;
+    getMessagesAfter: DatabaseApi;
     getConversationNormalMsgSeq: DatabaseApi;
     checkConversationNormalMsgSeq: DatabaseApi;
     getConversationPeerNormalMsgSeq: DatabaseApi;
@@ -250,6 +252,17 @@ declare global {
     upsertReadStateDB: DatabaseApi;
     updateReadStateAllReadSeqDB: DatabaseApi;
     deleteReadStateDB: DatabaseApi;
+    // favorites (with DB suffix to avoid conflict with WASM API functions)
+    insertFavoriteDB: DatabaseApi;
+    updateFavoriteDB: DatabaseApi;
+    deleteFavoriteDB: DatabaseApi;
+    getAllFavoritesDB: DatabaseApi;
+    getFavoriteListDB: DatabaseApi;
+    // conversation folders (with DB suffix to avoid conflict with WASM API functions)
+    insertConversationFolderDB: DatabaseApi;
+    updateConversationFolderDB: DatabaseApi;
+    deleteConversationFolderDB: DatabaseApi;
+    getConversationFoldersDB: DatabaseApi;
 
     // temp chche logs
     batchInsertTempCacheMessageList: DatabaseApi;
@@ -423,6 +436,35 @@ declare global {
       groupID: string
     ) => Promise<string>;
     setSelfInfo: (operationID: string, userInfo: string[]) => Promise<string>;
+    addFavorite: (
+      operationID: string,
+      conversationID: string,
+      seq: number,
+      tags: string,
+      note: string
+    ) => Promise<string>;
+    deleteFavorites: (
+      operationID: string,
+      favoriteIDs: string
+    ) => Promise<string>;
+    updateFavorite: (
+      operationID: string,
+      favoriteID: string,
+      tags: string,
+      note: string
+    ) => Promise<string>;
+    getFavorites: (
+      operationID: string,
+      offset: number,
+      count: number
+    ) => Promise<string>;
+    searchFavorites: (
+      operationID: string,
+      keyword: string,
+      tags: string,
+      pageNumber: number,
+      showNumber: number
+    ) => Promise<string>;
     createTextAtMessage: (
       operationID: string,
       text: string,
@@ -594,6 +636,37 @@ declare global {
       isPinned: boolean
     ) => Promise<string>;
     getTotalUnreadMsgCount: (operationID: string) => Promise<string>;
+    createConversationFolder: (
+      operationID: string,
+      req: string
+    ) => Promise<string>;
+    updateConversationFolder: (
+      operationID: string,
+      req: string
+    ) => Promise<string>;
+    deleteConversationFolder: (
+      operationID: string,
+      folderID: string
+    ) => Promise<string>;
+    getConversationFolders: (operationID: string) => Promise<string>;
+    addConversationsToFolder: (
+      operationID: string,
+      folderID: string,
+      conversationIDList: string
+    ) => Promise<string>;
+    removeConversationsFromFolder: (
+      operationID: string,
+      folderID: string,
+      conversationIDList: string
+    ) => Promise<string>;
+    getConversationListSplitByFolder: (
+      operationID: string,
+      folderID: string,
+      offset: number,
+      count: number
+    ) => Promise<string>;
+    getConversationFolderUnreadCounts: (operationID: string) => Promise<string>;
+    forwardMessages: (operationID: string, req: string) => Promise<string>;
     getConversationRecvMessageOpt: (
       operationID: string,
       conversationIDList: string[]
@@ -737,12 +810,68 @@ declare global {
       operationID: string,
       memberInfo: string
     ) => Promise<string>;
+    setGroupAdminPermissions: (
+      operationID: string,
+      groupID: string,
+      userID: string,
+      permissions: number
+    ) => Promise<string>;
+    publishGroupAnnouncement: (
+      operationID: string,
+      groupID: string,
+      content: string,
+      requireConfirm: boolean,
+      ex: string
+    ) => Promise<string>;
+    getGroupAnnouncements: (
+      operationID: string,
+      groupID: string,
+      pageNumber: number,
+      showNumber: number
+    ) => Promise<string>;
+    confirmGroupAnnouncement: (
+      operationID: string,
+      groupID: string,
+      announcementID: string
+    ) => Promise<string>;
+    getGroupAnnouncementConfirms: (
+      operationID: string,
+      groupID: string,
+      announcementID: string
+    ) => Promise<string>;
+    remindGroupAnnouncement: (
+      operationID: string,
+      groupID: string,
+      announcementID: string
+    ) => Promise<string>;
     joinGroup: (
       operationID: string,
       groupID: string,
       reqMsg: string,
       joinSource: GroupJoinSource
     ) => Promise<string>;
+    createGroupInvite: (
+      operationID: string,
+      groupID: string,
+      expireTime: number,
+      maxUses: number,
+      needApproval: boolean,
+      ex: string
+    ) => Promise<string>;
+    getGroupInvites: (operationID: string, groupID: string) => Promise<string>;
+    revokeGroupInvite: (
+      operationID: string,
+      groupID: string,
+      token: string
+    ) => Promise<string>;
+    getGroupInviteInfo: (operationID: string, token: string) => Promise<string>;
+    joinGroupByInvite: (
+      operationID: string,
+      token: string,
+      reqMsg: string,
+      joinSource: GroupJoinSource,
+      ex: string
+    ) => Promise<string>;
     searchGroups: (
       operationID: string,
       keywordList: string[],
@@ -7,6 +7,8 @@ import {
   SelfUserInfo,
   RtcInvite,
   GroupItem,
+  ConversationFolderRule,
+  ForwardMessageTarget,
 } from './entity';
 import {
   AllowType,
@@ -174,6 +176,23 @@ export type JoinGroupParams = {
   joinSource: GroupJoinSource;
   ex?: string;
 };
+export type CreateGroupInviteParams = {
+  groupID: string;
+  expireTime?: number;
+  maxUses?: number;
+  needApproval?: boolean;
+  ex?: string;
+};
+export type RevokeGroupInviteParams = {
+  groupID: string;
+  token: string;
+};
+export type JoinGroupByInviteParams = {
+  token: string;
+  reqMsg: string;
+  joinSource?: GroupJoinSource;
+  ex?: string;
+};
 export type SearchGroupParams = {
   keywordList: string[];
   isSearchGroupID: boolean;
@@ -387,6 +406,26 @@ export type UpdateMemberInfoParams = {
   roleLevel?: GroupMemberRole;
   ex?: string;
 };
+export type SetGroupAdminPermissionsParams = {
+  groupID: string;
+  userID: string;
+  permissions: number;
+};
+export type PublishGroupAnnouncementParams = {
+  groupID: string;
+  content: string;
+  requireConfirm: boolean;
+  ex?: string;
+};
+export type GetGroupAnnouncementsParams = {
+  groupID: string;
+  pageNumber: number;
+  showNumber: number;
+};
+export type GroupAnnouncementParams = {
+  groupID: string;
+  announcementID: string;
+};
 export type FindMessageParams = {
   conversationID: string;
   clientMsgIDList: string[];
@@ -400,6 +439,57 @@ export type UploadFileParams = {
   cause?: string;
 };
 export type PartialUserItem = Partial<SelfUserInfo>;
+export type AddFavoriteParams = {
+  conversationID: string;
+  seq: number;
+  tags?: string[];
+  note?: string;
+};
+export type UpdateFavoriteParams = {
+  favoriteID: string;
+  tags: string[];
+  note: string;
+};
+export type GetFavoritesParams = {
+  offset: number;
+  count: number;
+};
+export type CreateConversationFolderParams = {
+  name: string;
+  sort?: number;
+  // a folder with a rule is a smart folder
+  rule?: ConversationFolderRule;
+};
+export type UpdateConversationFolderParams = {
+  folderID: string;
+  name?: string;
+  sort?: number;
+  rule?: ConversationFolderRule;
+};
+export type ConversationFolderMembersParams = {
+  folderID: string;
+  conversationIDList: string[];
+};
+export type SplitConversationByFolderParams = {
+  folderID: string;
+  offset: number;
+  count: number;
+};
+export type ForwardMessagesParams = {
+  conversationID: string;
+  seqs: number[];
+  targets: ForwardMessageTarget[];
+  // forward the messages as one merger message per target
+  merge?: boolean;
+  mergeTitle?: string;
+  mergeAbstracts?: string[];
+};
+export type SearchFavoritesParams = {
+  keyword: string;
+  tags?: string[];
+  pageNumber: number;
+  showNumber: number;
+};
 
 export type SignalingInviteParams = {
   invitation: RtcInvite;
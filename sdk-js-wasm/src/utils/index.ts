@@ -5,3 +5,4 @@ export * from './value';
 export * from './is';
 export * from './escape';
 export * from './logFormat';
+export * from './search';
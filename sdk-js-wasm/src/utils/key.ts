@@ -66,6 +66,9 @@ const InternalConstraint = [
   ['entity_id', 'entityID'],
   ['version_id', 'versionID'],
   ['display_is_read', 'displayIsRead'],
+  ['favorite_id', 'favoriteID'],
+  ['folder_id', 'folderID'],
+  ['folder_ids', 'folderIDs'],
 ];
 
 function _getInternalCamelCaseBySnakeCase(key: string) {
@@ -0,0 +1,101 @@
+// Message search text and tokenization, kept in line with pkg/search of the SDK core.
+
+const CJK_RX = /[\p{Script=Han}\p{Script=Hiragana}\p{Script=Katakana}\p{Script=Hangul}]/u;
+const TOKEN_RX = /[\p{L}\p{N}\p{Mn}]/u;
+
+export type SearchToken = {
+  text: string;
+  // UTF-16 offsets
+  start: number;
+  end: number;
+  cjk: boolean;
+};
+
+// tokenize splits text into lower-cased runs of letters and digits, with every
+// CJK character a token of its own.
+export function tokenize(text: string): SearchToken[] {
+  const tokens: SearchToken[] = [];
+  let start = -1;
+  let i = 0;
+  const flush = (end: number) => {
+    if (start >= 0) {
+      tokens.push({
+        text: text.slice(start, end).toLowerCase(),
+        start,
+        end,
+        cjk: false,
+      });
+      start = -1;
+    }
+  };
+  for (const ch of text) {
+    if (CJK_RX.test(ch)) {
+      flush(i);
+      tokens.push({ text: ch, start: i, end: i + ch.length, cjk: true });
+    } else if (TOKEN_RX.test(ch)) {
+      if (start < 0) {
+        start = i;
+      }
+    } else {
+      flush(i);
+    }
+    i += ch.length;
+  }
+  flush(i);
+  return tokens;
+}
+
+// searchText returns the text of a message that search matches against.
+export function searchText(contentType: number, content: string): string {
+  let elem: Record<string, unknown>;
+  try {
+    elem = JSON.parse(content) ?? {};
+  } catch (e) {
+    return '';
+  }
+  let text: unknown;
+  switch (contentType) {
+    case 101: // Text
+      text = elem.content;
+      break;
+    case 105: // File
+      text = elem.fileName;
+      break;
+    case 106: // AtText
+    case 114: // Quote
+      text = elem.text;
+      break;
+    case 107: // Merger
+      text = elem.title;
+      break;
+    case 108: // Card
+      text = elem.nickname;
+      break;
+    case 109: // Location
+    case 110: // Custom
+      text = elem.description;
+      break;
+  }
+  return typeof text === 'string' ? text : '';
+}
+
+// matchPhrase reports whether the tokens of text contain the keyword tokens in
+// order, the last one also as a prefix unless it is CJK.
+export function matchPhrase(
+  textTokens: SearchToken[],
+  keywordTokens: SearchToken[]
+): boolean {
+  const last = keywordTokens.length - 1;
+  for (let i = 0; i + keywordTokens.length <= textTokens.length; i++) {
+    const ok = keywordTokens.every((q, j) => {
+      const t = textTokens[i + j].text;
+      return (
+        t === q.text || (j === last && !q.cjk && t.startsWith(q.text))
+      );
+    });
+    if (ok) {
+      return true;
+    }
+  }
+  return false;
+}
@@ -0,0 +1,58 @@
+import assert from 'assert';
+import initSqlJs, { Database } from '@jlongster/sql.js';
+import { localAppSDKVersions } from '../src/sqls/localAppSdkVersion';
+import { localConversations } from '../src/sqls/localConversations';
+import { localFriends } from '../src/sqls/localFriend';
+import { localGroups } from '../src/sqls/localGroups';
+import { migrate } from '../src/api/database/migration';
+
+// node:test is newer than the @types/node in use
+// eslint-disable-next-line @typescript-eslint/no-var-requires
+const { test } = require('node:test');
+
+async function openDB(): Promise<Database> {
+  const SQL = await initSqlJs({
+    locateFile: (file: string) =>
+      require.resolve(`@jlongster/sql.js/dist/${file}`),
+  });
+  return new SQL.Database();
+}
+
+function createTables(db: Database) {
+  localConversations(db);
+  localFriends(db);
+  localGroups(db);
+  localAppSDKVersions(db);
+}
+
+function appSDKVersion(db: Database) {
+  const result = db.exec(
+    'SELECT version, installed, schema_version FROM local_app_sdk_version'
+  );
+  return result[0].values;
+}
+
+test('fresh database is not marked as installed', async () => {
+  const db = await openDB();
+  createTables(db);
+  migrate(db);
+  assert.deepStrictEqual(appSDKVersion(db), [['', 0, 3]]);
+  // the next login runs no step again
+  migrate(db);
+  assert.deepStrictEqual(appSDKVersion(db), [['', 0, 3]]);
+});
+
+test('database of an older version keeps its installed state', async () => {
+  const db = await openDB();
+  db.exec(`
+    CREATE TABLE local_app_sdk_version ('version' varchar(255), primary key ('version'));
+    INSERT INTO local_app_sdk_version (version) VALUES ('v3.5.0');
+    CREATE TABLE local_friends ('owner_user_id' char(64), 'friend_user_id' char(64));
+    INSERT INTO local_friends VALUES ('u1', 'u2');
+  `);
+  createTables(db);
+  migrate(db);
+  assert.deepStrictEqual(appSDKVersion(db), [['v3.5.0', 1, 3]]);
+  const friends = db.exec('SELECT is_pinned FROM local_friends');
+  assert.deepStrictEqual(friends[0].values, [[0]]);
+});
@@ -75,3 +75,27 @@ func (o *ConversationApi) GetPinnedConversationIDs(c *gin.Context) {
 func (o *ConversationApi) GetConversationReadCursors(c *gin.Context) {
 	a2r.Call(c, conversation.ConversationClient.GetConversationReadCursors, o.Client)
 }
+
+func (o *ConversationApi) CreateConversationFolder(c *gin.Context) {
+	a2r.Call(c, conversation.ConversationClient.CreateConversationFolder, o.Client)
+}
+
+func (o *ConversationApi) UpdateConversationFolder(c *gin.Context) {
+	a2r.Call(c, conversation.ConversationClient.UpdateConversationFolder, o.Client)
+}
+
+func (o *ConversationApi) DeleteConversationFolder(c *gin.Context) {
+	a2r.Call(c, conversation.ConversationClient.DeleteConversationFolder, o.Client)
+}
+
+func (o *ConversationApi) GetConversationFolders(c *gin.Context) {
+	a2r.Call(c, conversation.ConversationClient.GetConversationFolders, o.Client)
+}
+
+func (o *ConversationApi) AddConversationsToFolder(c *gin.Context) {
+	a2r.Call(c, conversation.ConversationClient.AddConversationsToFolder, o.Client)
+}
+
+func (o *ConversationApi) RemoveConversationsFromFolder(c *gin.Context) {
+	a2r.Call(c, conversation.ConversationClient.RemoveConversationsFromFolder, o.Client)
+}
@@ -165,3 +165,67 @@ func (o *GroupApi) GetFullGroupMemberUserIDs(c *gin.Context) {
 func (o *GroupApi) GetFullJoinGroupIDs(c *gin.Context) {
 	a2r.Call(c, group.GroupClient.GetFullJoinGroupIDs, o.Client)
 }
+
+func (o *GroupApi) CreateGroupInvite(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.CreateGroupInvite, o.Client)
+}
+
+func (o *GroupApi) GetGroupInvites(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.GetGroupInvites, o.Client)
+}
+
+func (o *GroupApi) RevokeGroupInvite(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.RevokeGroupInvite, o.Client)
+}
+
+func (o *GroupApi) GetGroupInviteInfo(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.GetGroupInviteInfo, o.Client)
+}
+
+func (o *GroupApi) JoinGroupByInvite(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.JoinGroupByInvite, o.Client)
+}
+
+func (o *GroupApi) SetGroupAdminPermissions(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.SetGroupAdminPermissions, o.Client)
+}
+
+func (o *GroupApi) PublishGroupAnnouncement(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.PublishGroupAnnouncement, o.Client)
+}
+
+func (o *GroupApi) GetGroupAnnouncements(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.GetGroupAnnouncements, o.Client)
+}
+
+func (o *GroupApi) ConfirmGroupAnnouncement(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.ConfirmGroupAnnouncement, o.Client)
+}
+
+func (o *GroupApi) GetGroupAnnouncementConfirms(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.GetGroupAnnouncementConfirms, o.Client)
+}
+
+func (o *GroupApi) RemindGroupAnnouncement(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.RemindGroupAnnouncement, o.Client)
+}
+
+func (o *GroupApi) SetGroupMemberLimit(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.SetGroupMemberLimit, o.Client)
+}
+
+func (o *GroupApi) GetGroupMemberLimit(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.GetGroupMemberLimit, o.Client)
+}
+
+func (o *GroupApi) ImportGroupMembers(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.ImportGroupMembers, o.Client)
+}
+
+func (o *GroupApi) GetGroupImportJob(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.GetGroupImportJob, o.Client)
+}
+
+func (o *GroupApi) ExportGroupMembers(c *gin.Context) {
+	a2r.Call(c, group.GroupClient.ExportGroupMembers, o.Client)
+}
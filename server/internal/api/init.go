@@ -21,6 +21,7 @@ import (
 
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/discovery/etcd"
 	"github.com/openimsdk/tools/errs"
@@ -52,6 +53,14 @@ func Start(ctx context.Context, index int, cfg *Config) error {
 	}
 	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
+	shutdownTracing, err := tracing.Init(ctx, &cfg.Share.Tracing, "openim-api")
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing(context.Background())
+	if cfg.Share.Tracing.Enable {
+		client.AddOption(tracing.GrpcDialOption())
+	}
 
 	var (
 		netDone        = make(chan struct{}, 1)
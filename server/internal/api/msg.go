@@ -153,6 +153,66 @@ func (m *MessageApi) DeleteMsgPhysical(c *gin.Context) {
 	a2r.Call(c, msg.MsgClient.DeleteMsgPhysical, m.Client)
 }
 
+func (m *MessageApi) SetMsgRetentionPolicy(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.SetMsgRetentionPolicy, m.Client)
+}
+
+func (m *MessageApi) DeleteMsgRetentionPolicies(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.DeleteMsgRetentionPolicies, m.Client)
+}
+
+func (m *MessageApi) GetMsgRetentionPolicies(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.GetMsgRetentionPolicies, m.Client)
+}
+
+func (m *MessageApi) SetLegalHold(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.SetLegalHold, m.Client)
+}
+
+func (m *MessageApi) RemoveLegalHold(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.RemoveLegalHold, m.Client)
+}
+
+func (m *MessageApi) GetLegalHolds(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.GetLegalHolds, m.Client)
+}
+
+func (m *MessageApi) StartComplianceExport(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.StartComplianceExport, m.Client)
+}
+
+func (m *MessageApi) GetComplianceExport(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.GetComplianceExport, m.Client)
+}
+
+func (m *MessageApi) AddFavorite(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.AddFavorite, m.Client)
+}
+
+func (m *MessageApi) DeleteFavorites(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.DeleteFavorites, m.Client)
+}
+
+func (m *MessageApi) UpdateFavorite(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.UpdateFavorite, m.Client)
+}
+
+func (m *MessageApi) SearchFavorites(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.SearchFavorites, m.Client)
+}
+
+func (m *MessageApi) GetIncrementalFavorites(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.GetIncrementalFavorites, m.Client)
+}
+
+func (m *MessageApi) GetFullFavoriteIDs(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.GetFullFavoriteIDs, m.Client)
+}
+
+func (m *MessageApi) ForwardMsgs(c *gin.Context) {
+	a2r.Call(c, msg.MsgClient.ForwardMsgs, m.Client)
+}
+
 func (m *MessageApi) getSendMsgReq(c *gin.Context, req apistruct.SendMsg) (sendMsgReq *msg.SendMsgReq, err error) {
 	var data any
 	log.ZDebug(c, "getSendMsgReq", "req", req.Content)
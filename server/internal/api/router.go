@@ -23,6 +23,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/discovery"
@@ -95,6 +96,11 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, co
 	case BestSpeed:
 		r.Use(gzip.Gzip(gzip.BestSpeed))
 	}
+	if config.Share.Tracing.Enable {
+		// the handlers pass the *gin.Context to the rpc clients, let it reach the span in the request context
+		r.ContextWithFallback = true
+		r.Use(tracing.GinMiddleware("openim-api")...)
+	}
 	r.Use(prommetricsGin(), gin.RecoveryWithWriter(gin.DefaultErrorWriter, mw.GinPanicErr), mw.CorsHandler(), mw.GinParseOperationID(), GinParseToken(rpcli.NewAuthClient(authConn)))
 	u := NewUserApi(user.NewUserClient(userConn), client, config.Share.RpcRegisterName)
 	m := NewMessageApi(msg.NewMsgClient(msgConn), rpcli.NewUserClient(userConn), config.Share.IMAdminUserID)
@@ -104,6 +110,8 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, co
 		userRouterGroup.POST("/update_user_info", u.UpdateUserInfo)
 		userRouterGroup.POST("/update_user_info_ex", u.UpdateUserInfoEx)
 		userRouterGroup.POST("/set_global_msg_recv_opt", u.SetGlobalRecvMessageOpt)
+		userRouterGroup.POST("/set_last_seen_privacy", u.SetLastSeenPrivacy)
+		userRouterGroup.POST("/get_last_seen_privacy", u.GetLastSeenPrivacy)
 		userRouterGroup.POST("/get_users_info", u.GetUsersPublicInfo)
 		userRouterGroup.POST("/get_all_users_uid", u.GetAllUsersID)
 		userRouterGroup.POST("/account_check", u.AccountCheck)
@@ -177,6 +185,7 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, co
 		groupRouterGroup.POST("/mute_group", g.MuteGroup)
 		groupRouterGroup.POST("/cancel_mute_group", g.CancelMuteGroup)
 		groupRouterGroup.POST("/set_group_member_info", g.SetGroupMemberInfo)
+		groupRouterGroup.POST("/set_group_admin_permissions", g.SetGroupAdminPermissions)
 		groupRouterGroup.POST("/get_group_abstract_info", g.GetGroupAbstractInfo)
 		groupRouterGroup.POST("/get_groups", g.GetGroups)
 		groupRouterGroup.POST("/get_group_member_user_id", g.GetGroupMemberUserIDs)
@@ -185,6 +194,21 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, co
 		groupRouterGroup.POST("/get_incremental_group_members_batch", g.GetIncrementalGroupMemberBatch)
 		groupRouterGroup.POST("/get_full_group_member_user_ids", g.GetFullGroupMemberUserIDs)
 		groupRouterGroup.POST("/get_full_join_group_ids", g.GetFullJoinGroupIDs)
+		groupRouterGroup.POST("/create_group_invite", g.CreateGroupInvite)
+		groupRouterGroup.POST("/get_group_invites", g.GetGroupInvites)
+		groupRouterGroup.POST("/revoke_group_invite", g.RevokeGroupInvite)
+		groupRouterGroup.POST("/get_group_invite_info", g.GetGroupInviteInfo)
+		groupRouterGroup.POST("/join_group_by_invite", g.JoinGroupByInvite)
+		groupRouterGroup.POST("/publish_group_announcement", g.PublishGroupAnnouncement)
+		groupRouterGroup.POST("/get_group_announcements", g.GetGroupAnnouncements)
+		groupRouterGroup.POST("/confirm_group_announcement", g.ConfirmGroupAnnouncement)
+		groupRouterGroup.POST("/get_group_announcement_confirms", g.GetGroupAnnouncementConfirms)
+		groupRouterGroup.POST("/remind_group_announcement", g.RemindGroupAnnouncement)
+		groupRouterGroup.POST("/set_group_member_limit", g.SetGroupMemberLimit)
+		groupRouterGroup.POST("/get_group_member_limit", g.GetGroupMemberLimit)
+		groupRouterGroup.POST("/import_group_members", g.ImportGroupMembers)
+		groupRouterGroup.POST("/get_group_import_job", g.GetGroupImportJob)
+		groupRouterGroup.POST("/export_group_members", g.ExportGroupMembers)
 	}
 	// certificate
 	{
@@ -240,6 +264,21 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, co
 		msgGroup.POST("/delete_msgs", m.DeleteMsgs)
 		msgGroup.POST("/delete_msg_phsical_by_seq", m.DeleteMsgPhysicalBySeq)
 		msgGroup.POST("/delete_msg_physical", m.DeleteMsgPhysical)
+		msgGroup.POST("/set_msg_retention_policy", m.SetMsgRetentionPolicy)
+		msgGroup.POST("/delete_msg_retention_policies", m.DeleteMsgRetentionPolicies)
+		msgGroup.POST("/get_msg_retention_policies", m.GetMsgRetentionPolicies)
+		msgGroup.POST("/set_legal_hold", m.SetLegalHold)
+		msgGroup.POST("/remove_legal_hold", m.RemoveLegalHold)
+		msgGroup.POST("/get_legal_holds", m.GetLegalHolds)
+		msgGroup.POST("/start_compliance_export", m.StartComplianceExport)
+		msgGroup.POST("/get_compliance_export", m.GetComplianceExport)
+		msgGroup.POST("/add_favorite", m.AddFavorite)
+		msgGroup.POST("/delete_favorites", m.DeleteFavorites)
+		msgGroup.POST("/update_favorite", m.UpdateFavorite)
+		msgGroup.POST("/search_favorites", m.SearchFavorites)
+		msgGroup.POST("/get_incremental_favorites", m.GetIncrementalFavorites)
+		msgGroup.POST("/get_full_favorite_ids", m.GetFullFavoriteIDs)
+		msgGroup.POST("/forward_msgs", m.ForwardMsgs)
 
 		msgGroup.POST("/batch_send_msg", m.BatchSendMsg)
 		msgGroup.POST("/check_msg_is_send_success", m.CheckMsgIsSendSuccess)
@@ -261,6 +300,12 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, co
 		conversationGroup.POST("/get_not_notify_conversation_ids", c.GetNotNotifyConversationIDs)
 		conversationGroup.POST("/get_pinned_conversation_ids", c.GetPinnedConversationIDs)
 		conversationGroup.POST("/get_conversation_read_cursors", c.GetConversationReadCursors)
+		conversationGroup.POST("/create_folder", c.CreateConversationFolder)
+		conversationGroup.POST("/update_folder", c.UpdateConversationFolder)
+		conversationGroup.POST("/delete_folder", c.DeleteConversationFolder)
+		conversationGroup.POST("/get_folders", c.GetConversationFolders)
+		conversationGroup.POST("/add_conversations_to_folder", c.AddConversationsToFolder)
+		conversationGroup.POST("/remove_conversations_from_folder", c.RemoveConversationsFromFolder)
 	}
 
 	{
@@ -53,6 +53,14 @@ func (u *UserApi) SetGlobalRecvMessageOpt(c *gin.Context) {
 	a2r.Call(c, user.UserClient.SetGlobalRecvMessageOpt, u.Client)
 }
 
+func (u *UserApi) SetLastSeenPrivacy(c *gin.Context) {
+	a2r.Call(c, user.UserClient.SetLastSeenPrivacy, u.Client)
+}
+
+func (u *UserApi) GetLastSeenPrivacy(c *gin.Context) {
+	a2r.Call(c, user.UserClient.GetLastSeenPrivacy, u.Client)
+}
+
 func (u *UserApi) GetUsersPublicInfo(c *gin.Context) {
 	a2r.Call(c, user.UserClient.GetDesignateUsers, u.Client)
 }
@@ -24,6 +24,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
@@ -80,6 +81,9 @@ type Client struct {
 	hbCancel       context.CancelFunc
 	subLock        *sync.Mutex
 	subUserIDs     map[string]struct{} // client conn subscription list
+	pushWindow     *pushWindow         // unacknowledged pushes, nil unless the client acks them
+	out            *outbound           // frames waiting for the writer goroutine
+	resume         *resumeState        // resumable session, nil unless the client can resume
 }
 
 // ResetClient updates the client's state with new connection and context information.
@@ -108,6 +112,15 @@ func (c *Client) ResetClient(ctx *UserConnContext, conn LongConn, longConnServer
 		c.Encoder = NewJsonEncoder()
 	}
 	c.subUserIDs = make(map[string]struct{})
+	c.pushWindow = nil
+	c.out = nil
+	c.resume = nil
+}
+
+// enablePushAck makes pushes to the client tracked until acknowledged, with retransmission.
+func (c *Client) enablePushAck(size int, timeout time.Duration, maxRetransmit int) {
+	c.pushWindow = newPushWindow(size, timeout, maxRetransmit)
+	go c.retransmit(c.hbCtx, c.pushWindow)
 }
 
 func (c *Client) pingHandler(appData string) error {
@@ -141,6 +154,9 @@ func (c *Client) readMessage() {
 	c.conn.SetPongHandler(c.pongHandler)
 	c.conn.SetPingHandler(c.pingHandler)
 	c.activeHeartbeat(c.hbCtx)
+	if c.out != nil {
+		go c.writeLoop(c.hbCtx, c.out)
+	}
 
 	for {
 		log.ZDebug(c.ctx, "readMessage")
@@ -179,6 +195,7 @@ func (c *Client) readMessage() {
 
 		case CloseMessage:
 			c.closedErr = ErrClientClosed
+			c.resume.drop()
 			return
 
 		default:
@@ -244,6 +261,9 @@ func (c *Client) handleMessage(message []byte) error {
 		resp, messageErr = c.setAppBackgroundStatus(ctx, binaryReq)
 	case WsSubUserOnlineStatus:
 		resp, messageErr = c.longConnServer.SubUserOnlineStatus(ctx, c, binaryReq)
+	case WSPushMsgAck:
+		// acks get no reply
+		return c.ackPush(ctx, binaryReq)
 	default:
 		return fmt.Errorf(
 			"ReqIdentifier failed,sendID:%s,msgIncr:%s,reqIdentifier:%d",
@@ -262,7 +282,7 @@ func (c *Client) setAppBackgroundStatus(ctx context.Context, req *Req) ([]byte,
 		return nil, messageErr
 	}
 
-	c.IsBackground = isBackground
+	c.longConnServer.SetClientBackground(c, isBackground)
 	// TODO: callback
 	return resp, nil
 }
@@ -276,6 +296,7 @@ func (c *Client) close() {
 	c.closed.Store(true)
 	c.conn.Close()
 	c.hbCancel() // Close server-initiated heartbeat.
+	c.resume.hold(c)
 	c.longConnServer.UnRegister(c)
 }
 
@@ -291,19 +312,35 @@ func (c *Client) replyMessage(ctx context.Context, binaryReq *Req, err error, re
 	}
 	t := time.Now()
 	log.ZDebug(ctx, "gateway reply message", "resp", mReply.String())
-	err = c.writeBinaryMsg(mReply)
+	err = c.writeReply(mReply)
 	if err != nil {
 		log.ZWarn(ctx, "wireBinaryMsg replyMessage", err, "resp", mReply.String())
 	}
 	log.ZDebug(ctx, "wireBinaryMsg end", "time cost", time.Since(t))
 
 	if binaryReq.ReqIdentifier == WsLogoutMsg {
+		c.resume.drop()
 		return errs.New("user logout", "operationID", binaryReq.OperationID).Wrap()
 	}
 	return nil
 }
 
-func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error {
+func (c *Client) ackPush(ctx context.Context, req *Req) error {
+	if c.pushWindow == nil {
+		return nil
+	}
+	var ack sdkws.PushMsgAck
+	if err := proto.Unmarshal(req.Data, &ack); err != nil {
+		log.ZWarn(ctx, "unmarshal push ack failed", err)
+		return nil
+	}
+	c.pushWindow.ack(&ack)
+	return nil
+}
+
+// PushMessage writes the message to the client. For a client in ack mode, the message is kept
+// until the client acknowledges it, followed by watch if not nil, and true is returned.
+func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData, groupSize string, watch *pushAckWatch) (bool, error) {
 	var msg sdkws.PushMessages
 	conversationID := msgprocessor.GetConversationIDByMsg(msgData)
 	m := map[string]*sdkws.PullMsgs{conversationID: {Msgs: []*sdkws.MsgData{msgData}}}
@@ -315,14 +352,34 @@ func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error
 	log.ZDebug(ctx, "PushMessage", "msg", &msg)
 	data, err := proto.Marshal(&msg)
 	if err != nil {
-		return err
+		return false, err
 	}
 	resp := Resp{
 		ReqIdentifier: WSPushMsg,
 		OperationID:   mcontext.GetOperationID(ctx),
 		Data:          data,
 	}
-	return c.writeBinaryMsg(resp)
+	// messages without seq are online only, the client could not tell a lost one anyway
+	frame := &outboundFrame{resp: resp, push: &msg, conversationID: conversationID, groupSize: groupSize}
+	if c.closed.Load() && c.resume.keep(missedFrame{msg: msgData, groupSize: groupSize}) {
+		return false, errSessionParked
+	}
+	if c.pushWindow == nil || msgData.Seq == 0 {
+		return false, c.writePush(frame)
+	}
+	key := pushKey{conversationID: conversationID, seq: msgData.Seq}
+	if !c.pushWindow.add(key, resp, watch) {
+		log.ZWarn(ctx, "push window full or closed", nil, "userID", c.UserID, "platformID", c.PlatformID)
+		if err := c.writePush(frame); err != nil {
+			return false, err
+		}
+		return false, servererrs.ErrPushAckTimeout.WrapMsg("push window full")
+	}
+	if err := c.writePush(frame); err != nil {
+		c.pushWindow.remove(key)
+		return false, err
+	}
+	return true, nil
 }
 
 func (c *Client) KickOnlineMessage() error {
@@ -330,9 +387,19 @@ func (c *Client) KickOnlineMessage() error {
 		ReqIdentifier: WSKickOnlineMsg,
 	}
 	log.ZDebug(c.ctx, "KickOnlineMessage debug ")
-	err := c.writeBinaryMsg(resp)
-	c.close()
-	return err
+	c.resume.drop()
+	if c.out == nil {
+		err := c.writeFrame(resp)
+		c.close()
+		return err
+	}
+	// closed by the writer once the kick is written, or right away if it cannot be queued
+	select {
+	case c.out.queue <- &outboundFrame{resp: resp, closeAfter: true}:
+	default:
+		c.close()
+	}
+	return nil
 }
 
 func (c *Client) PushUserOnlineStatus(data []byte) error {
@@ -340,13 +407,48 @@ func (c *Client) PushUserOnlineStatus(data []byte) error {
 		ReqIdentifier: WsSubUserOnlineStatus,
 		Data:          data,
 	}
+	if c.closed.Load() && c.resume.keep(missedFrame{resp: resp}) {
+		return nil
+	}
 	return c.writeBinaryMsg(resp)
 }
 
+// writeBinaryMsg queues resp for the writer goroutine without waiting.
 func (c *Client) writeBinaryMsg(resp Resp) error {
 	if c.closed.Load() {
 		return nil
 	}
+	if c.out == nil {
+		return c.writeFrame(resp)
+	}
+	return c.enqueue(&outboundFrame{resp: resp})
+}
+
+func (c *Client) writePush(frame *outboundFrame) error {
+	if c.closed.Load() {
+		return nil
+	}
+	if c.out == nil {
+		return c.writeFrame(frame.resp)
+	}
+	return c.enqueue(frame)
+}
+
+// writeReply queues resp, waiting while the queue of the client is full.
+func (c *Client) writeReply(resp Resp) error {
+	if c.closed.Load() {
+		return nil
+	}
+	if c.out == nil {
+		return c.writeFrame(resp)
+	}
+	return c.enqueueWait(&outboundFrame{resp: resp})
+}
+
+func (c *Client) writeFrame(resp Resp) error {
+	if c.closed.Load() {
+		return nil
+	}
 
 	encodedBuf, err := c.Encoder.Encode(resp)
 	if err != nil {
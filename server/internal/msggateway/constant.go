@@ -28,6 +28,9 @@ const (
 	BackgroundStatus        = "isBackground"
 	SendResponse            = "isMsgResp"
 	SDKType                 = "sdkType"
+	PushAck                 = "pushAck"
+	SessionResume           = "sessionResume"
+	ResumeToken             = "resumeToken"
 )
 
 const (
@@ -48,11 +51,14 @@ const (
 	WSPullMsg             = 1005
 	WSGetConvMaxReadSeq   = 1006
 	WsPullConvLastMessage = 1007
+	WSPushMsgAck          = 1008
 	WSPushMsg             = 2001
 	WSKickOnlineMsg       = 2002
 	WsLogoutMsg           = 2003
 	WsSetBackgroundStatus = 2004
 	WsSubUserOnlineStatus = 2005
+	WSResyncMsg           = 2006
+	WSSessionResume       = 2007
 	WSDataError           = 3001
 )
 
@@ -185,6 +185,30 @@ func (c *UserConnContext) GetBackground() bool {
 	}
 	return b
 }
+
+// GetPushAck reports whether the client acknowledges pushed messages.
+func (c *UserConnContext) GetPushAck() bool {
+	b, err := strconv.ParseBool(c.Req.URL.Query().Get(PushAck))
+	if err != nil {
+		return false
+	}
+	return b
+}
+
+// GetSessionResume reports whether the client can resume its session on reconnect.
+func (c *UserConnContext) GetSessionResume() bool {
+	b, err := strconv.ParseBool(c.Req.URL.Query().Get(SessionResume))
+	if err != nil {
+		return false
+	}
+	return b
+}
+
+// GetResumeToken returns the resume token of the session the client reconnects to, if any.
+func (c *UserConnContext) GetResumeToken() string {
+	return c.Req.URL.Query().Get(ResumeToken)
+}
+
 func (c *UserConnContext) ParseEssentialArgs() error {
 	_, exists := c.Query(Token)
 	if !exists {
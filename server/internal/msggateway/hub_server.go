@@ -16,15 +16,19 @@ package msggateway
 
 import (
 	"context"
+	"errors"
 	"sync/atomic"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/startrpc"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
+	pbpush "github.com/openimsdk/protocol/push"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
@@ -32,6 +36,8 @@ import (
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mq/memamq"
 	"github.com/openimsdk/tools/utils/datautil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 )
 
@@ -41,6 +47,11 @@ func (s *Server) InitServer(ctx context.Context, config *Config, disCov discover
 		return err
 	}
 	s.userClient = rpcli.NewUserClient(userConn)
+	pushConn, err := disCov.GetConn(ctx, config.Share.RpcRegisterName.Push)
+	if err != nil {
+		return err
+	}
+	s.pushClient = rpcli.NewPushMsgServiceClient(pushConn)
 	if err := s.LongConnServer.SetDiscoveryRegistry(ctx, disCov, config); err != nil {
 		return err
 	}
@@ -74,7 +85,9 @@ type Server struct {
 	pushTerminal   map[int]struct{}
 	ready          func(srv *Server) error
 	queue          *memamq.MemoryQueue
+	ackQueue       *memamq.MemoryQueue // reports of unacknowledged pushes, apart from the pushes
 	userClient     *rpcli.UserClient
+	pushClient     *rpcli.PushMsgServiceClient
 }
 
 func (s *Server) SetLongConnServer(LongConnServer LongConnServer) {
@@ -88,6 +101,7 @@ func NewServer(longConnServer LongConnServer, conf *Config, ready func(srv *Serv
 		config:         conf,
 		ready:          ready,
 		queue:          memamq.NewMemoryQueue(512, 1024*16),
+		ackQueue:       memamq.NewMemoryQueue(16, 1024*16),
 	}
 	s.pushTerminal[constant.IOSPlatformID] = struct{}{}
 	s.pushTerminal[constant.AndroidPlatformID] = struct{}{}
@@ -127,8 +141,11 @@ func (s *Server) GetUsersOnlineStatus(ctx context.Context, req *msggateway.GetUs
 	return &resp, nil
 }
 
-func (s *Server) pushToUser(ctx context.Context, userID string, msgData *sdkws.MsgData) *msggateway.SingleMsgToUserResults {
+func (s *Server) pushToUser(ctx context.Context, userID string, msgData *sdkws.MsgData, groupSize string) *msggateway.SingleMsgToUserResults {
+	ctx, span := tracing.Start(ctx, "msggateway.push", trace.WithAttributes(attribute.String("openim.user_id", userID)))
+	defer span.End()
 	clients, ok := s.LongConnServer.GetUserAllCons(userID)
+	span.SetAttributes(attribute.Int("openim.conn_count", len(clients)))
 	if !ok {
 		log.ZDebug(ctx, "push user not online", "userID", userID)
 		return &msggateway.SingleMsgToUserResults{
@@ -140,6 +157,9 @@ func (s *Server) pushToUser(ctx context.Context, userID string, msgData *sdkws.M
 		UserID: userID,
 		Resp:   make([]*msggateway.SingleMsgToUserPlatform, 0, len(clients)),
 	}
+	// clients in ack mode count as reached once the push is written; if none of them acks it,
+	// the push service is asked for the offline push then, without holding up this push
+	watch := newPushAckWatch(func() { s.reportUnacked(ctx, userID, msgData) })
 	for _, client := range clients {
 		if client == nil {
 			continue
@@ -149,34 +169,71 @@ func (s *Server) pushToUser(ctx context.Context, userID string, msgData *sdkws.M
 		}
 		if !client.IsBackground ||
 			(client.IsBackground && client.PlatformID != constant.IOSPlatformID) {
-			err := client.PushMessage(ctx, msgData)
-			if err != nil {
+			_, pushTerminal := s.pushTerminal[client.PlatformID]
+			clientWatch := watch
+			if !pushTerminal {
+				clientWatch = nil
+			}
+			tracked, err := client.PushMessage(ctx, msgData, groupSize, clientWatch)
+			if errors.Is(err, errSessionParked) {
+				// kept for the client to get when it resumes, it is not reached until then
+				log.ZDebug(ctx, "push kept for parked session", "userID", userID, "platformID", client.PlatformID)
+			} else if err != nil {
 				log.ZWarn(ctx, "online push msg failed", err, "userID", userID, "platformID", client.PlatformID)
 				userPlatform.ResultCode = int64(servererrs.ErrPushMsgErr.Code())
-			} else {
-				if _, ok := s.pushTerminal[client.PlatformID]; ok {
-					result.OnlinePush = true
-				}
+			} else if pushTerminal && !tracked {
+				result.OnlinePush = true
 			}
 		} else {
 			userPlatform.ResultCode = int64(servererrs.ErrIOSBackgroundPushErr.Code())
 		}
 		result.Resp = append(result.Resp, userPlatform)
 	}
+	if result.OnlinePush {
+		// reached without ack mode, no offline push is needed whatever the acks
+		watch.ack()
+	} else if watch.reached() {
+		result.OnlinePush = true
+	}
 	return result
 }
 
+// reportUnacked asks the push service for the offline push of a message that no client of the
+// user in ack mode acknowledged, after pushToUser reported the user reached.
+func (s *Server) reportUnacked(ctx context.Context, userID string, msgData *sdkws.MsgData) {
+	ctx = context.WithoutCancel(ctx)
+	log.ZWarn(ctx, "online push not acknowledged", nil, "userID", userID, "seq", msgData.Seq)
+	if s.pushClient == nil {
+		return
+	}
+	err := s.ackQueue.NotWaitPush(func() {
+		req := &pbpush.PushUnackedOfflineReq{MsgData: msgData, UserIDs: []string{userID}}
+		if _, err := s.pushClient.PushUnackedOffline(ctx, req); err != nil {
+			log.ZWarn(ctx, "report unacknowledged push failed", err, "userID", userID, "seq", msgData.Seq)
+		}
+	})
+	if err != nil {
+		log.ZWarn(ctx, "report unacknowledged push dropped", err, "userID", userID, "seq", msgData.Seq)
+	}
+}
+
 func (s *Server) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, req *msggateway.OnlineBatchPushOneMsgReq) (*msggateway.OnlineBatchPushOneMsgResp, error) {
 	if len(req.PushToUserIDs) == 0 {
 		return &msggateway.OnlineBatchPushOneMsgResp{}, nil
 	}
 	ch := make(chan *msggateway.SingleMsgToUserResults, len(req.PushToUserIDs))
+	receivers := int(req.ReceiverCount)
+	if receivers == 0 {
+		// from a push service not sending the count yet
+		receivers = len(req.PushToUserIDs)
+	}
+	groupSize := prommetrics.GroupSizeLabel(receivers)
 	var count atomic.Int64
 	count.Add(int64(len(req.PushToUserIDs)))
 	for i := range req.PushToUserIDs {
 		userID := req.PushToUserIDs[i]
 		err := s.queue.PushCtx(ctx, func() {
-			ch <- s.pushToUser(ctx, userID, req.MsgData)
+			ch <- s.pushToUser(ctx, userID, req.MsgData, groupSize)
 			if count.Add(-1) == 0 {
 				close(ch)
 			}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/log"
@@ -53,6 +54,7 @@ func Start(ctx context.Context, index int, conf *Config) error {
 		WithHandshakeTimeout(time.Duration(conf.MsgGateway.LongConnSvr.WebsocketTimeout)*time.Second),
 		WithMessageMaxMsgLength(conf.MsgGateway.LongConnSvr.WebsocketMaxMsgLen),
 	)
+	longServer.onlineGateway = redis.NewOnlineGateway(rdb)
 
 	hubServer := NewServer(longServer, conf, func(srv *Server) error {
 		var err error
@@ -37,9 +37,10 @@ func (ws *WsServer) ChangeOnlineStatus(concurrent int) {
 
 	local2pb := func(u UserState) *pbuser.UserOnlineStatus {
 		return &pbuser.UserOnlineStatus{
-			UserID:  u.UserID,
-			Online:  u.Online,
-			Offline: u.Offline,
+			UserID:     u.UserID,
+			Online:     u.Online,
+			Offline:    u.Offline,
+			Background: u.Background,
 		}
 	}
 
@@ -90,6 +91,7 @@ func (ws *WsServer) ChangeOnlineStatus(concurrent int) {
 		if err := ws.userClient.SetUserOnlineStatus(ctx, req); err != nil {
 			log.ZError(ctx, "update user online status", err)
 		}
+		ws.setUsersGateway(ctx, req)
 		for _, ss := range req.Status {
 			for _, online := range ss.Online {
 				client, _, _ := ws.clients.Get(ss.UserID, int(online))
@@ -128,3 +130,26 @@ func (ws *WsServer) ChangeOnlineStatus(concurrent int) {
 		}
 	}
 }
+
+// setUsersGateway records this gateway as the push route of the users still connected to it,
+// and removes it for the users with no connection left.
+func (ws *WsServer) setUsersGateway(ctx context.Context, req *pbuser.SetUserOnlineStatusReq) {
+	if ws.onlineGateway == nil || ws.disCov == nil {
+		return
+	}
+	self, ok := ws.disCov.(interface{ GetSelfConnTarget() string })
+	if !ok || self.GetSelfConnTarget() == "" {
+		return
+	}
+	var online, offline []string
+	for _, ss := range req.Status {
+		if _, ok := ws.clients.GetAll(ss.UserID); ok {
+			online = append(online, ss.UserID)
+		} else {
+			offline = append(offline, ss.UserID)
+		}
+	}
+	if err := ws.onlineGateway.SetUsersGateway(ctx, self.GetSelfConnTarget(), online, offline); err != nil {
+		log.ZError(ctx, "set users gateway", err)
+	}
+}
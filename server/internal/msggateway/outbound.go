@@ -0,0 +1,258 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// OutboundFullResync drops the frame and asks the client to sync once its queue drains.
+	OutboundFullResync = "resync"
+	// OutboundFullDisconnect closes the connection.
+	OutboundFullDisconnect = "disconnect"
+
+	defaultOutboundQueueSize = 256
+
+	// limits of one coalesced push frame, well below the read limit of the SDK
+	maxCoalesceMsgs  = 64
+	maxCoalesceBytes = 256 * 1024
+)
+
+var (
+	ErrOutboundQueueFull = errs.New("outbound queue full")
+	ErrSlowConsumer      = errs.New("connection too slow to keep up with its outbound queue")
+)
+
+type outboundFrame struct {
+	resp Resp
+	// push and conversationID are set for a message push, so that the writer can merge the
+	// pushes of one conversation queued back to back into a single frame
+	push           *sdkws.PushMessages
+	conversationID string
+	// groupSize is the group_size label of the delivery latency of the push
+	groupSize string
+	// closeAfter closes the connection once the frame is written
+	closeAfter bool
+}
+
+// outbound is the queue between the goroutines writing to a client and its writer goroutine.
+type outbound struct {
+	queue      chan *outboundFrame
+	fullPolicy string
+	resync     chan struct{}
+}
+
+func newOutbound(size int, fullPolicy string) *outbound {
+	if size <= 0 {
+		size = defaultOutboundQueueSize
+	}
+	if fullPolicy != OutboundFullDisconnect {
+		fullPolicy = OutboundFullResync
+	}
+	return &outbound{
+		queue:      make(chan *outboundFrame, size),
+		fullPolicy: fullPolicy,
+		resync:     make(chan struct{}, 1),
+	}
+}
+
+// enqueue queues the frame without waiting, and applies the full policy when it cannot.
+func (c *Client) enqueue(frame *outboundFrame) error {
+	out := c.out
+	prommetrics.OutboundQueueDepthHistogram.Observe(float64(len(out.queue)))
+	select {
+	case out.queue <- frame:
+		return nil
+	default:
+	}
+	if out.fullPolicy == OutboundFullDisconnect {
+		c.closeSlowConsumer()
+		return ErrSlowConsumer
+	}
+	prommetrics.OutboundDroppedCounter.Inc()
+	select {
+	case out.resync <- struct{}{}:
+		log.ZWarn(c.ctx, "outbound queue full, drop frames until it drains", nil, "userID", c.UserID, "platformID", c.PlatformID)
+	default:
+	}
+	return ErrOutboundQueueFull
+}
+
+// enqueueWait queues a frame the client is waiting for, like the reply to its request. It is
+// called from the reading goroutine of the client, so waiting holds up nobody else.
+func (c *Client) enqueueWait(frame *outboundFrame) error {
+	out := c.out
+	prommetrics.OutboundQueueDepthHistogram.Observe(float64(len(out.queue)))
+	timer := time.NewTimer(writeWait)
+	defer timer.Stop()
+	select {
+	case out.queue <- frame:
+		return nil
+	case <-c.hbCtx.Done():
+		return ErrConnClosed
+	case <-timer.C:
+		c.closeSlowConsumer()
+		return ErrSlowConsumer
+	}
+}
+
+func (c *Client) closeSlowConsumer() {
+	log.ZWarn(c.ctx, "close slow connection", ErrSlowConsumer, "userID", c.UserID, "platformID", c.PlatformID)
+	prommetrics.SlowConsumerDisconnectCounter.Inc()
+	c.closedErr = ErrSlowConsumer
+	// close takes the write lock, which the writer may hold for writeWait on this connection
+	go c.close()
+}
+
+// writeLoop writes the queued frames until the connection closes. It is the only goroutine
+// writing data frames, pings and pongs still take the write lock directly.
+func (c *Client) writeLoop(ctx context.Context, out *outbound) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.ZPanic(ctx, "writeLoop panic", errs.ErrPanic(r))
+		}
+	}()
+	var next *outboundFrame
+	for {
+		frame := next
+		next = nil
+		if frame == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case frame = <-out.queue:
+			}
+		}
+		if frame.push != nil {
+			var err error
+			frame, next, err = out.coalesce(frame)
+			if err != nil {
+				log.ZError(ctx, "coalesce push failed", err)
+				c.closedErr = err
+				c.close()
+				return
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		start := time.Now()
+		if err := c.writeFrame(frame.resp); err != nil {
+			log.ZWarn(ctx, "write frame failed", err, "reqIdentifier", frame.resp.ReqIdentifier)
+			c.closedErr = err
+			c.close()
+			return
+		}
+		prommetrics.GatewayWriteDurationHistogram.Observe(time.Since(start).Seconds())
+		if frame.push != nil {
+			observeDelivery(frame.push, frame.groupSize)
+		}
+		if frame.closeAfter {
+			c.close()
+			return
+		}
+		if next == nil && len(out.queue) == 0 {
+			select {
+			case <-out.resync:
+				if err := c.writeFrame(Resp{ReqIdentifier: WSResyncMsg}); err != nil {
+					log.ZWarn(ctx, "write resync failed", err)
+				}
+			default:
+			}
+		}
+	}
+}
+
+// coalesce merges into frame the pushes of the same conversation queued right behind it. It
+// returns the merged frame, and the frame taken from the queue that could not be merged.
+func (o *outbound) coalesce(frame *outboundFrame) (*outboundFrame, *outboundFrame, error) {
+	var (
+		merged *sdkws.PushMessages
+		num    = 1
+		size   = len(frame.resp.Data)
+		next   *outboundFrame
+	)
+	for num < maxCoalesceMsgs && next == nil {
+		select {
+		case f := <-o.queue:
+			if f.push == nil || f.conversationID != frame.conversationID || size+len(f.resp.Data) > maxCoalesceBytes {
+				next = f
+				continue
+			}
+			if merged == nil {
+				merged = frame.push
+			}
+			mergePushMessages(merged, f.push)
+			num++
+			size += len(f.resp.Data)
+		default:
+			num = maxCoalesceMsgs
+		}
+	}
+	if merged == nil {
+		return frame, next, nil
+	}
+	data, err := proto.Marshal(merged)
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	frame.resp.Data = data
+	return frame, next, nil
+}
+
+// observeDelivery records the time from the send time of the pushed messages until now.
+func observeDelivery(push *sdkws.PushMessages, groupSize string) {
+	now := time.Now().UnixMilli()
+	observe := func(pullMsgs map[string]*sdkws.PullMsgs) {
+		for _, msgs := range pullMsgs {
+			for _, msg := range msgs.Msgs {
+				if msg.SendTime <= 0 {
+					continue
+				}
+				latency := max(now-msg.SendTime, 0)
+				prommetrics.MsgDeliveryLatencyHistogram.WithLabelValues(prommetrics.SessionTypeLabel(msg.SessionType), groupSize).
+					Observe(float64(latency) / 1000)
+			}
+		}
+	}
+	observe(push.Msgs)
+	observe(push.NotificationMsgs)
+}
+
+func mergePushMessages(dst *sdkws.PushMessages, src *sdkws.PushMessages) {
+	merge := func(dst map[string]*sdkws.PullMsgs, src map[string]*sdkws.PullMsgs) map[string]*sdkws.PullMsgs {
+		for conversationID, msgs := range src {
+			if dst == nil {
+				dst = make(map[string]*sdkws.PullMsgs)
+			}
+			if d, ok := dst[conversationID]; ok {
+				d.Msgs = append(d.Msgs, msgs.Msgs...)
+			} else {
+				dst[conversationID] = &sdkws.PullMsgs{Msgs: msgs.Msgs}
+			}
+		}
+		return dst
+	}
+	dst.Msgs = merge(dst.Msgs, src.Msgs)
+	dst.NotificationMsgs = merge(dst.NotificationMsgs, src.NotificationMsgs)
+}
@@ -0,0 +1,49 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func pushFrame(conversationID string, seq int64) *outboundFrame {
+	msg := &sdkws.PushMessages{Msgs: map[string]*sdkws.PullMsgs{conversationID: {Msgs: []*sdkws.MsgData{{Seq: seq}}}}}
+	data, _ := proto.Marshal(msg)
+	return &outboundFrame{resp: Resp{ReqIdentifier: WSPushMsg, Data: data}, push: msg, conversationID: conversationID}
+}
+
+func TestOutboundCoalesce(t *testing.T) {
+	out := newOutbound(8, "")
+	out.queue <- pushFrame("sg_1", 2)
+	out.queue <- pushFrame("sg_1", 3)
+	out.queue <- pushFrame("sg_2", 1)
+	out.queue <- pushFrame("sg_1", 4)
+
+	frame, next, err := out.coalesce(pushFrame("sg_1", 1))
+	assert.NoError(t, err)
+	var msg sdkws.PushMessages
+	assert.NoError(t, proto.Unmarshal(frame.resp.Data, &msg))
+	var seqs []int64
+	for _, m := range msg.Msgs["sg_1"].Msgs {
+		seqs = append(seqs, m.Seq)
+	}
+	assert.Equal(t, []int64{1, 2, 3}, seqs)
+	assert.Equal(t, "sg_2", next.conversationID, "another conversation is not merged")
+	assert.Len(t, out.queue, 1)
+}
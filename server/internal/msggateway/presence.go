@@ -0,0 +1,140 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+)
+
+// presenceDebounce holds back the status changes taking platforms of a user offline for a grace
+// period, so that a connection dropping and coming back on an unstable network does not reach the
+// subscribers. Platforms coming online are notified at once.
+type presenceDebounce struct {
+	grace  time.Duration
+	notify func(ctx context.Context, userID string, platformIDs []int32)
+
+	lock    sync.Mutex
+	last    map[string][]int32
+	pending map[string]*pendingPresence
+}
+
+type pendingPresence struct {
+	ctx         context.Context
+	platformIDs []int32
+	timer       *time.Timer
+}
+
+func newPresenceDebounce(grace time.Duration, notify func(ctx context.Context, userID string, platformIDs []int32)) *presenceDebounce {
+	return &presenceDebounce{
+		grace:   grace,
+		notify:  notify,
+		last:    make(map[string][]int32),
+		pending: make(map[string]*pendingPresence),
+	}
+}
+
+// change reports the online platforms of the user. Notifications are made under the lock, so the
+// subscribers see the changes of a user in order.
+func (p *presenceDebounce) change(ctx context.Context, userID string, platformIDs []int32) {
+	if p.grace <= 0 {
+		p.notify(ctx, userID, platformIDs)
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	last, known := p.last[userID]
+	// a platform coming online is notified at once, even while others are going offline
+	gained := !containsPlatforms(last, platformIDs)
+	if pending, ok := p.pending[userID]; ok {
+		pending.ctx = ctx
+		pending.platformIDs = platformIDs
+		if !gained && !containsPlatforms(platformIDs, last) {
+			return
+		}
+		pending.timer.Stop()
+		delete(p.pending, userID)
+		if samePlatforms(platformIDs, last) {
+			log.ZDebug(ctx, "user online status flap suppressed", "userID", userID, "platformIDs", platformIDs)
+			return
+		}
+		p.setLast(ctx, userID, platformIDs)
+		return
+	}
+	if known && containsPlatforms(platformIDs, last) || gained {
+		p.setLast(ctx, userID, platformIDs)
+		return
+	}
+	pending := &pendingPresence{ctx: ctx, platformIDs: platformIDs}
+	pending.timer = time.AfterFunc(p.grace, func() { p.expire(userID, pending) })
+	p.pending[userID] = pending
+}
+
+// forget drops what is known of the user, once no client subscribes to it any more.
+func (p *presenceDebounce) forget(userID string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if pending, ok := p.pending[userID]; ok {
+		pending.timer.Stop()
+		delete(p.pending, userID)
+	}
+	delete(p.last, userID)
+}
+
+func (p *presenceDebounce) expire(userID string, pending *pendingPresence) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.pending[userID] != pending {
+		return
+	}
+	delete(p.pending, userID)
+	if last, ok := p.last[userID]; ok && samePlatforms(pending.platformIDs, last) {
+		return
+	}
+	p.setLast(pending.ctx, userID, pending.platformIDs)
+}
+
+func (p *presenceDebounce) setLast(ctx context.Context, userID string, platformIDs []int32) {
+	if len(platformIDs) == 0 {
+		delete(p.last, userID)
+	} else {
+		p.last[userID] = append([]int32(nil), platformIDs...)
+	}
+	p.notify(ctx, userID, platformIDs)
+}
+
+// containsPlatforms reports whether every platform of sub is in platformIDs.
+func containsPlatforms(platformIDs []int32, sub []int32) bool {
+	for _, s := range sub {
+		found := false
+		for _, platformID := range platformIDs {
+			if platformID == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func samePlatforms(a []int32, b []int32) bool {
+	return containsPlatforms(a, b) && containsPlatforms(b, a)
+}
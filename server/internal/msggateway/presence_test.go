@@ -0,0 +1,71 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresenceDebounce(t *testing.T) {
+	var (
+		lock     sync.Mutex
+		notified [][]int32
+	)
+	p := newPresenceDebounce(50*time.Millisecond, func(ctx context.Context, userID string, platformIDs []int32) {
+		lock.Lock()
+		defer lock.Unlock()
+		notified = append(notified, platformIDs)
+	})
+	changes := func() [][]int32 {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([][]int32(nil), notified...)
+	}
+	ctx := context.Background()
+
+	p.change(ctx, "u1", []int32{1, 2})
+	assert.Equal(t, [][]int32{{1, 2}}, changes(), "coming online is notified at once")
+
+	p.change(ctx, "u1", []int32{1})
+	p.change(ctx, "u1", []int32{2, 1})
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(t, changes(), 1, "a platform back within the grace period is not notified")
+
+	p.change(ctx, "u1", []int32{1})
+	p.change(ctx, "u1", nil)
+	assert.Len(t, changes(), 1)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, [][]int32{{1, 2}, nil}, changes(), "only the latest state is notified after the grace period")
+
+	p.change(ctx, "u1", nil)
+	p.change(ctx, "u1", []int32{3})
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, [][]int32{{1, 2}, nil, {3}}, changes())
+
+	p.change(ctx, "u1", []int32{3, 4})
+	p.change(ctx, "u1", []int32{4})
+	p.change(ctx, "u1", []int32{4, 5})
+	assert.Equal(t, [][]int32{{1, 2}, nil, {3}, {3, 4}, {4, 5}}, changes(), "a new platform is notified at once while another is going offline")
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(t, changes(), 5)
+
+	p.change(ctx, "u1", []int32{5, 6})
+	assert.Equal(t, [][]int32{{1, 2}, nil, {3}, {3, 4}, {4, 5}, {5, 6}}, changes(), "a platform replaced by another is notified at once")
+}
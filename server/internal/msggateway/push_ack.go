@@ -0,0 +1,292 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"google.golang.org/protobuf/proto"
+)
+
+type pushKey struct {
+	conversationID string
+	seq            int64
+}
+
+type pendingPush struct {
+	resp        Resp
+	sendTime    time.Time
+	retransmits int
+	watches     []*pushAckWatch
+}
+
+// pushAckWatch follows a push to the clients of one user in ack mode. The push counts as
+// reached when it is written; if none of the clients acknowledges it in time, or their
+// connections close first, report is called once so that the offline push is sent then.
+type pushAckWatch struct {
+	lock     sync.Mutex
+	pending  int
+	acked    bool
+	reported bool
+	report   func()
+}
+
+func newPushAckWatch(report func()) *pushAckWatch {
+	return &pushAckWatch{report: report}
+}
+
+func (a *pushAckWatch) add() {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.pending++
+}
+
+// reached reports whether a client acknowledged the push or may still do so.
+func (a *pushAckWatch) reached() bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.acked || (a.pending > 0 && !a.reported)
+}
+
+// ack is called when a client acknowledged the push, or the user was reached otherwise.
+func (a *pushAckWatch) ack() {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.acked = true
+}
+
+// forget drops a client the push could not be written to, the caller reports that failure.
+func (a *pushAckWatch) forget() {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.pending--
+}
+
+// timedOut is called when a client did not acknowledge the push in time.
+func (a *pushAckWatch) timedOut() {
+	a.lock.Lock()
+	a.pending--
+	report := !a.acked && !a.reported && a.pending <= 0
+	if report {
+		a.reported = true
+	}
+	a.lock.Unlock()
+	if report {
+		a.report()
+	}
+}
+
+// pushWindow keeps the messages pushed to a client in ack mode until the client acknowledges
+// them, and retransmits the ones not acknowledged in time. A client that still does not answer
+// after maxRetransmit is taken as a dead connection and closed, so that it reconnects and syncs.
+type pushWindow struct {
+	lock          sync.Mutex
+	pending       map[pushKey]*pendingPush
+	size          int
+	timeout       time.Duration
+	maxRetransmit int
+	closed        bool
+}
+
+func newPushWindow(size int, timeout time.Duration, maxRetransmit int) *pushWindow {
+	if size <= 0 {
+		size = 1024
+	}
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &pushWindow{
+		pending:       make(map[pushKey]*pendingPush),
+		size:          size,
+		timeout:       timeout,
+		maxRetransmit: maxRetransmit,
+	}
+}
+
+// add records a push followed by watch, which may be nil, or returns false when the window is
+// full or its connection closed.
+func (w *pushWindow) add(key pushKey, resp Resp, watch *pushAckWatch) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.closed {
+		return false
+	}
+	p, ok := w.pending[key]
+	if ok {
+		p.resp = resp
+	} else {
+		if len(w.pending) >= w.size {
+			return false
+		}
+		p = &pendingPush{resp: resp, sendTime: time.Now()}
+		w.pending[key] = p
+	}
+	if watch != nil {
+		watch.add()
+		p.watches = append(p.watches, watch)
+	}
+	return true
+}
+
+// remove drops a push that could not be written.
+func (w *pushWindow) remove(key pushKey) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if p, ok := w.pending[key]; ok {
+		for _, watch := range p.watches {
+			watch.forget()
+		}
+		delete(w.pending, key)
+	}
+}
+
+func (w *pushWindow) ack(req *sdkws.PushMsgAck) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	for conversationID, seqs := range req.ConversationSeqs {
+		if seqs == nil {
+			continue
+		}
+		for _, seq := range seqs.Seqs {
+			key := pushKey{conversationID: conversationID, seq: seq}
+			if p, ok := w.pending[key]; ok {
+				for _, watch := range p.watches {
+					watch.ack()
+				}
+				delete(w.pending, key)
+			}
+		}
+	}
+}
+
+// expired returns the pushes to retransmit with the watches of the ones that just missed their
+// first ack timeout, and false if one of them has been retransmitted maxRetransmit times already.
+func (w *pushWindow) expired(now time.Time) ([]Resp, []*pushAckWatch, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	var (
+		resps   []Resp
+		watches []*pushAckWatch
+	)
+	for _, p := range w.pending {
+		if now.Sub(p.sendTime) < w.timeout {
+			continue
+		}
+		watches = append(watches, p.watches...)
+		p.watches = nil
+		if p.retransmits >= w.maxRetransmit {
+			return nil, watches, false
+		}
+		p.retransmits++
+		p.sendTime = now
+		resps = append(resps, p.resp)
+	}
+	return resps, watches, true
+}
+
+// drain returns the watches still waiting for an ack, when the connection closes.
+func (w *pushWindow) drain() []*pushAckWatch {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.closed = true
+	var watches []*pushAckWatch
+	for _, p := range w.pending {
+		watches = append(watches, p.watches...)
+		p.watches = nil
+	}
+	return watches
+}
+
+// pendingMsgs returns the messages not acknowledged yet, by conversation and seq.
+func (w *pushWindow) pendingMsgs() []*sdkws.MsgData {
+	w.lock.Lock()
+	keys := make([]pushKey, 0, len(w.pending))
+	for key := range w.pending {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].conversationID != keys[j].conversationID {
+			return keys[i].conversationID < keys[j].conversationID
+		}
+		return keys[i].seq < keys[j].seq
+	})
+	resps := make([]Resp, 0, len(keys))
+	for _, key := range keys {
+		resps = append(resps, w.pending[key].resp)
+	}
+	w.lock.Unlock()
+	var msgs []*sdkws.MsgData
+	for _, resp := range resps {
+		var push sdkws.PushMessages
+		if err := proto.Unmarshal(resp.Data, &push); err != nil {
+			continue
+		}
+		msgs = append(msgs, pushedMsgs(&push)...)
+	}
+	return msgs
+}
+
+// retransmit runs until the connection closes.
+func (c *Client) retransmit(ctx context.Context, w *pushWindow) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.ZPanic(ctx, "retransmit panic", errs.ErrPanic(r))
+		}
+	}()
+	// pushes left unacknowledged by a closed connection did not reach the client
+	defer func() { timeOutWatches(w.drain()) }()
+	ticker := time.NewTicker(w.timeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			resps, watches, ok := w.expired(now)
+			timeOutWatches(watches)
+			if ctx.Err() != nil {
+				return
+			}
+			if !ok {
+				log.ZWarn(ctx, "push not acknowledged, close the connection", nil, "maxRetransmit", w.maxRetransmit)
+				c.closedErr = servererrs.ErrPushAckTimeout
+				c.close()
+				return
+			}
+			for _, resp := range resps {
+				if err := c.writeBinaryMsg(resp); err != nil {
+					log.ZWarn(ctx, "retransmit push failed", err)
+					break
+				}
+			}
+			if len(resps) > 0 {
+				log.ZDebug(ctx, "retransmit push", "num", len(resps))
+			}
+		}
+	}
+}
+
+func timeOutWatches(watches []*pushAckWatch) {
+	for _, watch := range watches {
+		watch.timedOut()
+	}
+}
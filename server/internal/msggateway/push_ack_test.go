@@ -0,0 +1,116 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushWindow(t *testing.T) {
+	w := newPushWindow(2, time.Second, 1)
+	var reports int
+	first := newPushAckWatch(func() { reports++ })
+	assert.True(t, w.add(pushKey{conversationID: "si_a_b", seq: 1}, Resp{MsgIncr: "1"}, first))
+	second := newPushAckWatch(func() { reports++ })
+	assert.True(t, w.add(pushKey{conversationID: "si_a_b", seq: 2}, Resp{MsgIncr: "2"}, second))
+	assert.False(t, w.add(pushKey{conversationID: "si_a_b", seq: 3}, Resp{MsgIncr: "3"}, nil), "window is full")
+
+	w.ack(&sdkws.PushMsgAck{ConversationSeqs: map[string]*sdkws.Seqs{"si_a_b": {Seqs: []int64{1}}}})
+	assert.True(t, first.reached())
+
+	now := time.Now()
+	resps, watches, ok := w.expired(now)
+	assert.True(t, ok)
+	assert.Empty(t, resps)
+	assert.Empty(t, watches)
+	assert.True(t, second.reached())
+
+	resps, watches, ok = w.expired(now.Add(time.Second))
+	assert.True(t, ok)
+	assert.Len(t, resps, 1)
+	assert.Equal(t, "2", resps[0].MsgIncr)
+	timeOutWatches(watches)
+	assert.Equal(t, 1, reports, "the first timeout reports the push")
+	assert.False(t, second.reached())
+
+	resps, watches, ok = w.expired(now.Add(2 * time.Second))
+	assert.False(t, ok, "retransmitted too many times")
+	assert.Empty(t, watches, "reported once")
+	timeOutWatches(w.drain())
+	assert.Equal(t, 1, reports)
+	assert.False(t, w.add(pushKey{conversationID: "si_a_b", seq: 4}, Resp{}, nil), "window is closed")
+}
+
+func TestPushAckWatch(t *testing.T) {
+	var reports int
+	report := func() { reports++ }
+
+	// two clients in ack mode, one of them acks
+	watch := newPushAckWatch(report)
+	phone, pad := newPushWindow(8, time.Second, 1), newPushWindow(8, time.Second, 1)
+	key := pushKey{conversationID: "si_a_b", seq: 1}
+	assert.True(t, phone.add(key, Resp{}, watch))
+	assert.True(t, pad.add(key, Resp{}, watch))
+	_, watches, _ := phone.expired(time.Now().Add(time.Second))
+	timeOutWatches(watches)
+	assert.True(t, watch.reached(), "the other client may still ack")
+	pad.ack(&sdkws.PushMsgAck{ConversationSeqs: map[string]*sdkws.Seqs{"si_a_b": {Seqs: []int64{1}}}})
+	timeOutWatches(pad.drain())
+	assert.Equal(t, 0, reports)
+
+	// the connection closes before the ack
+	watch = newPushAckWatch(report)
+	w := newPushWindow(8, time.Second, 1)
+	assert.True(t, w.add(key, Resp{}, watch))
+	timeOutWatches(w.drain())
+	assert.Equal(t, 1, reports)
+
+	// the push could not be written, the caller handles it
+	watch = newPushAckWatch(report)
+	w = newPushWindow(8, time.Second, 1)
+	assert.True(t, w.add(key, Resp{}, watch))
+	w.remove(key)
+	assert.False(t, watch.reached())
+	timeOutWatches(w.drain())
+	assert.Equal(t, 1, reports)
+}
+
+type fakeLongConnServer struct {
+	LongConnServer
+	clients map[string][]*Client
+}
+
+func (f *fakeLongConnServer) GetUserAllCons(userID string) ([]*Client, bool) {
+	clients, ok := f.clients[userID]
+	return clients, ok
+}
+
+func TestPushToUserAckMode(t *testing.T) {
+	client := &Client{UserID: "b", PlatformID: constant.AndroidPlatformID, out: newOutbound(8, ""), pushWindow: newPushWindow(8, time.Second, 1)}
+	s := NewServer(&fakeLongConnServer{clients: map[string][]*Client{"b": {client}}}, &Config{}, nil)
+	msg := &sdkws.MsgData{SendID: "a", RecvID: "b", SessionType: constant.SingleChatType, Seq: 1}
+
+	result := s.pushToUser(context.Background(), "b", msg, "")
+	assert.True(t, result.OnlinePush, "reached once written, without waiting for the ack")
+	assert.Len(t, client.out.queue, 1)
+	key := pushKey{conversationID: "si_a_b", seq: 1}
+	assert.Len(t, client.pushWindow.pending[key].watches, 1)
+}
@@ -0,0 +1,335 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/stringutil"
+)
+
+const (
+	defaultResumeGrace     = 30 * time.Second
+	defaultResumeMaxMissed = 128
+)
+
+// errSessionParked is returned for a push to a dropped connection waiting to be resumed. The
+// message is kept for the resume, but the user does not count as reached by the online push.
+var errSessionParked = errs.New("session parked for resume")
+
+const (
+	sessionActive = iota
+	// the connection has closed, the frames written to it are kept
+	sessionHeld
+	// unregistration deferred until the grace window ends
+	sessionParked
+	// taken over by a new connection of the same platform, which cleans up after the client
+	sessionTaken
+	// the missed frames are handed over to the resuming connection
+	sessionResumed
+	// the grace window ended, the client is unregistered
+	sessionExpired
+)
+
+// missedFrame is a frame written to a connection after it dropped.
+type missedFrame struct {
+	// msg is set for a message push, resp for anything else
+	msg       *sdkws.MsgData
+	groupSize string
+	resp      Resp
+}
+
+// resumeState is the resumable session of a connection. When the connection drops for another
+// reason than a kick or a logout, its client stays registered as parked for the grace window:
+// it keeps its place in the user map and its subscriptions, and keeps the frames written to it.
+// A reconnect presenting the token takes the session over, so the user never goes offline.
+type resumeState struct {
+	token       string
+	tokenExpire int64 // expire time of the auth token in unix seconds, 0 if unknown
+	maxMissed   int
+	// from is the client the connection resumes, until the connection is registered
+	from *Client
+
+	lock     sync.Mutex
+	state    int
+	dropped  bool
+	deferred bool // the unregistration of the client has run and was deferred
+	missed   []missedFrame
+	seqs     map[pushKey]struct{}
+	overflow bool
+	timer    *time.Timer
+}
+
+// drop makes the session not resumable, for a connection kicked or logged out.
+func (r *resumeState) drop() {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.dropped = true
+}
+
+// hold starts keeping the frames written to the closed connection, beginning with the pushes it
+// has not acknowledged and the frames still queued for it.
+func (r *resumeState) hold(c *Client) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.state != sessionActive || r.dropped {
+		return
+	}
+	r.state = sessionHeld
+	if c.pushWindow != nil {
+		for _, msg := range c.pushWindow.pendingMsgs() {
+			r.keepLocked(missedFrame{msg: msg})
+		}
+	}
+	if c.out == nil {
+		return
+	}
+	for {
+		select {
+		case frame := <-c.out.queue:
+			if frame.closeAfter {
+				continue
+			}
+			if frame.push == nil {
+				r.keepLocked(missedFrame{resp: frame.resp})
+				continue
+			}
+			for _, msg := range pushedMsgs(frame.push) {
+				r.keepLocked(missedFrame{msg: msg, groupSize: frame.groupSize})
+			}
+		default:
+			return
+		}
+	}
+}
+
+// keep records a frame written to the dropped connection, and reports false if the session
+// is not kept for resume.
+func (r *resumeState) keep(frame missedFrame) bool {
+	if r == nil {
+		return false
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.state != sessionHeld && r.state != sessionParked && r.state != sessionTaken {
+		return false
+	}
+	r.keepLocked(frame)
+	return true
+}
+
+func (r *resumeState) keepLocked(frame missedFrame) {
+	if r.overflow {
+		return
+	}
+	if frame.msg != nil && frame.msg.Seq > 0 {
+		// a message both unacknowledged and retransmitted is kept once
+		key := pushKey{conversationID: msgprocessor.GetConversationIDByMsg(frame.msg), seq: frame.msg.Seq}
+		if _, ok := r.seqs[key]; ok {
+			return
+		}
+		r.seqs[key] = struct{}{}
+	}
+	if len(r.missed) >= r.maxMissed {
+		// too far behind, the resumed client syncs instead
+		r.overflow = true
+		r.missed = nil
+		return
+	}
+	r.missed = append(r.missed, frame)
+}
+
+// missedFrames returns the frames kept for the taken client, and false if there were too many to
+// keep. The client keeps nothing after.
+func (r *resumeState) missedFrames() ([]missedFrame, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.state = sessionResumed
+	return r.missed, !r.overflow
+}
+
+// resumeSessions holds the clients of resumable sessions by resume token.
+type resumeSessions struct {
+	grace     time.Duration
+	maxMissed int
+
+	lock    sync.Mutex
+	clients map[string]*Client
+}
+
+func newResumeSessions(grace time.Duration, maxMissed int) *resumeSessions {
+	if grace <= 0 {
+		grace = defaultResumeGrace
+	}
+	if maxMissed <= 0 {
+		maxMissed = defaultResumeMaxMissed
+	}
+	return &resumeSessions{
+		grace:     grace,
+		maxMissed: maxMissed,
+		clients:   make(map[string]*Client),
+	}
+}
+
+// newState returns the session of a new connection, with a fresh resume token.
+func (s *resumeSessions) newState(tokenExpire int64) *resumeState {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return &resumeState{
+		token:       hex.EncodeToString(b),
+		tokenExpire: tokenExpire,
+		maxMissed:   s.maxMissed,
+		seqs:        make(map[pushKey]struct{}),
+	}
+}
+
+func (s *resumeSessions) add(c *Client) {
+	if s == nil || c.resume == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.clients[c.resume.token] = c
+}
+
+func (s *resumeSessions) remove(c *Client) {
+	if s == nil || c.resume == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.clients[c.resume.token] == c {
+		delete(s.clients, c.resume.token)
+	}
+}
+
+// park defers the unregistration of a dropped client until the grace window ends, and reports
+// false if the client is to be unregistered now.
+func (s *resumeSessions) park(c *Client) bool {
+	if s == nil || c.resume == nil {
+		return false
+	}
+	r := c.resume
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.state == sessionTaken || r.state == sessionResumed {
+		r.deferred = true
+		return true
+	}
+	if r.state != sessionHeld || r.dropped {
+		return false
+	}
+	r.state = sessionParked
+	r.deferred = true
+	r.timer = time.AfterFunc(s.grace, func() {
+		r.lock.Lock()
+		expired := r.state == sessionParked
+		if expired {
+			r.state = sessionExpired
+		}
+		r.lock.Unlock()
+		if expired {
+			c.longConnServer.UnRegister(c)
+		}
+	})
+	return true
+}
+
+// claim takes over the client of a dropped connection. Whoever claims it replaces it in the
+// user map and the subscriptions, the unregistration of the client does nothing more.
+func (s *resumeSessions) claim(c *Client) bool {
+	r := c.resume
+	r.lock.Lock()
+	if r.state != sessionHeld && r.state != sessionParked {
+		r.lock.Unlock()
+		return false
+	}
+	r.state = sessionTaken
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	r.lock.Unlock()
+	s.remove(c)
+	return true
+}
+
+// take claims the client whose session the connection resumes, if the connection presents its
+// resume token along with the same user, platform and auth token. A connection still open is
+// closed first: the client reconnected before the gateway noticed it was gone.
+func (s *resumeSessions) take(ctx *UserConnContext) *Client {
+	token := ctx.GetResumeToken()
+	if s == nil || token == "" {
+		return nil
+	}
+	s.lock.Lock()
+	c, ok := s.clients[token]
+	s.lock.Unlock()
+	if !ok {
+		return nil
+	}
+	// clients with a session are not pooled, so their fields hold
+	r := c.resume
+	r.lock.Lock()
+	ok = r.state <= sessionParked && !r.dropped &&
+		c.UserID == ctx.GetUserID() && c.PlatformID == stringutil.StringToInt(ctx.GetPlatformID()) &&
+		c.token == ctx.GetToken() && (r.tokenExpire == 0 || time.Now().Unix() < r.tokenExpire)
+	r.lock.Unlock()
+	if !ok {
+		return nil
+	}
+	c.close()
+	if !s.claim(c) {
+		return nil
+	}
+	return c
+}
+
+// release unregisters a client taken for a connection that failed before registering.
+func (s *resumeSessions) release(c *Client) {
+	if c == nil {
+		return
+	}
+	r := c.resume
+	r.lock.Lock()
+	r.state = sessionExpired
+	deferred := r.deferred
+	r.lock.Unlock()
+	// otherwise the pending unregistration finds the session expired
+	if deferred {
+		c.longConnServer.UnRegister(c)
+	}
+}
+
+func pushedMsgs(push *sdkws.PushMessages) []*sdkws.MsgData {
+	var msgs []*sdkws.MsgData
+	for _, pullMsgs := range []map[string]*sdkws.PullMsgs{push.Msgs, push.NotificationMsgs} {
+		for _, m := range pullMsgs {
+			msgs = append(msgs, m.GetMsgs()...)
+		}
+	}
+	return msgs
+}
@@ -0,0 +1,107 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
+	pbAuth "github.com/openimsdk/protocol/auth"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestResumeSessions(t *testing.T) {
+	ws := &WsServer{unregisterChan: make(chan *Client, 1)}
+	s := newResumeSessions(50*time.Millisecond, 2)
+	park := func() *Client {
+		c := &Client{w: new(sync.Mutex), UserID: "u1", PlatformID: constant.AndroidPlatformID, token: "t1",
+			longConnServer: ws, resume: s.newState(0)}
+		s.add(c)
+		c.closed.Store(true)
+		c.resume.hold(c)
+		assert.True(t, s.park(c))
+		return c
+	}
+	resumeCtx := func(c *Client, token string) *UserConnContext {
+		req := httptest.NewRequest(http.MethodGet, "/?sendID=u1&platformID=2&token="+token+"&resumeToken="+c.resume.token, nil)
+		return newContext(httptest.NewRecorder(), req)
+	}
+	msg := func(seq int64) missedFrame {
+		return missedFrame{msg: &sdkws.MsgData{SendID: "u2", RecvID: "u1", SessionType: constant.SingleChatType, Seq: seq}}
+	}
+
+	c := park()
+	assert.True(t, c.resume.keep(msg(1)))
+	assert.True(t, c.resume.keep(msg(1)), "a message kept twice is replayed once")
+	assert.Nil(t, s.take(resumeCtx(c, "t2")), "the auth token must match")
+	assert.Equal(t, c, s.take(resumeCtx(c, "t1")))
+	assert.Nil(t, s.take(resumeCtx(c, "t1")), "a session is resumed once")
+	missed, ok := c.resume.missedFrames()
+	assert.True(t, ok)
+	assert.Len(t, missed, 1)
+
+	c = park()
+	for seq := int64(1); seq <= 3; seq++ {
+		c.resume.keep(msg(seq))
+	}
+	assert.True(t, c.resume.overflow, "too many missed frames, the client syncs instead")
+	c.resume.drop()
+	assert.Nil(t, s.take(resumeCtx(c, "t1")), "a kicked session is not resumed")
+	select {
+	case expired := <-ws.unregisterChan:
+		assert.Equal(t, c, expired)
+	case <-time.After(time.Second):
+		t.Fatal("parked session did not expire")
+	}
+	assert.False(t, s.park(c), "an expired session is unregistered for good")
+}
+
+type kickedAuthClient struct {
+	pbAuth.AuthClient
+}
+
+func (kickedAuthClient) ParseToken(ctx context.Context, req *pbAuth.ParseTokenReq, opts ...grpc.CallOption) (*pbAuth.ParseTokenResp, error) {
+	return nil, servererrs.ErrTokenKicked.WrapMsg("token kicked")
+}
+
+func TestResumeAuthenticates(t *testing.T) {
+	ws := &WsServer{
+		wsMaxConnNum: 10,
+		authClient:   &rpcli.AuthClient{AuthClient: kickedAuthClient{}},
+		sessions:     newResumeSessions(time.Minute, 2),
+	}
+	c := &Client{w: new(sync.Mutex), UserID: "u1", PlatformID: constant.AndroidPlatformID, token: "t1",
+		longConnServer: ws, resume: ws.sessions.newState(0)}
+	ws.sessions.add(c)
+	c.closed.Store(true)
+	c.resume.hold(c)
+	assert.True(t, ws.sessions.park(c))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/?sendID=u1&platformID=2&token=t1&resumeToken="+c.resume.token, nil)
+	ws.wsHandler(rec, req)
+	assert.Contains(t, rec.Body.String(), "TokenKickedError")
+	assert.Equal(t, sessionParked, c.resume.state, "a session is not resumed with a token that fails to parse")
+	c.resume.timer.Stop()
+}
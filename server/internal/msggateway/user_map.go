@@ -11,15 +11,18 @@ type UserMap interface {
 	Get(userID string, platformID int) ([]*Client, bool, bool)
 	Set(userID string, v *Client)
 	DeleteClients(userID string, clients []*Client) (isDeleteUser bool)
+	Replace(userID string, oldClients []*Client, newClient *Client) (isDeleteUser bool)
+	SetBackground(client *Client, isBackground bool)
 	UserState() <-chan UserState
 	GetAllUserStatus(deadline time.Time, nowtime time.Time) []UserState
 	RecvSubChange(userID string, platformIDs []int32) bool
 }
 
 type UserState struct {
-	UserID  string
-	Online  []int32
-	Offline []int32
+	UserID     string
+	Online     []int32
+	Offline    []int32
+	Background []int32
 }
 
 type UserPlatform struct {
@@ -38,6 +41,24 @@ func (u *UserPlatform) PlatformIDs() []int32 {
 	return platformIDs
 }
 
+// BackgroundPlatformIDs returns the platforms whose clients are all in the background.
+func (u *UserPlatform) BackgroundPlatformIDs() []int32 {
+	foreground := make(map[int32]struct{})
+	for _, client := range u.Clients {
+		if !client.IsBackground {
+			foreground[int32(client.PlatformID)] = struct{}{}
+		}
+	}
+	var platformIDs []int32
+	for _, client := range u.Clients {
+		platformID := int32(client.PlatformID)
+		if _, ok := foreground[platformID]; !ok && !datautil.Contain(platformID, platformIDs...) {
+			platformIDs = append(platformIDs, platformID)
+		}
+	}
+	return platformIDs
+}
+
 func (u *UserPlatform) PlatformIDSet() map[int32]struct{} {
 	if len(u.Clients) == 0 {
 		return nil
@@ -82,7 +103,7 @@ func (u *userMap) RecvSubChange(userID string, platformIDs []int32) bool {
 
 func (u *userMap) push(userID string, userPlatform *UserPlatform, offline []int32) bool {
 	select {
-	case u.ch <- UserState{UserID: userID, Online: userPlatform.PlatformIDs(), Offline: offline}:
+	case u.ch <- UserState{UserID: userID, Online: userPlatform.PlatformIDs(), Offline: offline, Background: userPlatform.BackgroundPlatformIDs()}:
 		userPlatform.Time = time.Now()
 		return true
 	default:
@@ -162,6 +183,52 @@ func (u *userMap) DeleteClients(userID string, clients []*Client) (isDeleteUser
 	return true
 }
 
+// Replace swaps the clients of parked sessions for the connection taking their place, or removes
+// them when newClient is nil. The platforms stay online, so no status change is reported.
+func (u *userMap) Replace(userID string, oldClients []*Client, newClient *Client) (isDeleteUser bool) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	result, ok := u.data[userID]
+	if !ok {
+		if newClient == nil {
+			return false
+		}
+		result = &UserPlatform{}
+		u.data[userID] = result
+		// the parked clients were removed meanwhile, the user comes back online
+		defer u.push(userID, result, nil)
+	}
+	tmp := result.Clients
+	result.Clients = result.Clients[:0]
+	for _, client := range tmp {
+		if !datautil.Contain(client, oldClients...) {
+			result.Clients = append(result.Clients, client)
+		}
+	}
+	if newClient != nil {
+		result.Clients = append(result.Clients, newClient)
+	}
+	if len(result.Clients) > 0 {
+		return false
+	}
+	delete(u.data, userID)
+	return true
+}
+
+// SetBackground records whether the app of the client is in the background, reporting the user
+// state when it changes.
+func (u *userMap) SetBackground(client *Client, isBackground bool) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	if client.IsBackground == isBackground {
+		return
+	}
+	client.IsBackground = isBackground
+	if result, ok := u.data[client.UserID]; ok && datautil.Contain(client, result.Clients...) {
+		u.push(client.UserID, result, nil)
+	}
+}
+
 func (u *userMap) GetAllUserStatus(deadline time.Time, nowtime time.Time) (result []UserState) {
 	u.lock.RLock()
 	defer u.lock.RUnlock()
@@ -175,7 +242,7 @@ func (u *userMap) GetAllUserStatus(deadline time.Time, nowtime time.Time) (resul
 		for _, client := range userPlatform.Clients {
 			online = append(online, int32(client.PlatformID))
 		}
-		result = append(result, UserState{UserID: userID, Online: online})
+		result = append(result, UserState{UserID: userID, Online: online, Background: userPlatform.BackgroundPlatformIDs()})
 	}
 	return result
 }
@@ -13,17 +13,21 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	pbAuth "github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/stringutil"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 )
 
 type LongConnServer interface {
@@ -37,6 +41,7 @@ type LongConnServer interface {
 	UnRegister(c *Client)
 	SetKickHandlerInfo(i *kickHandler)
 	SubUserOnlineStatus(ctx context.Context, client *Client, data *Req) ([]byte, error)
+	SetClientBackground(client *Client, isBackground bool)
 	Compressor
 	MessageHandler
 }
@@ -50,7 +55,10 @@ type WsServer struct {
 	kickHandlerChan   chan *kickHandler
 	clients           UserMap
 	online            *rpccache.OnlineCache
+	onlineGateway     cache.OnlineGatewayCache
 	subscription      *Subscription
+	sessions          *resumeSessions // parked sessions, nil unless session resume is enabled
+	presence          *presenceDebounce
 	clientPool        sync.Pool
 	onlineUserNum     atomic.Int64
 	onlineUserConnNum atomic.Int64
@@ -117,6 +125,12 @@ func (ws *WsServer) Validate(_ any) error {
 	return nil
 }
 
+// SetClientBackground records whether the app of the client is in the background, which makes the
+// user away once all its platforms are.
+func (ws *WsServer) SetClientBackground(client *Client, isBackground bool) {
+	ws.clients.SetBackground(client, isBackground)
+}
+
 func (ws *WsServer) GetUserAllCons(userID string) ([]*Client, bool) {
 	return ws.clients.GetAll(userID)
 }
@@ -133,7 +147,11 @@ func NewWsServer(msgGatewayConfig *Config, opts ...Option) *WsServer {
 	//userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
 
 	v := validator.New()
-	return &WsServer{
+	var sessions *resumeSessions
+	if resume := msgGatewayConfig.MsgGateway.SessionResume; resume.Enable {
+		sessions = newResumeSessions(time.Duration(resume.GraceSeconds)*time.Second, resume.MaxMissed)
+	}
+	ws := &WsServer{
 		msgGatewayConfig: msgGatewayConfig,
 		port:             config.port,
 		wsMaxConnNum:     config.maxConnNum,
@@ -150,9 +168,12 @@ func NewWsServer(msgGatewayConfig *Config, opts ...Option) *WsServer {
 		validate:        v,
 		clients:         newUserMap(),
 		subscription:    newSubscription(),
+		sessions:        sessions,
 		Compressor:      NewGzipCompressor(),
 		webhookClient:   webhook.NewWebhookClient(msgGatewayConfig.WebhooksConfig.URL),
 	}
+	ws.presence = newPresenceDebounce(time.Duration(msgGatewayConfig.MsgGateway.Presence.OfflineGrace)*time.Second, ws.pushUserIDOnlineStatus)
+	return ws
 }
 
 func (ws *WsServer) Run(done chan error) error {
@@ -251,6 +272,15 @@ func (ws *WsServer) SetKickHandlerInfo(i *kickHandler) {
 }
 
 func (ws *WsServer) registerClient(client *Client) {
+	if client.resume != nil && client.resume.from != nil {
+		ws.resumeClient(client)
+		return
+	}
+	if client.resume != nil || client.ctx.GetResumeToken() != "" {
+		ws.sendResumeTips(client, false)
+	}
+	ws.sessions.add(client)
+	ws.removeParked(client)
 	var (
 		userOK     bool
 		clientOK   bool
@@ -300,6 +330,89 @@ func (ws *WsServer) registerClient(client *Client) {
 	log.ZDebug(client.ctx, "user online", "online user Num", ws.onlineUserNum.Load(), "online user conn Num", ws.onlineUserConnNum.Load())
 }
 
+// sendResumeTips gives the client the token to resume its session with. It is queued before the
+// client is visible to pushes, so that a reconnecting client reads it first.
+func (ws *WsServer) sendResumeTips(client *Client, resumed bool) {
+	tips := &sdkws.SessionResumeTips{Resumed: resumed}
+	if client.resume != nil {
+		tips.Token = client.resume.token
+		tips.GraceSeconds = int64(ws.sessions.grace / time.Second)
+	}
+	data, err := proto.Marshal(tips)
+	if err != nil {
+		log.ZError(client.ctx, "marshal resume tips failed", err)
+		return
+	}
+	resp := Resp{ReqIdentifier: WSSessionResume, OperationID: client.ctx.GetOperationID(), Data: data}
+	if err := client.writeBinaryMsg(resp); err != nil {
+		log.ZWarn(client.ctx, "send resume tips failed", err)
+	}
+}
+
+// resumeClient hands the parked session over to the connection resuming it: the connection takes
+// the place of the parked client in the user map and its subscriptions, and gets the frames it
+// missed. There is no multi-terminal check and no status change, the user has stayed online.
+func (ws *WsServer) resumeClient(client *Client) {
+	parked := client.resume.from
+	client.resume.from = nil
+	ws.sendResumeTips(client, true)
+	ws.clients.Replace(client.UserID, []*Client{parked}, client)
+	ws.sessions.add(client)
+	// pushes taken before the replacement are still kept by the parked client
+	missed, ok := parked.resume.missedFrames()
+	if !ok {
+		_ = client.writeBinaryMsg(Resp{ReqIdentifier: WSResyncMsg})
+	}
+	for _, frame := range missed {
+		var err error
+		if frame.msg != nil {
+			_, err = client.PushMessage(client.ctx, frame.msg, frame.groupSize, nil)
+		} else {
+			err = client.writeBinaryMsg(frame.resp)
+		}
+		if err != nil {
+			log.ZWarn(client.ctx, "replay missed frame failed", err)
+			break
+		}
+	}
+	parked.subLock.Lock()
+	subUserIDs := datautil.Keys(parked.subUserIDs)
+	parked.subLock.Unlock()
+	ws.subscription.DelClient(parked)
+	ws.subscription.Sub(client, subUserIDs, nil)
+	log.ZDebug(client.ctx, "session resumed", "userID", client.UserID, "platformID", client.PlatformID,
+		"missed", len(missed), "resync", !ok)
+}
+
+// removeParked removes the parked sessions of the platform the client connects on without
+// resuming them, the client takes their place.
+func (ws *WsServer) removeParked(client *Client) {
+	if ws.sessions == nil {
+		return
+	}
+	oldClients, _, ok := ws.clients.Get(client.UserID, client.PlatformID)
+	if !ok {
+		return
+	}
+	var parked []*Client
+	for _, c := range oldClients {
+		if c.resume != nil && ws.sessions.claim(c) {
+			parked = append(parked, c)
+		}
+	}
+	if len(parked) == 0 {
+		return
+	}
+	if ws.clients.Replace(client.UserID, parked, nil) {
+		ws.onlineUserNum.Add(-1)
+		prommetrics.OnlineUserGauge.Dec()
+	}
+	ws.onlineUserConnNum.Add(-int64(len(parked)))
+	for _, c := range parked {
+		ws.subscription.DelClient(c)
+	}
+}
+
 func getRemoteAdders(client []*Client) string {
 	var ret string
 	for i, c := range client {
@@ -386,7 +499,16 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 }
 
 func (ws *WsServer) unregisterClient(client *Client) {
-	defer ws.clientPool.Put(client)
+	if ws.sessions.park(client) {
+		log.ZDebug(client.ctx, "session kept for resume", "userID", client.UserID, "platformID", client.PlatformID,
+			"close reason", client.closedErr)
+		return
+	}
+	// a client with a session may still be looked up by its resume token, so it is not reused
+	if client.resume == nil {
+		defer ws.clientPool.Put(client)
+	}
+	ws.sessions.remove(client)
 	isDeleteUser := ws.clients.DeleteClients(client.UserID, []*Client{client})
 	if isDeleteUser {
 		ws.onlineUserNum.Add(-1)
@@ -440,31 +562,14 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Call the authentication client to parse the Token obtained from the context
-	resp, err := ws.authClient.ParseToken(connContext, connContext.GetToken())
-	if err != nil {
-		// If there's an error parsing the Token, decide whether to send the error message via WebSocket based on the context flag
-		shouldSendError := connContext.ShouldSendResp()
-		if shouldSendError {
-			// Create a WebSocket connection object and attempt to send the error message via WebSocket
-			wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
-			if err := wsLongConn.RespondWithError(err, w, r); err == nil {
-				// If the error message is successfully sent via WebSocket, stop processing
-				return
-			}
-		}
-		// If sending via WebSocket is not required or fails, return the error via HTTP and stop processing
-		httpError(connContext, err)
-		return
-	}
-
-	// Validate the authentication response matches the request (e.g., user ID and platform ID)
-	err = ws.validateRespWithRequest(connContext, resp)
-	if err != nil {
-		// If validation fails, return an error via HTTP and stop processing
-		httpError(connContext, err)
+	resp, ok := ws.authenticate(connContext, w, r)
+	if !ok {
 		return
 	}
+	tokenExpire := resp.ExpireTimeSeconds
+	// A reconnect presenting the resume token of its parked session takes it over, once its
+	// token is valid: a kicked or expired token must not bring the session back
+	parked := ws.sessions.take(connContext)
 
 	log.ZDebug(connContext, "new conn", "token", connContext.GetToken())
 	// Create a WebSocket long connection object
@@ -472,6 +577,7 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	if err := wsLongConn.GenerateLongConn(w, r); err != nil {
 		//If the creation of the long connection fails, the error is handled internally during the handshake process.
 		log.ZWarn(connContext, "long connection fails", err)
+		ws.sessions.release(parked)
 		return
 	} else {
 		// Check if a normal response should be sent via WebSocket
@@ -480,6 +586,7 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 			// Attempt to send a success message through WebSocket
 			if err := wsLongConn.RespondWithSuccess(); err != nil {
 				// If the success message is successfully sent, end further processing
+				ws.sessions.release(parked)
 				return
 			}
 		}
@@ -488,8 +595,47 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	// Retrieve a client object from the client pool, reset its state, and associate it with the current WebSocket long connection
 	client := ws.clientPool.Get().(*Client)
 	client.ResetClient(connContext, wsLongConn, ws)
+	client.out = newOutbound(ws.msgGatewayConfig.MsgGateway.OutboundQueue.Size, ws.msgGatewayConfig.MsgGateway.OutboundQueue.FullPolicy)
+	if pushAck := ws.msgGatewayConfig.MsgGateway.PushAck; pushAck.Enable && connContext.GetPushAck() {
+		client.enablePushAck(pushAck.WindowSize, time.Duration(pushAck.AckTimeout)*time.Second, pushAck.MaxRetransmit)
+	}
+	if ws.sessions != nil && (connContext.GetSessionResume() || parked != nil) {
+		client.resume = ws.sessions.newState(tokenExpire)
+		client.resume.from = parked
+	}
 
 	// Register the client with the server and start message processing
 	ws.registerChan <- client
 	go client.readMessage()
 }
+
+// authenticate parses the token of the connection, and reports false once it has answered the
+// request with the error.
+func (ws *WsServer) authenticate(connContext *UserConnContext, w http.ResponseWriter, r *http.Request) (*pbAuth.ParseTokenResp, bool) {
+	// Call the authentication client to parse the Token obtained from the context
+	resp, err := ws.authClient.ParseToken(connContext, connContext.GetToken())
+	if err != nil {
+		// If there's an error parsing the Token, decide whether to send the error message via WebSocket based on the context flag
+		shouldSendError := connContext.ShouldSendResp()
+		if shouldSendError {
+			// Create a WebSocket connection object and attempt to send the error message via WebSocket
+			wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
+			if err := wsLongConn.RespondWithError(err, w, r); err == nil {
+				// If the error message is successfully sent via WebSocket, stop processing
+				return nil, false
+			}
+		}
+		// If sending via WebSocket is not required or fails, return the error via HTTP and stop processing
+		httpError(connContext, err)
+		return nil, false
+	}
+
+	// Validate the authentication response matches the request (e.g., user ID and platform ID)
+	err = ws.validateRespWithRequest(connContext, resp)
+	if err != nil {
+		// If validation fails, return an error via HTTP and stop processing
+		httpError(connContext, err)
+		return nil, false
+	}
+	return resp, true
+}
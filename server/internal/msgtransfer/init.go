@@ -32,6 +32,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/utils/datautil"
@@ -88,6 +89,14 @@ func Start(ctx context.Context, index int, config *Config) error {
 	}
 	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
+	shutdownTracing, err := tracing.Init(ctx, &config.Share.Tracing, "openim-msg-transfer")
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing(context.Background())
+	if config.Share.Tracing.Enable {
+		client.AddOption(tracing.GrpcDialOption())
+	}
 
 	msgDocModel, err := mgo.NewMsgMongo(mgocli.GetDB())
 	if err != nil {
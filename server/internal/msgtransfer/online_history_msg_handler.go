@@ -31,6 +31,7 @@ import (
 	"github.com/go-redis/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/kafka"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/tools/batcher"
 	"github.com/openimsdk/protocol/constant"
@@ -40,6 +41,8 @@ import (
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/stringutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -123,6 +126,9 @@ func (och *OnlineHistoryRedisConsumerHandler) do(ctx context.Context, channelID
 	ctx = mcontext.WithTriggerIDContext(ctx, val.TriggerID())
 	ctxMessages := och.parseConsumerMessages(ctx, val.Val())
 	ctx = withAggregationCtx(ctx, ctxMessages)
+	ctx, span := startBatchSpan(ctx, "msgtransfer.batch", ctxMessages)
+	defer span.End()
+	span.SetAttributes(attribute.String("openim.batch_key", val.Key()), attribute.Int("openim.batch_size", len(ctxMessages)))
 	log.ZInfo(ctx, "msg arrived channel", "channel id", channelID, "msgList length", len(ctxMessages), "key", val.Key())
 	och.doSetReadSeq(ctx, ctxMessages)
 
@@ -272,7 +278,9 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 	}
 	if len(storageMessageList) > 0 {
 		msg := storageMessageList[0]
+		start := time.Now()
 		lastSeq, isNewConversation, userSeqMap, err := och.msgTransferDatabase.BatchInsertChat2Cache(ctx, conversationID, storageMessageList)
+		prommetrics.MsgBatchInsertRedisDurationHistogram.Observe(time.Since(start).Seconds())
 		if err != nil && !errors.Is(errs.Unwrap(err), redis.Nil) {
 			log.ZWarn(ctx, "batch data insert to redis err", err, "storageMsgList", storageMessageList)
 			return
@@ -402,6 +410,21 @@ func withAggregationCtx(ctx context.Context, values []*ContextMsg) context.Conte
 	return mcontext.SetOperationID(ctx, allMessageOperationID)
 }
 
+// startBatchSpan starts the span of a batch in the trace of its first message, linked to the
+// traces of the others.
+func startBatchSpan(ctx context.Context, name string, values []*ContextMsg) (context.Context, trace.Span) {
+	links := make([]trace.Link, 0, len(values))
+	for _, v := range values {
+		if sc := trace.SpanContextFromContext(v.ctx); sc.IsValid() {
+			links = append(links, trace.Link{SpanContext: sc})
+		}
+	}
+	if len(links) > 0 {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, links[0].SpanContext)
+	}
+	return tracing.Start(ctx, name, trace.WithSpanKind(trace.SpanKindConsumer), trace.WithLinks(links...))
+}
+
 func (och *OnlineHistoryRedisConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error { return nil }
 func (och *OnlineHistoryRedisConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error {
 	return nil
@@ -422,6 +445,7 @@ func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(session sarama.Consum
 				return nil
 			}
 
+			prommetrics.KafkaConsumed(claim.Topic(), claim.Partition(), claim.HighWaterMarkOffset(), msg.Offset)
 			if len(msg.Value) == 0 {
 				continue
 			}
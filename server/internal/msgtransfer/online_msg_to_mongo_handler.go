@@ -16,14 +16,17 @@ package msgtransfer
 
 import (
 	"context"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/kafka"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	pbmsg "github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/tools/log"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -58,7 +61,9 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(ctx context.Cont
 		return
 	}
 	log.ZDebug(ctx, "mongo consumer recv msg", "msgs", msgFromMQ.String())
+	start := time.Now()
 	err = mc.msgTransferDatabase.BatchInsertChat2DB(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData, msgFromMQ.LastSeq)
+	prommetrics.MsgInsertMongoDurationHistogram.Observe(time.Since(start).Seconds())
 	if err != nil {
 		log.ZError(
 			ctx,
@@ -87,12 +92,15 @@ func (mc *OnlineHistoryMongoConsumerHandler) ConsumeClaim(sess sarama.ConsumerGr
 	log.ZDebug(context.Background(), "online new session msg come", "highWaterMarkOffset",
 		claim.HighWaterMarkOffset(), "topic", claim.Topic(), "partition", claim.Partition())
 	for msg := range claim.Messages() {
+		prommetrics.KafkaConsumed(claim.Topic(), claim.Partition(), claim.HighWaterMarkOffset(), msg.Offset)
 		ctx := mc.historyConsumerGroup.GetContextFromMsg(msg)
+		ctx, span := tracing.Start(ctx, "msgtransfer.mongo", trace.WithSpanKind(trace.SpanKindConsumer))
 		if len(msg.Value) != 0 {
 			mc.handleChatWs2Mongo(ctx, msg, string(msg.Key), sess)
 		} else {
 			log.ZError(ctx, "mongo msg get from kafka but is nil", nil, "conversationID", msg.Key)
 		}
+		span.End()
 		sess.MarkMessage(msg, "")
 	}
 	return nil
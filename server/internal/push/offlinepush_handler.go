@@ -38,6 +38,7 @@ func (*OfflinePushConsumerHandler) Setup(sarama.ConsumerGroupSession) error   {
 func (*OfflinePushConsumerHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
 func (o *OfflinePushConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for msg := range claim.Messages() {
+		prommetrics.KafkaConsumed(claim.Topic(), claim.Partition(), claim.HighWaterMarkOffset(), msg.Offset)
 		ctx := o.OfflinePushConsumerGroup.GetContextFromMsg(msg)
 		o.handleMsg2OfflinePush(ctx, msg.Value)
 		sess.MarkMessage(msg, "")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/protocol/msggateway"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/discovery"
@@ -37,13 +38,14 @@ func (u emptyOnlinePusher) GetOnlinePushFailedUserIDs(ctx context.Context, msg *
 	return nil
 }
 
-func NewOnlinePusher(disCov discovery.SvcDiscoveryRegistry, config *Config) OnlinePusher {
+func NewOnlinePusher(disCov discovery.SvcDiscoveryRegistry, config *Config, routes cache.OnlineGatewayCache) OnlinePusher {
 	switch config.Discovery.Enable {
 	case "k8s":
 		return NewK8sStaticConsistentHash(disCov, config)
-	case "zookeeper":
-		return NewDefaultAllNode(disCov, config)
-	case "etcd":
+	case "zookeeper", "etcd":
+		if config.RpcConfig.OnlinePushRoute {
+			return NewRouteOnlinePusher(disCov, config, routes)
+		}
 		return NewDefaultAllNode(disCov, config)
 	default:
 		return newEmptyOnlinePusher()
@@ -75,7 +77,7 @@ func (d *DefaultAllNode) GetConnsAndOnlinePush(ctx context.Context, msg *sdkws.M
 	var (
 		mu         sync.Mutex
 		wg         = errgroup.Group{}
-		input      = &msggateway.OnlineBatchPushOneMsgReq{MsgData: msg, PushToUserIDs: pushToUserIDs}
+		input      = &msggateway.OnlineBatchPushOneMsgReq{MsgData: msg, PushToUserIDs: pushToUserIDs, ReceiverCount: int32(len(pushToUserIDs))}
 		maxWorkers = d.config.RpcConfig.MaxConcurrentWorkers
 	)
 
@@ -179,7 +181,7 @@ func (k *K8sStaticConsistentHash) GetConnsAndOnlinePush(ctx context.Context, msg
 		tcon := conn
 		tuserIds := userIds
 		wg.Go(func() error {
-			input := &msggateway.OnlineBatchPushOneMsgReq{MsgData: msg, PushToUserIDs: tuserIds}
+			input := &msggateway.OnlineBatchPushOneMsgReq{MsgData: msg, PushToUserIDs: tuserIds, ReceiverCount: int32(len(pushToUserIDs))}
 			msgClient := msggateway.NewMsgGatewayClient(tcon)
 			reply, err := msgClient.SuperGroupOnlineBatchPushOneMsg(ctx, input)
 			if err != nil {
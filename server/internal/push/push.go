@@ -44,6 +44,15 @@ func (p pushServer) DelUserPushToken(ctx context.Context,
 	return &pbpush.DelUserPushTokenResp{}, nil
 }
 
+// PushUnackedOffline sends the offline push to users the gateways counted as reached, whose
+// clients in ack mode did not acknowledge the message in the end.
+func (p pushServer) PushUnackedOffline(ctx context.Context, req *pbpush.PushUnackedOfflineReq) (*pbpush.PushUnackedOfflineResp, error) {
+	if err := p.pushCh.PushUnacked(ctx, req.MsgData, req.UserIDs); err != nil {
+		return nil, err
+	}
+	return &pbpush.PushUnackedOfflineResp{}, nil
+}
+
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
 	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
 	if err != nil {
@@ -13,8 +13,10 @@ import (
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	redis2 "github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/kafka"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
@@ -30,6 +32,8 @@ import (
 	"github.com/openimsdk/tools/utils/jsonutil"
 	"github.com/openimsdk/tools/utils/timeutil"
 	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -80,7 +84,7 @@ func NewConsumerHandler(ctx context.Context, config *Config, database controller
 	consumerHandler.conversationClient = rpcli.NewConversationClient(conversationConn)
 
 	consumerHandler.offlinePusher = offlinePusher
-	consumerHandler.onlinePusher = NewOnlinePusher(client, config)
+	consumerHandler.onlinePusher = NewOnlinePusher(client, config, redis2.NewOnlineGateway(rdb))
 	consumerHandler.groupLocalCache = rpccache.NewGroupLocalCache(consumerHandler.groupClient, &config.LocalCacheConfig, rdb)
 	consumerHandler.conversationLocalCache = rpccache.NewConversationLocalCache(consumerHandler.conversationClient, &config.LocalCacheConfig, rdb)
 	consumerHandler.webhookClient = webhook.NewWebhookClient(config.WebhooksConfig.URL)
@@ -107,7 +111,13 @@ func (c *ConsumerHandler) handleMs2PsChat(ctx context.Context, msg []byte) {
 		prommetrics.MsgLoneTimePushCounter.Inc()
 		log.ZWarn(ctx, "it’s been a while since the message was sent", nil, "msg", msgFromMQ.String(), "sec", sec, "nowSec", nowSec, "nowSec-sec", nowSec-sec)
 	}
+	ctx, span := tracing.Start(ctx, "push.consume", trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(attribute.String("openim.client_msg_id", msgFromMQ.MsgData.ClientMsgID)))
 	var err error
+	defer func(start time.Time) {
+		prommetrics.PushFanoutDurationHistogram.WithLabelValues(prommetrics.SessionTypeLabel(msgFromMQ.MsgData.SessionType)).Observe(time.Since(start).Seconds())
+		tracing.End(span, err)
+	}(time.Now())
 
 	switch msgFromMQ.MsgData.SessionType {
 	case constant.ReadGroupChatType:
@@ -141,6 +151,7 @@ func (c *ConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim s
 	log.ZInfo(ctx, "begin consume messages")
 
 	for msg := range claim.Messages() {
+		prommetrics.KafkaConsumed(claim.Topic(), claim.Partition(), claim.HighWaterMarkOffset(), msg.Offset)
 		ctx := c.pushConsumerGroup.GetContextFromMsg(msg)
 		c.handleMs2PsChat(ctx, msg.Value)
 		sess.MarkMessage(msg, "")
@@ -211,6 +222,27 @@ func (c *ConsumerHandler) Push2User(ctx context.Context, userIDs []string, msg *
 	return nil
 }
 
+// PushUnacked sends the offline push of msg to the users the online push turned out not to
+// reach, filtered as after an online push.
+func (c *ConsumerHandler) PushUnacked(ctx context.Context, msg *sdkws.MsgData, userIDs []string) error {
+	if !c.shouldPushOffline(ctx, msg) {
+		return nil
+	}
+	userIDs = datautil.Filter(userIDs, func(userID string) (string, bool) { return userID, userID != msg.SendID })
+	if msg.SessionType == constant.ReadGroupChatType {
+		var err error
+		userIDs, err = c.filterGroupMessageOfflinePush(ctx, msg.GroupID, msg, userIDs)
+		if err != nil {
+			return err
+		}
+	}
+	log.ZInfo(ctx, "offline push unacknowledged", "userIDs", userIDs, "seq", msg.Seq)
+	if len(userIDs) > 0 {
+		c.asyncOfflinePush(ctx, userIDs, msg)
+	}
+	return nil
+}
+
 func (c *ConsumerHandler) shouldPushOffline(_ context.Context, msg *sdkws.MsgData) bool {
 	isOfflinePush := datautil.GetSwitchFromOptions(msg.Options, constant.IsOfflinePush)
 	if !isOfflinePush {
@@ -0,0 +1,135 @@
+package push
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/protocol/msggateway"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+)
+
+// RouteOnlinePusher pushes to the gateways the receivers are connected to, as recorded by the
+// gateways in the online gateway cache. Receivers without a known gateway still go to every
+// gateway, and routed receivers not reached are retried on the other gateways, so a missing or
+// stale record only costs a broadcast, never the message.
+type RouteOnlinePusher struct {
+	*DefaultAllNode
+	routes cache.OnlineGatewayCache
+}
+
+func NewRouteOnlinePusher(disCov discovery.SvcDiscoveryRegistry, config *Config, routes cache.OnlineGatewayCache) *RouteOnlinePusher {
+	return &RouteOnlinePusher{DefaultAllNode: NewDefaultAllNode(disCov, config), routes: routes}
+}
+
+func (r *RouteOnlinePusher) GetConnsAndOnlinePush(ctx context.Context, msg *sdkws.MsgData,
+	pushToUserIDs []string) (wsResults []*msggateway.SingleMsgToUserResults, err error) {
+	conns, err := r.disCov.GetConns(ctx, r.config.Share.RpcRegisterName.MessageGateway)
+	if err != nil {
+		return nil, err
+	}
+	if len(conns) == 0 {
+		log.ZWarn(ctx, "get gateway conn 0 ", nil)
+		return nil, nil
+	}
+	gateways, err := r.routes.GetUsersGateways(ctx, pushToUserIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get users gateways failed, push to all gateways", err)
+		return r.DefaultAllNode.GetConnsAndOnlinePush(ctx, msg, pushToUserIDs)
+	}
+	targetConns := make(map[string]grpc.ClientConnInterface, len(conns))
+	for _, conn := range conns {
+		if c, ok := conn.(interface{ Target() string }); ok {
+			targetConns[c.Target()] = conn
+		}
+	}
+	var (
+		connUsers  = make(map[grpc.ClientConnInterface][]string, len(conns))
+		userRoutes = make(map[string][]grpc.ClientConnInterface, len(pushToUserIDs))
+		unrouted   []string
+	)
+	for _, userID := range pushToUserIDs {
+		for _, gateway := range gateways[userID] {
+			if conn, ok := targetConns[gateway]; ok {
+				connUsers[conn] = append(connUsers[conn], userID)
+				userRoutes[userID] = append(userRoutes[userID], conn)
+			}
+		}
+		// no record, or the recorded gateways are gone, e.g. restarted with another address
+		if len(userRoutes[userID]) == 0 {
+			unrouted = append(unrouted, userID)
+		}
+	}
+	log.ZDebug(ctx, "route online push", "conns", len(conns), "routedConns", len(connUsers), "unrouted", len(unrouted))
+	for _, conn := range conns {
+		connUsers[conn] = append(connUsers[conn], unrouted...)
+	}
+	wsResults = r.pushConns(ctx, msg, connUsers, len(pushToUserIDs))
+
+	// a record can outlive the connection, e.g. the user reconnected to another gateway before
+	// the old one refreshed its records: try the other gateways for routed users not reached
+	online := make(map[string]struct{}, len(wsResults))
+	for _, result := range wsResults {
+		if result.OnlinePush {
+			online[result.UserID] = struct{}{}
+		}
+	}
+	retryUsers := make(map[grpc.ClientConnInterface][]string)
+	for userID, routes := range userRoutes {
+		if _, ok := online[userID]; ok {
+			continue
+		}
+		for _, conn := range conns {
+			if !datautil.Contain(conn, routes...) {
+				retryUsers[conn] = append(retryUsers[conn], userID)
+			}
+		}
+	}
+	if len(retryUsers) > 0 {
+		log.ZDebug(ctx, "route online push stale", "retryConns", len(retryUsers))
+		wsResults = append(wsResults, r.pushConns(ctx, msg, retryUsers, len(pushToUserIDs))...)
+	}
+	return wsResults, nil
+}
+
+// pushConns pushes msg to the users of each gateway and returns the results of all gateways.
+func (r *RouteOnlinePusher) pushConns(ctx context.Context, msg *sdkws.MsgData, connUsers map[grpc.ClientConnInterface][]string,
+	receiverCount int) (wsResults []*msggateway.SingleMsgToUserResults) {
+	var (
+		mu         sync.Mutex
+		wg         = errgroup.Group{}
+		maxWorkers = r.config.RpcConfig.MaxConcurrentWorkers
+	)
+	if maxWorkers < 3 {
+		maxWorkers = 3
+	}
+	wg.SetLimit(maxWorkers)
+	for conn, userIDs := range connUsers {
+		conn, userIDs := conn, userIDs
+		if len(userIDs) == 0 {
+			continue
+		}
+		wg.Go(func() error {
+			input := &msggateway.OnlineBatchPushOneMsgReq{MsgData: msg, PushToUserIDs: userIDs, ReceiverCount: int32(receiverCount)}
+			reply, err := msggateway.NewMsgGatewayClient(conn).SuperGroupOnlineBatchPushOneMsg(ctx, input)
+			if err != nil {
+				log.ZError(ctx, "SuperGroupOnlineBatchPushOneMsg ", err, "req:", input.String())
+				return nil
+			}
+			log.ZDebug(ctx, "push result", "reply", reply)
+			if reply != nil && reply.SinglePushResult != nil {
+				mu.Lock()
+				wsResults = append(wsResults, reply.SinglePushResult...)
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	_ = wg.Wait()
+	return wsResults
+}
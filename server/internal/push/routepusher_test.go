@@ -0,0 +1,102 @@
+package push
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/openimsdk/protocol/msggateway"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// fakeGateway answers online pushes for the users connected to it.
+type fakeGateway struct {
+	target string
+	online map[string]bool
+	mu     sync.Mutex
+	pushed []string
+}
+
+func (f *fakeGateway) Target() string {
+	return f.target
+}
+
+func (f *fakeGateway) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	req := args.(*msggateway.OnlineBatchPushOneMsgReq)
+	resp := reply.(*msggateway.OnlineBatchPushOneMsgResp)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, userID := range req.PushToUserIDs {
+		f.pushed = append(f.pushed, userID)
+		resp.SinglePushResult = append(resp.SinglePushResult, &msggateway.SingleMsgToUserResults{UserID: userID, OnlinePush: f.online[userID]})
+	}
+	return nil
+}
+
+func (f *fakeGateway) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not used")
+}
+
+func (f *fakeGateway) pushedUsers() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	users := append([]string(nil), f.pushed...)
+	sort.Strings(users)
+	return users
+}
+
+type fakeGatewayDiscovery struct {
+	discovery.SvcDiscoveryRegistry
+	conns []grpc.ClientConnInterface
+}
+
+func (f *fakeGatewayDiscovery) GetConns(ctx context.Context, serviceName string, opts ...grpc.DialOption) ([]grpc.ClientConnInterface, error) {
+	return f.conns, nil
+}
+
+type fakeGatewayRoutes map[string][]string
+
+func (f fakeGatewayRoutes) SetUsersGateway(ctx context.Context, gateway string, online []string, offline []string) error {
+	return nil
+}
+
+func (f fakeGatewayRoutes) GetUsersGateways(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	return f, nil
+}
+
+func onlineUserIDs(results []*msggateway.SingleMsgToUserResults) []string {
+	var userIDs []string
+	for _, result := range results {
+		if result.OnlinePush {
+			userIDs = append(userIDs, result.UserID)
+		}
+	}
+	sort.Strings(userIDs)
+	return userIDs
+}
+
+func TestRouteOnlinePush(t *testing.T) {
+	gw1 := &fakeGateway{target: "gw1", online: map[string]bool{"a": true, "c": true}}
+	gw2 := &fakeGateway{target: "gw2", online: map[string]bool{"b": true}}
+	gw3 := &fakeGateway{target: "gw3", online: map[string]bool{}}
+	r := NewRouteOnlinePusher(&fakeGatewayDiscovery{conns: []grpc.ClientConnInterface{gw1, gw2, gw3}}, &Config{},
+		fakeGatewayRoutes{
+			"a": {"gw1"},
+			// reconnected to gw2, the record of gw1 has not expired yet
+			"b": {"gw1"},
+			// the recorded gateway is gone
+			"c": {"gw9"},
+		})
+
+	results, err := r.GetConnsAndOnlinePush(context.Background(), &sdkws.MsgData{}, []string{"a", "b", "c", "d"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, onlineUserIDs(results))
+	// a is pushed to its gateway only; b is retried on the others; c and d go everywhere
+	assert.Equal(t, []string{"a", "b", "c", "d"}, gw1.pushedUsers())
+	assert.Equal(t, []string{"b", "c", "d"}, gw2.pushedUsers())
+	assert.Equal(t, []string{"b", "c", "d"}, gw3.pushedUsers())
+}
@@ -47,6 +47,7 @@ import (
 type conversationServer struct {
 	pbconversation.UnimplementedConversationServer
 	conversationDatabase controller.ConversationDatabase
+	folderDatabase       controller.ConversationFolderDatabase
 
 	conversationNotificationSender *ConversationNotificationSender
 	config                         *Config
@@ -79,6 +80,10 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	folderDB, err := mgo.NewConversationFolderMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	userConn, err := client.GetConn(ctx, config.Share.RpcRegisterName.User)
 	if err != nil {
 		return err
@@ -93,13 +98,15 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	}
 	msgClient := rpcli.NewMsgClient(msgConn)
 	localcache.InitLocalCache(&config.LocalCacheConfig)
+	conversationCache := redis.NewConversationRedis(rdb, &config.LocalCacheConfig, redis.GetRocksCacheOptions(), conversationDB)
 	pbconversation.RegisterConversationServer(server, &conversationServer{
 		conversationNotificationSender: NewConversationNotificationSender(&config.NotificationConfig, msgClient),
-		conversationDatabase: controller.NewConversationDatabase(conversationDB,
-			redis.NewConversationRedis(rdb, &config.LocalCacheConfig, redis.GetRocksCacheOptions(), conversationDB), mgocli.GetTx()),
-		userClient:  rpcli.NewUserClient(userConn),
-		groupClient: rpcli.NewGroupClient(groupConn),
-		msgClient:   msgClient,
+		conversationDatabase:           controller.NewConversationDatabase(conversationDB, conversationCache, mgocli.GetTx()),
+		folderDatabase:                 controller.NewConversationFolderDatabase(folderDB, conversationDB, conversationCache, mgocli.GetTx()),
+		config:                         config,
+		userClient:                     rpcli.NewUserClient(userConn),
+		groupClient:                    rpcli.NewGroupClient(groupConn),
+		msgClient:                      msgClient,
 	})
 	return nil
 }
@@ -225,6 +232,8 @@ func (c *conversationServer) SetConversation(ctx context.Context, req *pbconvers
 	if err := datautil.CopyStructFields(&conversation, req.Conversation); err != nil {
 		return nil, err
 	}
+	// folder membership is only changed through the folder APIs
+	conversation.FolderIDs = nil
 	err := c.conversationDatabase.SetUserConversations(ctx, req.Conversation.OwnerUserID, []*dbModel.Conversation{&conversation})
 	if err != nil {
 		return nil, err
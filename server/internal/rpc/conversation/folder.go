@@ -0,0 +1,194 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	pbconversation "github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/encrypt"
+)
+
+// maxConversationFolders is the number of folders a user can have.
+const maxConversationFolders = 100
+
+func checkConversationFolderRule(rule *pbconversation.ConversationFolderRule) error {
+	if len(rule.SessionTypes) == 0 && rule.GroupIDPrefix == "" && rule.Unread == constant.FolderUnreadAny {
+		return errs.ErrArgs.WrapMsg("folder rule has no condition")
+	}
+	for _, sessionType := range rule.SessionTypes {
+		switch sessionType {
+		case constant.SingleChatType, constant.ReadGroupChatType, constant.NotificationChatType:
+		default:
+			return errs.ErrArgs.WrapMsg("invalid session type in folder rule", "sessionType", sessionType)
+		}
+	}
+	switch rule.Unread {
+	case constant.FolderUnreadAny, constant.FolderUnreadOnly, constant.FolderUnreadAtMe:
+	default:
+		return errs.ErrArgs.WrapMsg("invalid unread in folder rule", "unread", rule.Unread)
+	}
+	return nil
+}
+
+// checkConversationIDs makes sure the user has all the conversations.
+func (c *conversationServer) checkConversationIDs(ctx context.Context, userID string, conversationIDs []string) error {
+	conversations, err := c.conversationDatabase.FindConversations(ctx, userID, conversationIDs)
+	if err != nil {
+		return err
+	}
+	if len(conversations) != len(conversationIDs) {
+		return errs.ErrRecordNotFound.WrapMsg("conversation not found")
+	}
+	return nil
+}
+
+func (c *conversationServer) CreateConversationFolder(ctx context.Context, req *pbconversation.CreateConversationFolderReq) (*pbconversation.CreateConversationFolderResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if req.Rule != nil {
+		if err := checkConversationFolderRule(req.Rule); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	folder := &model.ConversationFolder{
+		FolderID:    encrypt.Md5(strings.Join([]string{req.UserID, mcontext.GetOperationID(ctx), strconv.FormatInt(now.UnixNano(), 10), strconv.Itoa(rand.Int())}, ",")),
+		OwnerUserID: req.UserID,
+		Name:        req.Name,
+		Sort:        req.Sort,
+		Rule:        convert.ConversationFolderRulePb2DB(req.Rule),
+		CreateTime:  now,
+		UpdateTime:  now,
+	}
+	if err := c.folderDatabase.CreateFolder(ctx, folder, maxConversationFolders); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationChangeNotification(ctx, req.UserID, nil)
+	return &pbconversation.CreateConversationFolderResp{Folder: convert.ConversationFolderDB2Pb(folder)}, nil
+}
+
+// UpdateConversationFolder changes the name, the sort and the rule of a folder. A regular
+// folder can't become a smart folder and the other way around.
+func (c *conversationServer) UpdateConversationFolder(ctx context.Context, req *pbconversation.UpdateConversationFolderReq) (*pbconversation.UpdateConversationFolderResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folder, err := c.folderDatabase.TakeFolder(ctx, req.UserID, req.FolderID)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]any)
+	if req.Name != nil {
+		data["name"] = req.Name.Value
+	}
+	if req.Sort != nil {
+		data["sort"] = req.Sort.Value
+	}
+	if req.Rule != nil {
+		if folder.Rule == nil {
+			return nil, errs.ErrArgs.WrapMsg("folder is not a smart folder")
+		}
+		if err := checkConversationFolderRule(req.Rule); err != nil {
+			return nil, err
+		}
+		data["rule"] = convert.ConversationFolderRulePb2DB(req.Rule)
+	}
+	if len(data) == 0 {
+		return &pbconversation.UpdateConversationFolderResp{}, nil
+	}
+	data["update_time"] = time.Now()
+	if err := c.folderDatabase.UpdateFolder(ctx, req.UserID, req.FolderID, data); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationChangeNotification(ctx, req.UserID, nil)
+	return &pbconversation.UpdateConversationFolderResp{}, nil
+}
+
+func (c *conversationServer) DeleteConversationFolder(ctx context.Context, req *pbconversation.DeleteConversationFolderReq) (*pbconversation.DeleteConversationFolderResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := c.folderDatabase.TakeFolder(ctx, req.UserID, req.FolderID); err != nil {
+		return nil, err
+	}
+	if err := c.folderDatabase.DeleteFolder(ctx, req.UserID, req.FolderID); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationChangeNotification(ctx, req.UserID, nil)
+	return &pbconversation.DeleteConversationFolderResp{}, nil
+}
+
+func (c *conversationServer) GetConversationFolders(ctx context.Context, req *pbconversation.GetConversationFoldersReq) (*pbconversation.GetConversationFoldersResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folders, err := c.folderDatabase.FindFolders(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &pbconversation.GetConversationFoldersResp{Folders: datautil.Slice(folders, convert.ConversationFolderDB2Pb)}, nil
+}
+
+// AddConversationsToFolder puts conversations into a regular folder, the conversations of a
+// smart folder come from its rule.
+func (c *conversationServer) AddConversationsToFolder(ctx context.Context, req *pbconversation.AddConversationsToFolderReq) (*pbconversation.AddConversationsToFolderResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folder, err := c.folderDatabase.TakeFolder(ctx, req.UserID, req.FolderID)
+	if err != nil {
+		return nil, err
+	}
+	if folder.Rule != nil {
+		return nil, errs.ErrArgs.WrapMsg("conversations can't be added to a smart folder")
+	}
+	conversationIDs := datautil.Distinct(req.ConversationIDs)
+	if err := c.checkConversationIDs(ctx, req.UserID, conversationIDs); err != nil {
+		return nil, err
+	}
+	if err := c.folderDatabase.AddConversationsToFolder(ctx, req.UserID, req.FolderID, conversationIDs); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationChangeNotification(ctx, req.UserID, conversationIDs)
+	return &pbconversation.AddConversationsToFolderResp{}, nil
+}
+
+func (c *conversationServer) RemoveConversationsFromFolder(ctx context.Context, req *pbconversation.RemoveConversationsFromFolderReq) (*pbconversation.RemoveConversationsFromFolderResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := c.folderDatabase.TakeFolder(ctx, req.UserID, req.FolderID); err != nil {
+		return nil, err
+	}
+	conversationIDs := datautil.Distinct(req.ConversationIDs)
+	if err := c.folderDatabase.RemoveConversationsFromFolder(ctx, req.UserID, req.FolderID, conversationIDs); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationChangeNotification(ctx, req.UserID, conversationIDs)
+	return &pbconversation.RemoveConversationsFromFolderResp{}, nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/openimsdk/open-im-server/v3/internal/rpc/incrversion"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/util/hashutil"
 	"github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 func (c *conversationServer) GetFullOwnerConversationIDs(ctx context.Context, req *conversation.GetFullOwnerConversationIDsReq) (*conversation.GetFullOwnerConversationIDsResp, error) {
@@ -31,12 +33,29 @@ func (c *conversationServer) GetFullOwnerConversationIDs(ctx context.Context, re
 }
 
 func (c *conversationServer) GetIncrementalConversation(ctx context.Context, req *conversation.GetIncrementalConversationReq) (*conversation.GetIncrementalConversationResp, error) {
+	var folderChanged bool
 	opt := incrversion.Option[*conversation.Conversation, conversation.GetIncrementalConversationResp]{
-		Ctx:             ctx,
-		VersionKey:      req.UserID,
-		VersionID:       req.VersionID,
-		VersionNumber:   req.Version,
-		Version:         c.conversationDatabase.FindConversationUserVersion,
+		Ctx:           ctx,
+		VersionKey:    req.UserID,
+		VersionID:     req.VersionID,
+		VersionNumber: req.Version,
+		Version: func(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error) {
+			vl, err := c.conversationDatabase.FindConversationUserVersion(ctx, userID, version, limit)
+			if err != nil {
+				return nil, err
+			}
+			logs := make([]model.VersionLogElem, 0, len(vl.Logs))
+			for i, log := range vl.Logs {
+				if log.EID == model.VersionFolderChangeID {
+					vl.LogLen--
+					folderChanged = true
+					continue
+				}
+				logs = append(logs, vl.Logs[i])
+			}
+			vl.Logs = logs
+			return vl, nil
+		},
 		CacheMaxVersion: c.conversationDatabase.FindMaxConversationUserVersionCache,
 		Find: func(ctx context.Context, conversationIDs []string) ([]*conversation.Conversation, error) {
 			return c.getConversations(ctx, req.UserID, conversationIDs)
@@ -52,5 +71,17 @@ func (c *conversationServer) GetIncrementalConversation(ctx context.Context, req
 			}
 		},
 	}
-	return opt.Build()
+	resp, err := opt.Build()
+	if err != nil {
+		return nil, err
+	}
+	if resp.Full || folderChanged {
+		folders, err := c.folderDatabase.FindFolders(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		resp.FolderChanged = true
+		resp.Folders = datautil.Slice(folders, convert.ConversationFolderDB2Pb)
+	}
+	return resp, nil
 }
@@ -0,0 +1,105 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbconversation "github.com/openimsdk/protocol/conversation"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memConversationVersion keeps the conversation version log of one user the way the folder
+// database logs its changes.
+type memConversationVersion struct {
+	controller.ConversationDatabase
+	log *model.VersionLog
+}
+
+func (m *memConversationVersion) FindConversationUserVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error) {
+	vl := *m.log
+	vl.Logs = nil
+	for _, elem := range m.log.Logs {
+		if elem.Version > version {
+			vl.Logs = append(vl.Logs, elem)
+		}
+	}
+	vl.LogLen = len(vl.Logs)
+	return &vl, nil
+}
+
+// FindMaxConversationUserVersionCache returns the version without the logs, as the cache does.
+func (m *memConversationVersion) FindMaxConversationUserVersionCache(ctx context.Context, userID string) (*model.VersionLog, error) {
+	vl := *m.log
+	vl.Logs = nil
+	return &vl, nil
+}
+
+type memFolderDatabase struct {
+	controller.ConversationFolderDatabase
+	version *memConversationVersion
+	folders []*model.ConversationFolder
+}
+
+func (m *memFolderDatabase) CreateFolder(ctx context.Context, folder *model.ConversationFolder, maxFolders int) error {
+	m.folders = append(m.folders, folder)
+	m.version.log.Version++
+	m.version.log.Logs = append(m.version.log.Logs, model.VersionLogElem{EID: model.VersionFolderChangeID, State: model.VersionStateUpdate, Version: m.version.log.Version})
+	return nil
+}
+
+func (m *memFolderDatabase) FindFolders(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error) {
+	return m.folders, nil
+}
+
+func TestGetIncrementalConversationFolders(t *testing.T) {
+	ctx := context.Background()
+	version := &memConversationVersion{log: &model.VersionLog{ID: primitive.NewObjectID(), DID: "u1", Version: 3}}
+	folders := &memFolderDatabase{version: version}
+	c := &conversationServer{conversationDatabase: version, folderDatabase: folders}
+	sync := func(versionNumber uint64) *pbconversation.GetIncrementalConversationResp {
+		resp, err := c.GetIncrementalConversation(ctx, &pbconversation.GetIncrementalConversationReq{UserID: "u1", VersionID: version.log.ID.Hex(), Version: versionNumber})
+		assert.NoError(t, err)
+		return resp
+	}
+
+	resp := sync(3)
+	assert.False(t, resp.FolderChanged)
+	assert.Empty(t, resp.Folders)
+
+	assert.NoError(t, folders.CreateFolder(ctx, &model.ConversationFolder{FolderID: "f1", OwnerUserID: "u1", Name: "work"}, maxConversationFolders))
+	resp = sync(3)
+	assert.False(t, resp.Full)
+	assert.Equal(t, uint64(4), resp.Version)
+	assert.True(t, resp.FolderChanged)
+	assert.Empty(t, resp.Update, "the folder change is not a conversation")
+	if assert.Len(t, resp.Folders, 1) {
+		assert.Equal(t, "f1", resp.Folders[0].FolderID)
+		assert.Equal(t, "work", resp.Folders[0].Name)
+	}
+
+	resp = sync(4)
+	assert.False(t, resp.FolderChanged)
+
+	// a full sync sends the folders too
+	resp = sync(0)
+	assert.True(t, resp.Full)
+	assert.True(t, resp.FolderChanged)
+	assert.Len(t, resp.Folders, 1)
+}
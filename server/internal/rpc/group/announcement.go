@@ -0,0 +1,205 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/encrypt"
+)
+
+func (g *groupServer) newGroupAnnouncement(ctx context.Context, groupID string, content string) *model.GroupAnnouncement {
+	return &model.GroupAnnouncement{
+		AnnouncementID: encrypt.Md5(strings.Join([]string{groupID, mcontext.GetOperationID(ctx), strconv.FormatInt(time.Now().UnixNano(), 10), strconv.Itoa(rand.Int())}, ",;,")),
+		GroupID:        groupID,
+		Content:        content,
+		CreatorUserID:  mcontext.GetOpUserID(ctx),
+		CreateTime:     time.Now(),
+	}
+}
+
+// recordGroupAnnouncement appends an announcement set through the group info to the history.
+// The group info is already updated at this point, so a failure is only logged.
+func (g *groupServer) recordGroupAnnouncement(ctx context.Context, groupID string, content string) *sdkws.GroupAnnouncement {
+	announcement := g.newGroupAnnouncement(ctx, groupID, content)
+	if err := g.db.CreateGroupAnnouncement(ctx, announcement); err != nil {
+		log.ZWarn(ctx, "record group announcement failed", err, "groupID", groupID)
+		return nil
+	}
+	return convert.Db2PbGroupAnnouncement(announcement)
+}
+
+// getUnconfirmedUserIDs returns the current members, except the publisher, who have not confirmed the announcement.
+func (g *groupServer) getUnconfirmedUserIDs(ctx context.Context, announcement *model.GroupAnnouncement) ([]*model.GroupAnnouncementConfirm, []string, error) {
+	confirms, err := g.db.FindGroupAnnouncementConfirm(ctx, announcement.AnnouncementID)
+	if err != nil {
+		return nil, nil, err
+	}
+	memberUserIDs, err := g.db.FindGroupMemberUserID(ctx, announcement.GroupID)
+	if err != nil {
+		return nil, nil, err
+	}
+	confirmed := datautil.SliceSetAny(confirms, func(e *model.GroupAnnouncementConfirm) string {
+		return e.UserID
+	})
+	unconfirmed := make([]string, 0, len(memberUserIDs))
+	for _, userID := range memberUserIDs {
+		if userID == announcement.CreatorUserID {
+			continue
+		}
+		if _, ok := confirmed[userID]; !ok {
+			unconfirmed = append(unconfirmed, userID)
+		}
+	}
+	return confirms, unconfirmed, nil
+}
+
+func (g *groupServer) PublishGroupAnnouncement(ctx context.Context, req *pbgroup.PublishGroupAnnouncementReq) (*pbgroup.PublishGroupAnnouncementResp, error) {
+	if err := g.CheckGroupPermission(ctx, req.GroupID, constant.GroupPermissionPinMsg); err != nil {
+		return nil, err
+	}
+	group, err := g.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	announcement := g.newGroupAnnouncement(ctx, req.GroupID, req.Content)
+	announcement.RequireConfirm = req.RequireConfirm
+	announcement.Ex = req.Ex
+	if err := g.db.PublishGroupAnnouncement(ctx, announcement); err != nil {
+		return nil, err
+	}
+	pbAnnouncement := convert.Db2PbGroupAnnouncement(announcement)
+	groupInfo, err := g.notification.getGroupInfo(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	notificationFlag := true
+	g.notification.GroupInfoSetAnnouncementNotification(ctx, &sdkws.GroupInfoSetAnnouncementTips{Group: groupInfo, Announcement: pbAnnouncement}, &notificationFlag)
+	return &pbgroup.PublishGroupAnnouncementResp{Announcement: pbAnnouncement}, nil
+}
+
+func (g *groupServer) GetGroupAnnouncements(ctx context.Context, req *pbgroup.GetGroupAnnouncementsReq) (*pbgroup.GetGroupAnnouncementsResp, error) {
+	opUserID := mcontext.GetOpUserID(ctx)
+	if !authverify.IsAppManagerUid(ctx, g.config.Share.IMAdminUserID) {
+		if _, err := g.db.TakeGroupMember(ctx, req.GroupID, opUserID); err != nil {
+			return nil, err
+		}
+	}
+	total, announcements, err := g.db.PageGroupAnnouncement(ctx, req.GroupID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	confirmedIDs, err := g.db.FindConfirmedGroupAnnouncementIDs(ctx, opUserID, datautil.Slice(announcements, func(e *model.GroupAnnouncement) string {
+		return e.AnnouncementID
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return &pbgroup.GetGroupAnnouncementsResp{
+		Total:                    uint32(total),
+		Announcements:            datautil.Slice(announcements, convert.Db2PbGroupAnnouncement),
+		ConfirmedAnnouncementIDs: confirmedIDs,
+	}, nil
+}
+
+func (g *groupServer) ConfirmGroupAnnouncement(ctx context.Context, req *pbgroup.ConfirmGroupAnnouncementReq) (*pbgroup.ConfirmGroupAnnouncementResp, error) {
+	opUserID := mcontext.GetOpUserID(ctx)
+	if _, err := g.db.TakeGroupMember(ctx, req.GroupID, opUserID); err != nil {
+		return nil, err
+	}
+	announcement, err := g.db.TakeGroupAnnouncement(ctx, req.GroupID, req.AnnouncementID)
+	if err != nil {
+		return nil, err
+	}
+	if !announcement.RequireConfirm {
+		return nil, errs.ErrArgs.WrapMsg("announcement does not require confirmation")
+	}
+	confirm := &model.GroupAnnouncementConfirm{
+		AnnouncementID: announcement.AnnouncementID,
+		GroupID:        announcement.GroupID,
+		UserID:         opUserID,
+		ConfirmTime:    time.Now(),
+	}
+	if err := g.db.ConfirmGroupAnnouncement(ctx, confirm); err != nil {
+		return nil, err
+	}
+	if announcement.CreatorUserID != opUserID {
+		g.notification.GroupAnnouncementConfirmedNotification(ctx, convert.Db2PbGroupAnnouncement(announcement), confirm.ConfirmTime.UnixMilli())
+	}
+	return &pbgroup.ConfirmGroupAnnouncementResp{}, nil
+}
+
+func (g *groupServer) GetGroupAnnouncementConfirms(ctx context.Context, req *pbgroup.GetGroupAnnouncementConfirmsReq) (*pbgroup.GetGroupAnnouncementConfirmsResp, error) {
+	if err := g.CheckGroupPermission(ctx, req.GroupID, constant.GroupPermissionPinMsg); err != nil {
+		return nil, err
+	}
+	announcement, err := g.db.TakeGroupAnnouncement(ctx, req.GroupID, req.AnnouncementID)
+	if err != nil {
+		return nil, err
+	}
+	confirms, unconfirmed, err := g.getUnconfirmedUserIDs(ctx, announcement)
+	if err != nil {
+		return nil, err
+	}
+	return &pbgroup.GetGroupAnnouncementConfirmsResp{
+		Confirms: datautil.Slice(confirms, func(e *model.GroupAnnouncementConfirm) *pbgroup.GroupAnnouncementConfirm {
+			return &pbgroup.GroupAnnouncementConfirm{UserID: e.UserID, ConfirmTime: e.ConfirmTime.UnixMilli()}
+		}),
+		UnconfirmedUserIDs: unconfirmed,
+	}, nil
+}
+
+func (g *groupServer) RemindGroupAnnouncement(ctx context.Context, req *pbgroup.RemindGroupAnnouncementReq) (*pbgroup.RemindGroupAnnouncementResp, error) {
+	if err := g.CheckGroupPermission(ctx, req.GroupID, constant.GroupPermissionPinMsg); err != nil {
+		return nil, err
+	}
+	announcement, err := g.db.TakeGroupAnnouncement(ctx, req.GroupID, req.AnnouncementID)
+	if err != nil {
+		return nil, err
+	}
+	if !announcement.RequireConfirm {
+		return nil, errs.ErrArgs.WrapMsg("announcement does not require confirmation")
+	}
+	_, unconfirmed, err := g.getUnconfirmedUserIDs(ctx, announcement)
+	if err != nil {
+		return nil, err
+	}
+	if len(unconfirmed) == 0 {
+		return &pbgroup.RemindGroupAnnouncementResp{}, nil
+	}
+	reminded, err := g.notification.GroupAnnouncementRemindNotification(ctx, convert.Db2PbGroupAnnouncement(announcement), unconfirmed)
+	if err != nil {
+		return nil, err
+	}
+	return &pbgroup.RemindGroupAnnouncementResp{RemindedUserIDs: reminded}, nil
+}
@@ -55,6 +55,7 @@ func (s *groupServer) groupMemberDB2PB(member *model.GroupMember, appMangerLevel
 		Ex:             member.Ex,
 		MuteEndTime:    member.MuteEndTime.UnixMilli(),
 		InviterUserID:  member.InviterUserID,
+		Permissions:    member.Permissions,
 	}
 }
 
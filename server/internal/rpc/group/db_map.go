@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	pbgroup "github.com/openimsdk/protocol/group"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/errs"
@@ -126,6 +127,7 @@ func UpdateGroupMemberMap(req *pbgroup.SetGroupMemberInfo) map[string]any {
 	}
 	if req.RoleLevel != nil {
 		m["role_level"] = req.RoleLevel.Value
+		m["permissions"] = model.DefaultGroupPermissions(req.RoleLevel.Value)
 	}
 	if req.Ex != nil {
 		m["ex"] = req.Ex.Value
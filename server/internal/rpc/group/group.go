@@ -65,6 +65,7 @@ type groupServer struct {
 	userClient         *rpcli.UserClient
 	msgClient          *rpcli.MsgClient
 	conversationClient *rpcli.ConversationClient
+	thirdClient        *rpcli.ThirdClient
 }
 
 type Config struct {
@@ -99,6 +100,22 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	groupInviteDB, err := mgo.NewGroupInviteMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	announcementDB, err := mgo.NewGroupAnnouncementMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	announcementConfirmDB, err := mgo.NewGroupAnnouncementConfirmMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	importJobDB, err := mgo.NewGroupImportJobMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 
 	//userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
 	//msgRpcClient := rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
@@ -116,17 +133,23 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	thirdConn, err := client.GetConn(ctx, config.Share.RpcRegisterName.Third)
+	if err != nil {
+		return err
+	}
 	gs := groupServer{
 		config:             config,
 		webhookClient:      webhook.NewWebhookClient(config.WebhooksConfig.URL),
 		userClient:         rpcli.NewUserClient(userConn),
 		msgClient:          rpcli.NewMsgClient(msgConn),
 		conversationClient: rpcli.NewConversationClient(conversationConn),
+		thirdClient:        rpcli.NewThirdClient(thirdConn),
 	}
-	gs.db = controller.NewGroupDatabase(rdb, &config.LocalCacheConfig, groupDB, groupMemberDB, groupRequestDB, mgocli.GetTx(), grouphash.NewGroupHashFromGroupServer(&gs))
+	gs.db = controller.NewGroupDatabase(rdb, &config.LocalCacheConfig, groupDB, groupMemberDB, groupRequestDB, groupInviteDB, announcementDB, announcementConfirmDB, importJobDB, mgocli.GetTx(), grouphash.NewGroupHashFromGroupServer(&gs))
 	gs.notification = NewNotificationSender(gs.db, config, gs.userClient, gs.msgClient, gs.conversationClient)
 	localcache.InitLocalCache(&config.LocalCacheConfig)
 	pbgroup.RegisterGroupServer(server, &gs)
+	go gs.recoverGroupImports(ctx)
 	return nil
 }
 
@@ -169,6 +192,20 @@ func (g *groupServer) CheckGroupAdmin(ctx context.Context, groupID string) error
 	return nil
 }
 
+// CheckGroupPermission is like CheckGroupAdmin, but also requires an admin operator to hold perm.
+func (g *groupServer) CheckGroupPermission(ctx context.Context, groupID string, perm int32) error {
+	if !authverify.IsAppManagerUid(ctx, g.config.Share.IMAdminUserID) {
+		groupMember, err := g.db.TakeGroupMember(ctx, groupID, mcontext.GetOpUserID(ctx))
+		if err != nil {
+			return err
+		}
+		if !authverify.HasGroupPermission(groupMember.RoleLevel, groupMember.Permissions, perm) {
+			return errs.ErrNoPermission.WrapMsg("no group owner or admin with permission")
+		}
+	}
+	return nil
+}
+
 func (g *groupServer) IsNotFound(err error) bool {
 	return errs.ErrRecordNotFound.Is(specialerror.ErrCode(errs.Unwrap(err)))
 }
@@ -237,6 +274,9 @@ func (g *groupServer) CreateGroup(ctx context.Context, req *pbgroup.CreateGroupR
 
 	var groupMembers []*model.GroupMember
 	group := convert.Pb2DBGroupInfo(req.GroupInfo)
+	if err := g.checkCreateGroupLimits(ctx, group, req); err != nil {
+		return nil, err
+	}
 	if err := g.GenGroupID(ctx, &group.GroupID); err != nil {
 		return nil, err
 	}
@@ -246,6 +286,7 @@ func (g *groupServer) CreateGroup(ctx context.Context, req *pbgroup.CreateGroupR
 			GroupID:        group.GroupID,
 			UserID:         userID,
 			RoleLevel:      roleLevel,
+			Permissions:    model.DefaultGroupPermissions(roleLevel),
 			OperatorUserID: opUserID,
 			JoinSource:     constant.JoinByInvitation,
 			InviterUserID:  opUserID,
@@ -295,8 +336,9 @@ func (g *groupServer) CreateGroup(ctx context.Context, req *pbgroup.CreateGroupR
 	if req.GroupInfo.Notification != "" {
 		notificationFlag := true
 		g.notification.GroupInfoSetAnnouncementNotification(ctx, &sdkws.GroupInfoSetAnnouncementTips{
-			Group:  tips.Group,
-			OpUser: tips.OpUser,
+			Group:        tips.Group,
+			OpUser:       tips.OpUser,
+			Announcement: g.recordGroupAnnouncement(ctx, tips.Group.GroupID, req.GroupInfo.Notification),
 		}, &notificationFlag)
 	}
 
@@ -403,7 +445,7 @@ func (g *groupServer) InviteUserToGroup(ctx context.Context, req *pbgroup.Invite
 
 	if group.NeedVerification == constant.AllNeedVerification {
 		if !authverify.IsAppManagerUid(ctx, g.config.Share.IMAdminUserID) {
-			if !(groupMember.RoleLevel == constant.GroupOwner || groupMember.RoleLevel == constant.GroupAdmin) {
+			if !authverify.HasGroupPermission(groupMember.RoleLevel, groupMember.Permissions, constant.GroupPermissionApproveJoin) {
 				var requests []*model.GroupRequest
 				for _, userID := range req.InvitedUserIDs {
 					requests = append(requests, &model.GroupRequest{
@@ -450,7 +492,14 @@ func (g *groupServer) InviteUserToGroup(ctx context.Context, req *pbgroup.Invite
 		return nil, err
 	}
 
-	if err := g.db.CreateGroup(ctx, nil, groupMembers); err != nil {
+	if err := g.checkJoinedGroupLimit(ctx, req.InvitedUserIDs...); err != nil {
+		return nil, err
+	}
+	maxMemberCount, err := g.groupMemberLimit(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.db.AddGroupMembers(ctx, req.GroupID, groupMembers, maxMemberCount); err != nil {
 		return nil, err
 	}
 
@@ -567,6 +616,9 @@ func (g *groupServer) KickGroupMember(ctx context.Context, req *pbgroup.KickGrou
 			switch opMember.RoleLevel {
 			case constant.GroupOwner:
 			case constant.GroupAdmin:
+				if !authverify.HasGroupPermission(opMember.RoleLevel, opMember.Permissions, constant.GroupPermissionKick) {
+					return nil, errs.ErrNoPermission.WrapMsg("group admin has no kick permission")
+				}
 				if member.RoleLevel == constant.GroupOwner || member.RoleLevel == constant.GroupAdmin {
 					return nil, errs.ErrNoPermission.WrapMsg("group admins cannot remove the group owner and other admins")
 				}
@@ -776,8 +828,8 @@ func (g *groupServer) GroupApplicationResponse(ctx context.Context, req *pbgroup
 		if err != nil {
 			return nil, err
 		}
-		if !(groupMember.RoleLevel == constant.GroupOwner || groupMember.RoleLevel == constant.GroupAdmin) {
-			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin")
+		if !authverify.HasGroupPermission(groupMember.RoleLevel, groupMember.Permissions, constant.GroupPermissionApproveJoin) {
+			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin with approve permission")
 		}
 	}
 	group, err := g.db.TakeGroup(ctx, req.GroupID)
@@ -818,9 +870,16 @@ func (g *groupServer) GroupApplicationResponse(ctx context.Context, req *pbgroup
 		if err := g.webhookBeforeMembersJoinGroup(ctx, &g.config.WebhooksConfig.BeforeMemberJoinGroup, []*model.GroupMember{member}, group.GroupID, group.Ex); err != nil && err != servererrs.ErrCallbackContinue {
 			return nil, err
 		}
+		if err := g.checkJoinedGroupLimit(ctx, req.FromUserID); err != nil {
+			return nil, err
+		}
+	}
+	maxMemberCount, err := g.groupMemberLimit(ctx, group)
+	if err != nil {
+		return nil, err
 	}
 	log.ZDebug(ctx, "GroupApplicationResponse", "inGroup", inGroup, "HandleResult", req.HandleResult, "member", member)
-	if err := g.db.HandlerGroupRequest(ctx, req.GroupID, req.FromUserID, req.HandledMsg, req.HandleResult, member); err != nil {
+	if err := g.db.HandlerGroupRequest(ctx, req.GroupID, req.FromUserID, req.HandledMsg, req.HandleResult, member, maxMemberCount); err != nil {
 		return nil, err
 	}
 	switch req.HandleResult {
@@ -893,7 +952,14 @@ func (g *groupServer) JoinGroup(ctx context.Context, req *pbgroup.JoinGroupReq)
 			return nil, err
 		}
 
-		if err := g.db.CreateGroup(ctx, nil, []*model.GroupMember{groupMember}); err != nil {
+		if err := g.checkJoinedGroupLimit(ctx, groupMember.UserID); err != nil {
+			return nil, err
+		}
+		maxMemberCount, err := g.groupMemberLimit(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.db.AddGroupMembers(ctx, group.GroupID, []*model.GroupMember{groupMember}, maxMemberCount); err != nil {
 			return nil, err
 		}
 
@@ -969,8 +1035,11 @@ func (g *groupServer) SetGroupInfo(ctx context.Context, req *pbgroup.SetGroupInf
 		if err != nil {
 			return nil, err
 		}
-		if !(opMember.RoleLevel == constant.GroupOwner || opMember.RoleLevel == constant.GroupAdmin) {
-			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin")
+		if !authverify.HasGroupPermission(opMember.RoleLevel, opMember.Permissions, constant.GroupPermissionEditInfo) {
+			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin with edit permission")
+		}
+		if req.GroupInfoForSet.Notification != "" && !authverify.HasGroupPermission(opMember.RoleLevel, opMember.Permissions, constant.GroupPermissionPinMsg) {
+			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin with pin permission")
 		}
 		if err := g.PopulateGroupMember(ctx, opMember); err != nil {
 			return nil, err
@@ -1039,7 +1108,8 @@ func (g *groupServer) SetGroupInfo(ctx context.Context, req *pbgroup.SetGroupInf
 			}
 		}()
 		notficationFlag := true
-		g.notification.GroupInfoSetAnnouncementNotification(ctx, &sdkws.GroupInfoSetAnnouncementTips{Group: tips.Group, OpUser: tips.OpUser}, &notficationFlag)
+		announcement := g.recordGroupAnnouncement(ctx, req.GroupInfoForSet.GroupID, req.GroupInfoForSet.Notification)
+		g.notification.GroupInfoSetAnnouncementNotification(ctx, &sdkws.GroupInfoSetAnnouncementTips{Group: tips.Group, OpUser: tips.OpUser, Announcement: announcement}, &notficationFlag)
 	}
 	if req.GroupInfoForSet.GroupName != "" {
 		num--
@@ -1065,8 +1135,12 @@ func (g *groupServer) SetGroupInfoEx(ctx context.Context, req *pbgroup.SetGroupI
 			return nil, err
 		}
 
-		if !(opMember.RoleLevel == constant.GroupOwner || opMember.RoleLevel == constant.GroupAdmin) {
-			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin")
+		if !authverify.HasGroupPermission(opMember.RoleLevel, opMember.Permissions, constant.GroupPermissionEditInfo) {
+			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin with edit permission")
+		}
+
+		if req.Notification != nil && !authverify.HasGroupPermission(opMember.RoleLevel, opMember.Permissions, constant.GroupPermissionPinMsg) {
+			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin with pin permission")
 		}
 
 		if err := g.PopulateGroupMember(ctx, opMember); err != nil {
@@ -1147,7 +1221,8 @@ func (g *groupServer) SetGroupInfoEx(ctx context.Context, req *pbgroup.SetGroupI
 				log.ZWarn(ctx, "SetConversations", err, "UserIDs", resp.UserIDs, "conversation", conversation)
 			}
 
-			g.notification.GroupInfoSetAnnouncementNotification(ctx, &sdkws.GroupInfoSetAnnouncementTips{Group: tips.Group, OpUser: tips.OpUser}, &notificationFlag)
+			announcement := g.recordGroupAnnouncement(ctx, req.GroupID, req.Notification.Value)
+			g.notification.GroupInfoSetAnnouncementNotification(ctx, &sdkws.GroupInfoSetAnnouncementTips{Group: tips.Group, OpUser: tips.OpUser, Announcement: announcement}, &notificationFlag)
 		} else {
 			notificationFlag = false
 			g.notification.GroupInfoSetAnnouncementNotification(ctx, &sdkws.GroupInfoSetAnnouncementTips{Group: tips.Group, OpUser: tips.OpUser}, &notificationFlag)
@@ -1418,7 +1493,7 @@ func (g *groupServer) MuteGroupMember(ctx context.Context, req *pbgroup.MuteGrou
 				return nil, errs.ErrNoPermission.WrapMsg("set group admin mute")
 			}
 		case constant.GroupOrdinaryUsers:
-			if !(opMember.RoleLevel == constant.GroupAdmin || opMember.RoleLevel == constant.GroupOwner) {
+			if !authverify.HasGroupPermission(opMember.RoleLevel, opMember.Permissions, constant.GroupPermissionMute) {
 				return nil, errs.ErrNoPermission.WrapMsg("set group ordinary users mute")
 			}
 		}
@@ -1455,7 +1530,7 @@ func (g *groupServer) CancelMuteGroupMember(ctx context.Context, req *pbgroup.Ca
 				return nil, errs.ErrNoPermission.WrapMsg("Can not set group admin unmute")
 			}
 		case constant.GroupOrdinaryUsers:
-			if !(opMember.RoleLevel == constant.GroupAdmin || opMember.RoleLevel == constant.GroupOwner) {
+			if !authverify.HasGroupPermission(opMember.RoleLevel, opMember.Permissions, constant.GroupPermissionMute) {
 				return nil, errs.ErrNoPermission.WrapMsg("Can not set group ordinary users unmute")
 			}
 		}
@@ -1472,7 +1547,7 @@ func (g *groupServer) CancelMuteGroupMember(ctx context.Context, req *pbgroup.Ca
 }
 
 func (g *groupServer) MuteGroup(ctx context.Context, req *pbgroup.MuteGroupReq) (*pbgroup.MuteGroupResp, error) {
-	if err := g.CheckGroupAdmin(ctx, req.GroupID); err != nil {
+	if err := g.CheckGroupPermission(ctx, req.GroupID, constant.GroupPermissionMute); err != nil {
 		return nil, err
 	}
 	if err := g.db.UpdateGroup(ctx, req.GroupID, UpdateGroupStatusMap(constant.GroupStatusMuted)); err != nil {
@@ -1483,7 +1558,7 @@ func (g *groupServer) MuteGroup(ctx context.Context, req *pbgroup.MuteGroupReq)
 }
 
 func (g *groupServer) CancelMuteGroup(ctx context.Context, req *pbgroup.CancelMuteGroupReq) (*pbgroup.CancelMuteGroupResp, error) {
-	if err := g.CheckGroupAdmin(ctx, req.GroupID); err != nil {
+	if err := g.CheckGroupPermission(ctx, req.GroupID, constant.GroupPermissionMute); err != nil {
 		return nil, err
 	}
 	if err := g.db.UpdateGroup(ctx, req.GroupID, UpdateGroupStatusMap(constant.GroupOk)); err != nil {
@@ -1493,6 +1568,33 @@ func (g *groupServer) CancelMuteGroup(ctx context.Context, req *pbgroup.CancelMu
 	return &pbgroup.CancelMuteGroupResp{}, nil
 }
 
+func (g *groupServer) SetGroupAdminPermissions(ctx context.Context, req *pbgroup.SetGroupAdminPermissionsReq) (*pbgroup.SetGroupAdminPermissionsResp, error) {
+	if !authverify.IsAppManagerUid(ctx, g.config.Share.IMAdminUserID) {
+		opMember, err := g.db.TakeGroupMember(ctx, req.GroupID, mcontext.GetOpUserID(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if opMember.RoleLevel != constant.GroupOwner {
+			return nil, errs.ErrNoPermission.WrapMsg("only the group owner can set admin permissions")
+		}
+	}
+	member, err := g.db.TakeGroupMember(ctx, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if member.RoleLevel != constant.GroupAdmin {
+		return nil, errs.ErrArgs.WrapMsg("user is not a group admin")
+	}
+	if req.Permissions < 0 || req.Permissions&^constant.GroupPermissionAll != 0 {
+		return nil, errs.ErrArgs.WrapMsg("invalid permissions", "permissions", req.Permissions)
+	}
+	if err := g.db.UpdateGroupMember(ctx, req.GroupID, req.UserID, map[string]any{"permissions": req.Permissions}); err != nil {
+		return nil, err
+	}
+	g.notification.GroupMemberInfoSetNotification(ctx, req.GroupID, req.UserID)
+	return &pbgroup.SetGroupAdminPermissionsResp{}, nil
+}
+
 func (g *groupServer) SetGroupMemberInfo(ctx context.Context, req *pbgroup.SetGroupMemberInfoReq) (*pbgroup.SetGroupMemberInfoResp, error) {
 	if len(req.Members) == 0 {
 		return nil, errs.ErrArgs.WrapMsg("members empty")
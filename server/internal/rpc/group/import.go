@@ -0,0 +1,568 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/protocol/third"
+	pbuser "github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/protocol/wrapperspb"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/encrypt"
+)
+
+const (
+	maxRosterSize         = 32 << 20
+	maxRosterRows         = 100000
+	maxImportRowErrors    = 1000
+	importBatchSize       = 100
+	rosterDownloadTimeout = time.Minute * 2
+	importLease           = time.Minute * 2
+	importLeaseRenew      = time.Second * 30
+	maxImportAttempts     = 3
+)
+
+func (g *groupServer) ImportGroupMembers(ctx context.Context, req *pbgroup.ImportGroupMembersReq) (*pbgroup.ImportGroupMembersResp, error) {
+	if err := authverify.CheckAdmin(ctx, g.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	job := &model.GroupImportJob{
+		JobID:       encrypt.Md5(strings.Join([]string{req.ObjectName, mcontext.GetOperationID(ctx), strconv.FormatInt(now.UnixNano(), 10)}, ",")),
+		ObjectName:  req.ObjectName,
+		Format:      rosterFormat(req.Format, req.ObjectName),
+		Status:      constant.GroupImportJobRunning,
+		OpUserID:    mcontext.GetOpUserID(ctx),
+		Attempts:    1,
+		LeaseExpire: now.Add(importLease),
+		CreateTime:  now,
+		UpdateTime:  now,
+	}
+	if err := g.db.CreateGroupImportJob(ctx, job); err != nil {
+		return nil, err
+	}
+	go g.runGroupImport(context.WithoutCancel(ctx), job)
+	return &pbgroup.ImportGroupMembersResp{JobID: job.JobID}, nil
+}
+
+func (g *groupServer) GetGroupImportJob(ctx context.Context, req *pbgroup.GetGroupImportJobReq) (*pbgroup.GetGroupImportJobResp, error) {
+	if err := authverify.CheckAdmin(ctx, g.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	job, err := g.db.TakeGroupImportJob(ctx, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	return &pbgroup.GetGroupImportJobResp{Job: convert.Db2PbGroupImportJob(job)}, nil
+}
+
+func (g *groupServer) ExportGroupMembers(ctx context.Context, req *pbgroup.ExportGroupMembersReq) (*pbgroup.ExportGroupMembersResp, error) {
+	if err := authverify.CheckAdmin(ctx, g.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	groupIDs := datautil.Distinct(req.GroupIDs)
+	groups, err := g.db.FindGroup(ctx, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	groupMap := datautil.SliceToMap(groups, func(e *model.Group) string { return e.GroupID })
+	if ids := datautil.Single(groupIDs, datautil.Keys(groupMap)); len(ids) > 0 {
+		return nil, servererrs.ErrGroupIDNotFound.WrapMsg("group not found", "groupIDs", ids)
+	}
+	var (
+		members []*model.GroupMember
+		userIDs []string
+	)
+	for _, groupID := range groupIDs {
+		groupMembers, err := g.db.FindGroupMemberAll(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, groupMembers...)
+		for _, member := range groupMembers {
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+	userMap, err := g.userClient.GetUsersInfoMap(ctx, datautil.Distinct(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*rosterRow, 0, len(members))
+	for _, member := range members {
+		row := &rosterRow{
+			UserID:    member.UserID,
+			GroupID:   member.GroupID,
+			GroupName: groupMap[member.GroupID].GroupName,
+			Role:      rosterRole(member.RoleLevel),
+		}
+		if user, ok := userMap[member.UserID]; ok {
+			row.Nickname = user.Nickname
+			row.FaceURL = user.FaceURL
+		}
+		rows = append(rows, row)
+	}
+	content, err := formatRoster(rosterFormat(req.Format, ""), rows)
+	if err != nil {
+		return nil, err
+	}
+	return &pbgroup.ExportGroupMembersResp{Content: string(content)}, nil
+}
+
+// runGroupImport applies a roster in the background. Rows go through the same handlers as the
+// API calls, so access checks, member limits, webhooks and notifications all apply. Users, groups
+// and memberships that already exist as requested are skipped, which makes re-running a job on
+// the same roster safe, e.g. after the job was interrupted by a restart.
+func (g *groupServer) runGroupImport(ctx context.Context, job *model.GroupImportJob) {
+	stop := g.renewGroupImportLease(ctx, job.JobID)
+	defer stop()
+	defer func() {
+		if r := recover(); r != nil {
+			log.ZPanic(ctx, "runGroupImport Panic", errs.ErrPanic(r))
+			g.failGroupImport(ctx, job, "import aborted by an internal error")
+		}
+	}()
+	rows, err := g.loadRoster(ctx, job.ObjectName, job.Format)
+	if err != nil {
+		log.ZWarn(ctx, "load group import roster failed", err, "jobID", job.JobID, "objectName", job.ObjectName)
+		g.failGroupImport(ctx, job, importErrMsg(err))
+		return
+	}
+	im := &groupImport{g: g, job: job, rows: rows, done: make([]bool, len(rows))}
+	job.Total = int32(len(rows))
+	im.save(ctx)
+	im.validate()
+	im.registerUsers(ctx)
+	im.joinGroups(ctx)
+	job.Status = constant.GroupImportJobFinished
+	im.save(ctx)
+	log.ZInfo(ctx, "group import finished", "jobID", job.JobID, "total", job.Total, "succeeded", job.Succeeded, "skipped", job.Skipped, "failed", job.Failed)
+}
+
+// renewGroupImportLease keeps the lease of a running job alive until the returned func is called.
+func (g *groupServer) renewGroupImportLease(ctx context.Context, jobID string) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(importLeaseRenew)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				data := map[string]any{"lease_expire": time.Now().Add(importLease)}
+				if err := g.db.UpdateGroupImportJob(ctx, jobID, data); err != nil {
+					log.ZWarn(ctx, "renew group import lease failed", err, "jobID", jobID)
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// recoverGroupImports takes over the jobs left running by an instance that stopped, until ctx is done.
+// Re-running an import is safe, a job that keeps getting interrupted fails after maxImportAttempts.
+func (g *groupServer) recoverGroupImports(ctx context.Context) {
+	ticker := time.NewTicker(importLeaseRenew)
+	defer ticker.Stop()
+	for {
+		g.recoverExpiredGroupImports(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (g *groupServer) recoverExpiredGroupImports(ctx context.Context) {
+	for {
+		now := time.Now()
+		job, err := g.db.TakeExpiredGroupImportJob(ctx, now, now.Add(importLease))
+		if err != nil {
+			log.ZError(ctx, "take expired group import job failed", err)
+			return
+		}
+		if job == nil {
+			return
+		}
+		jobCtx := mcontext.SetOpUserID(mcontext.NewCtx("recover-import-"+job.JobID), job.OpUserID)
+		if job.Attempts > maxImportAttempts {
+			log.ZWarn(jobCtx, "group import interrupted too often", nil, "jobID", job.JobID, "attempts", job.Attempts)
+			g.failGroupImport(jobCtx, job, "import interrupted")
+			continue
+		}
+		log.ZInfo(jobCtx, "resume interrupted group import", "jobID", job.JobID, "attempts", job.Attempts)
+		job.Processed, job.Succeeded, job.Skipped, job.Failed, job.RowErrors = 0, 0, 0, 0, nil
+		go g.runGroupImport(jobCtx, job)
+	}
+}
+
+func (g *groupServer) failGroupImport(ctx context.Context, job *model.GroupImportJob, errMsg string) {
+	data := map[string]any{"status": constant.GroupImportJobFailed, "err_msg": errMsg, "update_time": time.Now()}
+	if err := g.db.UpdateGroupImportJob(ctx, job.JobID, data); err != nil {
+		log.ZError(ctx, "update group import job failed", err, "jobID", job.JobID)
+	}
+}
+
+// loadRoster downloads a roster from object storage and parses it.
+func (g *groupServer) loadRoster(ctx context.Context, objectName string, format string) ([]*rosterRow, error) {
+	resp, err := g.thirdClient.AccessURL(ctx, &third.AccessURLReq{Name: objectName})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, rosterDownloadTimeout)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, resp.Url, nil)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid roster url")
+	}
+	httpResp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "download roster failed")
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, errs.New("download roster failed", "status", httpResp.Status).Wrap()
+	}
+	data, err := io.ReadAll(io.LimitReader(httpResp.Body, maxRosterSize+1))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "download roster failed")
+	}
+	if len(data) > maxRosterSize {
+		return nil, errs.ErrArgs.WrapMsg("roster too large", "maxSize", maxRosterSize)
+	}
+	rows, err := parseRoster(format, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) > maxRosterRows {
+		return nil, errs.ErrArgs.WrapMsg("roster has too many rows", "rows", len(rows), "maxRows", maxRosterRows)
+	}
+	return rows, nil
+}
+
+// importErrMsg drops the call stack from err, it does not help whoever fixes the roster.
+func importErrMsg(err error) string {
+	for {
+		cause, ok := err.(interface{ Cause() error })
+		if !ok {
+			return err.Error()
+		}
+		err = cause.Cause()
+	}
+}
+
+// groupImport is the state of one running import job, done marks the rows that have an outcome.
+type groupImport struct {
+	g    *groupServer
+	job  *model.GroupImportJob
+	rows []*rosterRow
+	done []bool
+}
+
+func (im *groupImport) fail(i int, err error) {
+	if im.done[i] {
+		return
+	}
+	im.done[i] = true
+	im.job.Processed++
+	im.job.Failed++
+	if len(im.job.RowErrors) < maxImportRowErrors {
+		im.job.RowErrors = append(im.job.RowErrors, model.GroupImportRowError{
+			Row:     int32(i + 1),
+			UserID:  im.rows[i].UserID,
+			GroupID: im.rows[i].GroupID,
+			ErrMsg:  importErrMsg(err),
+		})
+	}
+}
+
+func (im *groupImport) succeed(i int, skipped bool) {
+	if im.done[i] {
+		return
+	}
+	im.done[i] = true
+	im.job.Processed++
+	if skipped {
+		im.job.Skipped++
+	} else {
+		im.job.Succeeded++
+	}
+}
+
+// save stores the progress of the job, a failure only delays the progress report.
+func (im *groupImport) save(ctx context.Context) {
+	job := im.job
+	job.UpdateTime = time.Now()
+	data := map[string]any{
+		"status":      job.Status,
+		"total":       job.Total,
+		"processed":   job.Processed,
+		"succeeded":   job.Succeeded,
+		"skipped":     job.Skipped,
+		"failed":      job.Failed,
+		"row_errors":  job.RowErrors,
+		"update_time": job.UpdateTime,
+	}
+	if err := im.g.db.UpdateGroupImportJob(ctx, job.JobID, data); err != nil {
+		log.ZError(ctx, "update group import job failed", err, "jobID", job.JobID)
+	}
+}
+
+// validate fails the rows that can never succeed, including repeated memberships.
+func (im *groupImport) validate() {
+	seen := make(map[[2]string]struct{})
+	for i, row := range im.rows {
+		if row.UserID == "" {
+			im.fail(i, errs.ErrArgs.WrapMsg("userID is empty"))
+			continue
+		}
+		if row.GroupID == "" {
+			im.fail(i, errs.ErrArgs.WrapMsg("groupID is empty"))
+			continue
+		}
+		if _, err := rosterRoleLevel(row.Role); err != nil {
+			im.fail(i, err)
+			continue
+		}
+		key := [2]string{row.GroupID, row.UserID}
+		if _, ok := seen[key]; ok {
+			im.fail(i, errs.ErrArgs.WrapMsg("user repeated in group"))
+			continue
+		}
+		seen[key] = struct{}{}
+	}
+}
+
+// registerUsers registers the users of the roster that do not exist yet.
+// Rows of a user that cannot be registered fail.
+func (im *groupImport) registerUsers(ctx context.Context) {
+	userRows := make(map[string][]int)
+	var userIDs []string
+	for i, row := range im.rows {
+		if im.done[i] {
+			continue
+		}
+		if _, ok := userRows[row.UserID]; !ok {
+			userIDs = append(userIDs, row.UserID)
+		}
+		userRows[row.UserID] = append(userRows[row.UserID], i)
+	}
+	failUser := func(userID string, err error) {
+		for _, i := range userRows[userID] {
+			im.fail(i, err)
+		}
+	}
+	for _, batch := range splitBatches(userIDs, importBatchSize) {
+		resp, err := im.g.userClient.AccountCheck(ctx, &pbuser.AccountCheckReq{CheckUserIDs: batch})
+		if err != nil {
+			for _, userID := range batch {
+				failUser(userID, err)
+			}
+			continue
+		}
+		var users []*sdkws.UserInfo
+		for _, result := range resp.Results {
+			if result.AccountStatus == constant.Registered {
+				continue
+			}
+			row := im.rows[userRows[result.UserID][0]]
+			users = append(users, &sdkws.UserInfo{UserID: row.UserID, Nickname: row.Nickname, FaceURL: row.FaceURL})
+		}
+		if len(users) == 0 {
+			continue
+		}
+		if _, err := im.g.userClient.UserRegister(ctx, &pbuser.UserRegisterReq{Users: users}); err == nil {
+			continue
+		}
+		// find out which users are to blame
+		for _, user := range users {
+			if _, err := im.g.userClient.UserRegister(ctx, &pbuser.UserRegisterReq{Users: []*sdkws.UserInfo{user}}); err != nil {
+				failUser(user.UserID, err)
+			}
+		}
+	}
+	im.save(ctx)
+}
+
+// joinGroups creates the missing groups and brings the members of the existing ones in line with the roster.
+func (im *groupImport) joinGroups(ctx context.Context) {
+	groupRows := make(map[string][]int)
+	var groupIDs []string
+	for i, row := range im.rows {
+		if im.done[i] {
+			continue
+		}
+		if _, ok := groupRows[row.GroupID]; !ok {
+			groupIDs = append(groupIDs, row.GroupID)
+		}
+		groupRows[row.GroupID] = append(groupRows[row.GroupID], i)
+	}
+	for _, groupID := range groupIDs {
+		rows := groupRows[groupID]
+		group, err := im.g.db.TakeGroup(ctx, groupID)
+		if err == nil {
+			im.updateGroup(ctx, group, rows)
+		} else if im.g.IsNotFound(err) {
+			im.createGroup(ctx, groupID, rows)
+		} else {
+			for _, i := range rows {
+				im.fail(i, err)
+			}
+		}
+		im.save(ctx)
+	}
+}
+
+func (im *groupImport) createGroup(ctx context.Context, groupID string, rows []int) {
+	req := &pbgroup.CreateGroupReq{GroupInfo: &sdkws.GroupInfo{GroupID: groupID, GroupType: constant.WorkingGroup}}
+	for _, i := range rows {
+		row := im.rows[i]
+		roleLevel, _ := rosterRoleLevel(row.Role)
+		switch roleLevel {
+		case constant.GroupOwner:
+			if req.OwnerUserID != "" {
+				im.fail(i, errs.ErrArgs.WrapMsg("group has more than one owner"))
+				continue
+			}
+			req.OwnerUserID = row.UserID
+		case constant.GroupAdmin:
+			req.AdminUserIDs = append(req.AdminUserIDs, row.UserID)
+		default:
+			req.MemberUserIDs = append(req.MemberUserIDs, row.UserID)
+		}
+		if req.GroupInfo.GroupName == "" {
+			req.GroupInfo.GroupName = row.GroupName
+		}
+	}
+	var err error
+	if req.OwnerUserID == "" {
+		err = errs.ErrArgs.WrapMsg("group does not exist and has no owner row")
+	} else {
+		_, err = im.g.CreateGroup(ctx, req)
+	}
+	for _, i := range rows {
+		if err != nil {
+			im.fail(i, err)
+		} else {
+			im.succeed(i, false)
+		}
+	}
+}
+
+func (im *groupImport) updateGroup(ctx context.Context, group *model.Group, rows []int) {
+	if group.Status == constant.GroupStatusDismissed {
+		for _, i := range rows {
+			im.fail(i, servererrs.ErrDismissedAlready.WrapMsg("group dismissed"))
+		}
+		return
+	}
+	userIDs := make([]string, 0, len(rows))
+	for _, i := range rows {
+		userIDs = append(userIDs, im.rows[i].UserID)
+	}
+	members, err := im.g.db.FindGroupMembers(ctx, group.GroupID, userIDs)
+	if err != nil {
+		for _, i := range rows {
+			im.fail(i, err)
+		}
+		return
+	}
+	memberMap := datautil.SliceToMap(members, func(e *model.GroupMember) string { return e.UserID })
+	var (
+		inviteRows []int
+		roleRows   []int
+	)
+	for _, i := range rows {
+		row := im.rows[i]
+		roleLevel, _ := rosterRoleLevel(row.Role)
+		member, ok := memberMap[row.UserID]
+		switch {
+		case ok && member.RoleLevel == roleLevel:
+			im.succeed(i, true)
+		case roleLevel == constant.GroupOwner || (ok && member.RoleLevel == constant.GroupOwner):
+			im.fail(i, errs.ErrArgs.WrapMsg("the owner of an existing group can not be changed by import"))
+		case ok:
+			roleRows = append(roleRows, i)
+		default:
+			inviteRows = append(inviteRows, i)
+			if roleLevel != constant.GroupOrdinaryUsers {
+				roleRows = append(roleRows, i)
+			}
+		}
+	}
+	for _, batch := range splitBatches(inviteRows, importBatchSize) {
+		if err := im.inviteUsers(ctx, group.GroupID, batch); err == nil {
+			continue
+		}
+		for _, i := range batch {
+			if err := im.inviteUsers(ctx, group.GroupID, []int{i}); err != nil {
+				im.fail(i, err)
+			}
+		}
+	}
+	for _, i := range roleRows {
+		if im.done[i] {
+			continue
+		}
+		roleLevel, _ := rosterRoleLevel(im.rows[i].Role)
+		req := &pbgroup.SetGroupMemberInfoReq{Members: []*pbgroup.SetGroupMemberInfo{
+			{GroupID: group.GroupID, UserID: im.rows[i].UserID, RoleLevel: wrapperspb.Int32(roleLevel)},
+		}}
+		if _, err := im.g.SetGroupMemberInfo(ctx, req); err != nil {
+			im.fail(i, err)
+		}
+	}
+	for _, i := range rows {
+		im.succeed(i, false)
+	}
+}
+
+func (im *groupImport) inviteUsers(ctx context.Context, groupID string, rows []int) error {
+	req := &pbgroup.InviteUserToGroupReq{GroupID: groupID, Reason: "import"}
+	for _, i := range rows {
+		req.InvitedUserIDs = append(req.InvitedUserIDs, im.rows[i].UserID)
+	}
+	_, err := im.g.InviteUserToGroup(ctx, req)
+	return err
+}
+
+func splitBatches[T any](s []T, size int) [][]T {
+	batches := make([][]T, 0, (len(s)+size-1)/size)
+	for size < len(s) {
+		batches = append(batches, s[:size:size])
+		s = s[size:]
+	}
+	if len(s) > 0 {
+		batches = append(batches, s)
+	}
+	return batches
+}
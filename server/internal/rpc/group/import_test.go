@@ -0,0 +1,65 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+type importJobDatabase struct {
+	controller.GroupDatabase
+	expired []*model.GroupImportJob
+	updates map[string]map[string]any
+}
+
+func (d *importJobDatabase) TakeExpiredGroupImportJob(ctx context.Context, now time.Time, leaseExpire time.Time) (*model.GroupImportJob, error) {
+	if len(d.expired) == 0 {
+		return nil, nil
+	}
+	job := d.expired[0]
+	d.expired = d.expired[1:]
+	job.Attempts++
+	job.LeaseExpire = leaseExpire
+	return job, nil
+}
+
+func (d *importJobDatabase) UpdateGroupImportJob(ctx context.Context, jobID string, data map[string]any) error {
+	d.updates[jobID] = data
+	return nil
+}
+
+func TestRecoverExpiredGroupImports(t *testing.T) {
+	db := &importJobDatabase{
+		expired: []*model.GroupImportJob{
+			{JobID: "j1", Status: constant.GroupImportJobRunning, Attempts: maxImportAttempts},
+			{JobID: "j2", Status: constant.GroupImportJobRunning, Attempts: maxImportAttempts},
+		},
+		updates: make(map[string]map[string]any),
+	}
+	g := &groupServer{db: db, config: &Config{}}
+	g.recoverExpiredGroupImports(context.Background())
+	assert.Empty(t, db.expired)
+	for _, jobID := range []string{"j1", "j2"} {
+		assert.Equal(t, constant.GroupImportJobFailed, db.updates[jobID]["status"], jobID)
+		assert.Equal(t, "import interrupted", db.updates[jobID]["err_msg"], jobID)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// genInviteToken generates a url-safe random token that is not yet in use.
+func (g *groupServer) genInviteToken(ctx context.Context) (string, error) {
+	for i := 0; i < 10; i++ {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return "", errs.WrapMsg(err, "generate invite token failed")
+		}
+		token := base64.RawURLEncoding.EncodeToString(b)
+		_, err := g.db.TakeGroupInvite(ctx, token)
+		if err == nil {
+			continue
+		} else if g.IsNotFound(err) {
+			return token, nil
+		} else {
+			return "", err
+		}
+	}
+	return "", servererrs.ErrData.WrapMsg("invite token gen error")
+}
+
+// takeValidGroupInvite returns the invite only if it can still be redeemed.
+func (g *groupServer) takeValidGroupInvite(ctx context.Context, token string) (*model.GroupInvite, error) {
+	invite, err := g.db.TakeGroupInvite(ctx, token)
+	if err != nil {
+		if g.IsNotFound(err) {
+			return nil, servererrs.ErrGroupInviteInvalid.WrapMsg("invite not found")
+		}
+		return nil, err
+	}
+	if invite.Revoked {
+		return nil, servererrs.ErrGroupInviteInvalid.WrapMsg("invite revoked")
+	}
+	if invite.IsExpired(time.Now()) {
+		return nil, servererrs.ErrGroupInviteInvalid.WrapMsg("invite expired")
+	}
+	if invite.IsExhausted() {
+		return nil, servererrs.ErrGroupInviteInvalid.WrapMsg("invite used up")
+	}
+	return invite, nil
+}
+
+func (g *groupServer) CreateGroupInvite(ctx context.Context, req *pbgroup.CreateGroupInviteReq) (*pbgroup.CreateGroupInviteResp, error) {
+	if err := g.CheckGroupPermission(ctx, req.GroupID, constant.GroupPermissionApproveJoin); err != nil {
+		return nil, err
+	}
+	group, err := g.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	token, err := g.genInviteToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invite := &model.GroupInvite{
+		Token:         token,
+		GroupID:       req.GroupID,
+		CreatorUserID: mcontext.GetOpUserID(ctx),
+		CreateTime:    time.Now(),
+		ExpireTime:    time.UnixMilli(req.ExpireTime),
+		MaxUses:       req.MaxUses,
+		NeedApproval:  req.NeedApproval,
+		Ex:            req.Ex,
+	}
+	if err := g.db.CreateGroupInvite(ctx, invite); err != nil {
+		return nil, err
+	}
+	return &pbgroup.CreateGroupInviteResp{Invite: convert.Db2PbGroupInvite(invite)}, nil
+}
+
+func (g *groupServer) GetGroupInvites(ctx context.Context, req *pbgroup.GetGroupInvitesReq) (*pbgroup.GetGroupInvitesResp, error) {
+	if err := g.CheckGroupPermission(ctx, req.GroupID, constant.GroupPermissionApproveJoin); err != nil {
+		return nil, err
+	}
+	total, invites, err := g.db.PageGroupInvite(ctx, req.GroupID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &pbgroup.GetGroupInvitesResp{
+		Total:   uint32(total),
+		Invites: datautil.Slice(invites, convert.Db2PbGroupInvite),
+	}, nil
+}
+
+func (g *groupServer) RevokeGroupInvite(ctx context.Context, req *pbgroup.RevokeGroupInviteReq) (*pbgroup.RevokeGroupInviteResp, error) {
+	if err := g.CheckGroupPermission(ctx, req.GroupID, constant.GroupPermissionApproveJoin); err != nil {
+		return nil, err
+	}
+	if err := g.db.RevokeGroupInvite(ctx, req.GroupID, req.Token); err != nil {
+		return nil, err
+	}
+	return &pbgroup.RevokeGroupInviteResp{}, nil
+}
+
+func (g *groupServer) GetGroupInviteInfo(ctx context.Context, req *pbgroup.GetGroupInviteInfoReq) (*pbgroup.GetGroupInviteInfoResp, error) {
+	invite, err := g.takeValidGroupInvite(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	group, err := g.db.TakeGroup(ctx, invite.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	num, err := g.db.FindGroupMemberNum(ctx, group.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := g.db.TakeGroupOwner(ctx, group.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &pbgroup.GetGroupInviteInfoResp{
+		Invite:    convert.Db2PbGroupInvite(invite),
+		GroupInfo: convert.Db2PbGroupInfo(group, owner.UserID, num),
+	}, nil
+}
+
+func (g *groupServer) JoinGroupByInvite(ctx context.Context, req *pbgroup.JoinGroupByInviteReq) (*pbgroup.JoinGroupByInviteResp, error) {
+	userID := mcontext.GetOpUserID(ctx)
+	if _, err := g.userClient.GetUserInfo(ctx, userID); err != nil {
+		return nil, err
+	}
+	invite, err := g.takeValidGroupInvite(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	group, err := g.db.TakeGroup(ctx, invite.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	_, err = g.db.TakeGroupMember(ctx, group.GroupID, userID)
+	if err == nil {
+		return nil, errs.ErrArgs.WrapMsg("already in group")
+	} else if !g.IsNotFound(err) && errs.Unwrap(err) != errs.ErrRecordNotFound {
+		return nil, err
+	}
+	joinSource := req.JoinSource
+	if joinSource == 0 {
+		joinSource = constant.JoinByInviteLink
+	}
+
+	reqCall := &callbackstruct.CallbackJoinGroupReq{
+		GroupID:    group.GroupID,
+		GroupType:  string(group.GroupType),
+		ApplyID:    userID,
+		ReqMessage: req.ReqMessage,
+		Ex:         req.Ex,
+	}
+	if err := g.webhookBeforeApplyJoinGroup(ctx, &g.config.WebhooksConfig.BeforeApplyJoinGroup, reqCall); err != nil && err != servererrs.ErrCallbackContinue {
+		return nil, err
+	}
+
+	if invite.NeedApproval {
+		groupRequest := &model.GroupRequest{
+			UserID:        userID,
+			GroupID:       group.GroupID,
+			ReqMsg:        req.ReqMessage,
+			JoinSource:    joinSource,
+			InviterUserID: invite.CreatorUserID,
+			ReqTime:       time.Now(),
+			HandledTime:   time.Unix(0, 0),
+			Ex:            req.Ex,
+		}
+		if err := g.db.RedeemGroupInvite(ctx, invite.Token, nil, groupRequest, 0); err != nil {
+			return nil, g.inviteRedeemErr(err)
+		}
+		// JoinGroupApplicationNotification treats InviterUserID as the applicant.
+		g.notification.JoinGroupApplicationNotification(ctx, &pbgroup.JoinGroupReq{
+			GroupID:       group.GroupID,
+			ReqMessage:    req.ReqMessage,
+			JoinSource:    joinSource,
+			InviterUserID: userID,
+			Ex:            req.Ex,
+		})
+		return &pbgroup.JoinGroupByInviteResp{GroupID: group.GroupID, NeedApproval: true}, nil
+	}
+
+	groupMember := &model.GroupMember{
+		GroupID:        group.GroupID,
+		UserID:         userID,
+		RoleLevel:      constant.GroupOrdinaryUsers,
+		JoinSource:     joinSource,
+		OperatorUserID: userID,
+		InviterUserID:  invite.CreatorUserID,
+		JoinTime:       time.Now(),
+		MuteEndTime:    time.UnixMilli(0),
+	}
+	if err := g.webhookBeforeMembersJoinGroup(ctx, &g.config.WebhooksConfig.BeforeMemberJoinGroup, []*model.GroupMember{groupMember}, group.GroupID, group.Ex); err != nil && err != servererrs.ErrCallbackContinue {
+		return nil, err
+	}
+	if err := g.checkJoinedGroupLimit(ctx, userID); err != nil {
+		return nil, err
+	}
+	maxMemberCount, err := g.groupMemberLimit(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.db.RedeemGroupInvite(ctx, invite.Token, groupMember, nil, maxMemberCount); err != nil {
+		return nil, g.inviteRedeemErr(err)
+	}
+	if err := g.notification.MemberEnterNotification(ctx, group.GroupID, userID); err != nil {
+		return nil, err
+	}
+	g.webhookAfterJoinGroup(ctx, &g.config.WebhooksConfig.AfterJoinGroup, &pbgroup.JoinGroupReq{
+		GroupID:       group.GroupID,
+		ReqMessage:    req.ReqMessage,
+		JoinSource:    joinSource,
+		InviterUserID: userID,
+		Ex:            req.Ex,
+	})
+	return &pbgroup.JoinGroupByInviteResp{GroupID: group.GroupID}, nil
+}
+
+// inviteRedeemErr maps the conditional update miss to an invalid invite,
+// which happens when the invite was revoked, expired or used up concurrently.
+func (g *groupServer) inviteRedeemErr(err error) error {
+	if g.IsNotFound(err) {
+		return servererrs.ErrGroupInviteInvalid.WrapMsg("invite no longer valid")
+	}
+	return err
+}
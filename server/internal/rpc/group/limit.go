@@ -0,0 +1,157 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+// memberLimit resolves the member limit of a group: the limit set for the group itself,
+// then the limit configured for its owner, then the limit of its group type. 0 means unlimited.
+func (g *groupServer) memberLimit(group *model.Group, ownerUserID string) int64 {
+	if group.MemberLimit > 0 {
+		return group.MemberLimit
+	}
+	conf := g.config.RpcConfig.MemberLimit
+	for _, owner := range conf.Owners {
+		if owner.UserID == ownerUserID {
+			return owner.MemberLimit
+		}
+	}
+	switch group.GroupType {
+	case constant.SuperGroup:
+		return conf.SuperGroup
+	case constant.WorkingGroup:
+		return conf.WorkingGroup
+	default:
+		return conf.NormalGroup
+	}
+}
+
+// groupMemberLimit is memberLimit for an existing group, the owner is only looked up when owner limits are configured.
+func (g *groupServer) groupMemberLimit(ctx context.Context, group *model.Group) (int64, error) {
+	var ownerUserID string
+	if group.MemberLimit <= 0 && len(g.config.RpcConfig.MemberLimit.Owners) > 0 {
+		owner, err := g.db.TakeGroupOwner(ctx, group.GroupID)
+		if err == nil {
+			ownerUserID = owner.UserID
+		} else if !g.IsNotFound(err) {
+			return 0, err
+		}
+	}
+	return g.memberLimit(group, ownerUserID), nil
+}
+
+// checkJoinedGroupLimit makes sure none of the users is already in the maximum number of groups.
+func (g *groupServer) checkJoinedGroupLimit(ctx context.Context, userIDs ...string) error {
+	maxJoined := g.config.RpcConfig.MaxJoinedGroups
+	if maxJoined <= 0 {
+		return nil
+	}
+	for _, userID := range userIDs {
+		groupIDs, err := g.db.FindJoinGroupID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if int64(len(groupIDs)) >= maxJoined {
+			return servererrs.ErrGroupMemberLimit.WrapMsg("joined group limit reached", "userID", userID, "maxJoinedGroups", maxJoined)
+		}
+	}
+	return nil
+}
+
+// checkCreateGroupLimits sets the creator of a new group and checks the limits creating it
+// runs into. Only app managers may name another user as the creator, everyone else creates
+// the group as themselves and is counted for it.
+func (g *groupServer) checkCreateGroupLimits(ctx context.Context, group *model.Group, req *pbgroup.CreateGroupReq) error {
+	opUserID := mcontext.GetOpUserID(ctx)
+	if group.CreatorUserID == "" || !authverify.IsAppManagerUid(ctx, g.config.Share.IMAdminUserID) {
+		group.CreatorUserID = opUserID
+	}
+	if err := g.checkCreatedGroupLimit(ctx, opUserID); err != nil {
+		return err
+	}
+	memberUserIDs := append(append([]string{req.OwnerUserID}, req.AdminUserIDs...), req.MemberUserIDs...)
+	if maxMemberCount := g.memberLimit(group, req.OwnerUserID); maxMemberCount > 0 && int64(len(memberUserIDs)) > maxMemberCount {
+		return servererrs.ErrGroupMemberLimit.WrapMsg("group member limit reached", "memberCount", len(memberUserIDs), "maxMemberCount", maxMemberCount)
+	}
+	return g.checkJoinedGroupLimit(ctx, memberUserIDs...)
+}
+
+// checkCreatedGroupLimit makes sure the user has not created the maximum number of groups yet.
+// Groups created by app managers are not limited.
+func (g *groupServer) checkCreatedGroupLimit(ctx context.Context, userID string) error {
+	maxCreated := g.config.RpcConfig.MaxCreatedGroups
+	if maxCreated <= 0 || authverify.IsManagerUserID(userID, g.config.Share.IMAdminUserID) {
+		return nil
+	}
+	num, err := g.db.CountCreatedGroup(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if num >= maxCreated {
+		return servererrs.ErrGroupMemberLimit.WrapMsg("created group limit reached", "userID", userID, "maxCreatedGroups", maxCreated)
+	}
+	return nil
+}
+
+func (g *groupServer) SetGroupMemberLimit(ctx context.Context, req *pbgroup.SetGroupMemberLimitReq) (*pbgroup.SetGroupMemberLimitResp, error) {
+	if err := authverify.CheckAdmin(ctx, g.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	group, err := g.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	if err := g.db.UpdateGroup(ctx, req.GroupID, map[string]any{"member_limit": req.MemberLimit}); err != nil {
+		return nil, err
+	}
+	log.ZInfo(ctx, "group member limit set", "groupID", req.GroupID, "old", group.MemberLimit, "new", req.MemberLimit)
+	return &pbgroup.SetGroupMemberLimitResp{}, nil
+}
+
+func (g *groupServer) GetGroupMemberLimit(ctx context.Context, req *pbgroup.GetGroupMemberLimitReq) (*pbgroup.GetGroupMemberLimitResp, error) {
+	if err := authverify.CheckAdmin(ctx, g.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	group, err := g.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	maxMemberCount, err := g.groupMemberLimit(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	num, err := g.db.FindGroupMemberNum(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &pbgroup.GetGroupMemberLimitResp{
+		MemberLimit:    group.MemberLimit,
+		MaxMemberCount: maxMemberCount,
+		MemberCount:    num,
+	}, nil
+}
@@ -0,0 +1,131 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/stretchr/testify/assert"
+)
+
+type limitGroupDatabase struct {
+	controller.GroupDatabase
+	created map[string]int64
+	joined  map[string][]string
+	owners  map[string]string
+}
+
+func (d *limitGroupDatabase) CountCreatedGroup(ctx context.Context, userID string) (int64, error) {
+	return d.created[userID], nil
+}
+
+func (d *limitGroupDatabase) FindJoinGroupID(ctx context.Context, userID string) ([]string, error) {
+	return d.joined[userID], nil
+}
+
+func (d *limitGroupDatabase) TakeGroupOwner(ctx context.Context, groupID string) (*model.GroupMember, error) {
+	owner, ok := d.owners[groupID]
+	if !ok {
+		return nil, errs.ErrRecordNotFound.Wrap()
+	}
+	return &model.GroupMember{GroupID: groupID, UserID: owner, RoleLevel: constant.GroupOwner}, nil
+}
+
+func newLimitGroupServer(db *limitGroupDatabase) *groupServer {
+	conf := &Config{}
+	conf.Share.IMAdminUserID = []string{"imAdmin"}
+	conf.RpcConfig.MaxCreatedGroups = 2
+	conf.RpcConfig.MaxJoinedGroups = 3
+	conf.RpcConfig.MemberLimit.WorkingGroup = 4
+	conf.RpcConfig.MemberLimit.Owners = append(conf.RpcConfig.MemberLimit.Owners, struct {
+		UserID      string `mapstructure:"userID"`
+		MemberLimit int64  `mapstructure:"memberLimit"`
+	}{UserID: "vip", MemberLimit: 6})
+	return &groupServer{db: db, config: conf}
+}
+
+func isGroupMemberLimit(err error) bool {
+	return err != nil && servererrs.ErrGroupMemberLimit.Is(errs.Unwrap(err))
+}
+
+func TestCheckCreateGroupLimits(t *testing.T) {
+	db := &limitGroupDatabase{created: map[string]int64{"full": 2, "u1": 1}}
+	g := newLimitGroupServer(db)
+	create := func(opUserID, creatorUserID string, members ...string) (*model.Group, error) {
+		ctx := mcontext.SetOpUserID(context.Background(), opUserID)
+		group := &model.Group{GroupType: constant.WorkingGroup, CreatorUserID: creatorUserID}
+		return group, g.checkCreateGroupLimits(ctx, group, &pbgroup.CreateGroupReq{OwnerUserID: opUserID, MemberUserIDs: members})
+	}
+
+	group, err := create("u1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", group.CreatorUserID)
+
+	_, err = create("full", "")
+	assert.True(t, isGroupMemberLimit(err))
+
+	// a user can not get around the limit by naming an app manager or someone else as the creator
+	group, err = create("full", "imAdmin")
+	assert.True(t, isGroupMemberLimit(err))
+	assert.Equal(t, "full", group.CreatorUserID)
+	group, err = create("u1", "full")
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", group.CreatorUserID)
+
+	// app managers are not limited and may create a group for another user
+	group, err = create("imAdmin", "full")
+	assert.NoError(t, err)
+	assert.Equal(t, "full", group.CreatorUserID)
+
+	_, err = create("u1", "", "m1", "m2", "m3")
+	assert.NoError(t, err)
+	_, err = create("u1", "", "m1", "m2", "m3", "m4")
+	assert.True(t, isGroupMemberLimit(err))
+}
+
+func TestCheckJoinedGroupLimit(t *testing.T) {
+	db := &limitGroupDatabase{joined: map[string][]string{"u1": {"g1", "g2"}, "u2": {"g1", "g2", "g3"}}}
+	g := newLimitGroupServer(db)
+	ctx := context.Background()
+	assert.NoError(t, g.checkJoinedGroupLimit(ctx, "u1", "u3"))
+	assert.True(t, isGroupMemberLimit(g.checkJoinedGroupLimit(ctx, "u1", "u2")))
+
+	g.config.RpcConfig.MaxJoinedGroups = 0
+	assert.NoError(t, g.checkJoinedGroupLimit(ctx, "u2"))
+}
+
+func TestGroupMemberLimit(t *testing.T) {
+	db := &limitGroupDatabase{owners: map[string]string{"g1": "u1", "g2": "vip"}}
+	g := newLimitGroupServer(db)
+	ctx := context.Background()
+	limit := func(group *model.Group) int64 {
+		n, err := g.groupMemberLimit(ctx, group)
+		assert.NoError(t, err)
+		return n
+	}
+	assert.Equal(t, int64(4), limit(&model.Group{GroupID: "g1", GroupType: constant.WorkingGroup}))
+	assert.Equal(t, int64(6), limit(&model.Group{GroupID: "g2", GroupType: constant.WorkingGroup}))
+	assert.Equal(t, int64(10), limit(&model.Group{GroupID: "g2", GroupType: constant.WorkingGroup, MemberLimit: 10}))
+	// a group without an owner falls back to the limit of its type
+	assert.Equal(t, int64(4), limit(&model.Group{GroupID: "g3", GroupType: constant.WorkingGroup}))
+}
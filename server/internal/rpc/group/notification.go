@@ -56,6 +56,9 @@ func NewNotificationSender(db controller.GroupDatabase, config *Config, userClie
 			notification.WithRpcClient(func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
 				return msgClient.SendMsg(ctx, req)
 			}),
+			notification.WithRpcBatchClient(func(ctx context.Context, req *msg.SendNotificationsReq) (*msg.SendNotificationsResp, error) {
+				return msgClient.SendNotifications(ctx, req)
+			}),
 			notification.WithUserRpcClient(userClient.GetUserInfo),
 		),
 		getUsersInfo: func(ctx context.Context, userIDs []string) ([]common_user.CommonUser, error) {
@@ -807,3 +810,35 @@ func (g *NotificationSender) GroupMemberSetToOrdinaryUserNotification(ctx contex
 	g.setSortVersion(ctx, &tips.GroupMemberVersion, &tips.GroupMemberVersionID, database.GroupMemberVersionName, tips.Group.GroupID, &tips.GroupSortVersion)
 	g.Notification(ctx, mcontext.GetOpUserID(ctx), group.GroupID, constant.GroupMemberSetToOrdinaryUserNotification, tips)
 }
+
+// GroupAnnouncementRemindNotification sends the reminder before returning and returns the users it reached.
+func (g *NotificationSender) GroupAnnouncementRemindNotification(ctx context.Context, announcement *sdkws.GroupAnnouncement, userIDs []string) ([]string, error) {
+	group, err := g.getGroupInfo(ctx, announcement.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	tips := &sdkws.GroupAnnouncementRemindTips{Group: group, Announcement: announcement}
+	if err := g.fillOpUser(ctx, &tips.OpUser, group.GroupID); err != nil {
+		return nil, err
+	}
+	return g.NotificationToUsersSync(ctx, mcontext.GetOpUserID(ctx), userIDs, constant.GroupAnnouncementRemindNotification, tips), nil
+}
+
+func (g *NotificationSender) GroupAnnouncementConfirmedNotification(ctx context.Context, announcement *sdkws.GroupAnnouncement, confirmTime int64) {
+	var err error
+	defer func() {
+		if err != nil {
+			log.ZError(ctx, stringutil.GetFuncName(1)+" failed", err)
+		}
+	}()
+	var group *sdkws.GroupInfo
+	group, err = g.getGroupInfo(ctx, announcement.GroupID)
+	if err != nil {
+		return
+	}
+	tips := &sdkws.GroupAnnouncementConfirmedTips{Group: group, Announcement: announcement, ConfirmTime: confirmTime}
+	if err = g.fillUserByUserID(ctx, mcontext.GetOpUserID(ctx), &tips.ConfirmUser, group.GroupID); err != nil {
+		return
+	}
+	g.Notification(ctx, mcontext.GetOpUserID(ctx), announcement.CreatorUserID, constant.GroupAnnouncementConfirmedNotification, tips)
+}
@@ -0,0 +1,202 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	rosterRoleOwner  = "owner"
+	rosterRoleAdmin  = "admin"
+	rosterRoleMember = "member"
+)
+
+// rosterColumns are the csv columns of a roster, also the json keys of a row.
+var rosterColumns = []string{"userID", "nickname", "faceURL", "groupID", "groupName", "role"}
+
+// rosterRow is one group membership of an imported or exported roster.
+// Nickname and FaceURL are only used when the user has to be registered,
+// GroupName only when the group has to be created.
+type rosterRow struct {
+	UserID    string `json:"userID"`
+	Nickname  string `json:"nickname,omitempty"`
+	FaceURL   string `json:"faceURL,omitempty"`
+	GroupID   string `json:"groupID"`
+	GroupName string `json:"groupName,omitempty"`
+	Role      string `json:"role,omitempty"`
+}
+
+func (r *rosterRow) values() []string {
+	return []string{r.UserID, r.Nickname, r.FaceURL, r.GroupID, r.GroupName, r.Role}
+}
+
+func (r *rosterRow) trim() {
+	r.UserID = strings.TrimSpace(r.UserID)
+	r.GroupID = strings.TrimSpace(r.GroupID)
+	r.Role = strings.TrimSpace(r.Role)
+}
+
+// rosterRoleLevel maps a roster role to a group role level, an empty role is a member.
+func rosterRoleLevel(role string) (int32, error) {
+	switch strings.ToLower(role) {
+	case rosterRoleOwner:
+		return constant.GroupOwner, nil
+	case rosterRoleAdmin:
+		return constant.GroupAdmin, nil
+	case rosterRoleMember, "":
+		return constant.GroupOrdinaryUsers, nil
+	default:
+		return 0, errs.ErrArgs.WrapMsg("invalid role " + role)
+	}
+}
+
+func rosterRole(roleLevel int32) string {
+	switch roleLevel {
+	case constant.GroupOwner:
+		return rosterRoleOwner
+	case constant.GroupAdmin:
+		return rosterRoleAdmin
+	default:
+		return rosterRoleMember
+	}
+}
+
+// rosterFormat returns the requested format, or guesses it from the object name.
+func rosterFormat(format string, objectName string) string {
+	if format != "" {
+		return format
+	}
+	if strings.EqualFold(path.Ext(objectName), ".json") {
+		return constant.GroupRosterJSON
+	}
+	return constant.GroupRosterCSV
+}
+
+func parseRoster(format string, data []byte) ([]*rosterRow, error) {
+	switch format {
+	case constant.GroupRosterCSV:
+		return parseRosterCSV(data)
+	case constant.GroupRosterJSON:
+		var rows []*rosterRow
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return nil, errs.ErrArgs.WrapMsg("invalid json roster: " + err.Error())
+		}
+		for i, row := range rows {
+			if row == nil {
+				rows[i] = &rosterRow{}
+				continue
+			}
+			row.trim()
+		}
+		return rows, nil
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unsupported roster format " + format)
+	}
+}
+
+// parseRosterCSV reads a csv roster with a header line. Columns are matched by name, case-insensitively,
+// so they may come in any order and unknown columns are ignored; userID and groupID are required.
+func parseRosterCSV(data []byte) ([]*rosterRow, error) {
+	// spreadsheet programs like to prepend a byte order mark
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, errs.ErrArgs.WrapMsg("invalid csv roster: " + err.Error())
+	}
+	index := make(map[string]int)
+	for i, name := range header {
+		for _, column := range rosterColumns {
+			if strings.EqualFold(strings.TrimSpace(name), column) {
+				index[column] = i
+			}
+		}
+	}
+	for _, column := range []string{"userID", "groupID"} {
+		if _, ok := index[column]; !ok {
+			return nil, errs.ErrArgs.WrapMsg("csv roster has no " + column + " column")
+		}
+	}
+	var rows []*rosterRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errs.ErrArgs.WrapMsg("invalid csv roster: " + err.Error())
+		}
+		field := func(column string) string {
+			if i, ok := index[column]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+		row := &rosterRow{
+			UserID:    field("userID"),
+			Nickname:  field("nickname"),
+			FaceURL:   field("faceURL"),
+			GroupID:   field("groupID"),
+			GroupName: field("groupName"),
+			Role:      field("role"),
+		}
+		row.trim()
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func formatRoster(format string, rows []*rosterRow) ([]byte, error) {
+	switch format {
+	case constant.GroupRosterCSV:
+		var buf bytes.Buffer
+		writer := csv.NewWriter(&buf)
+		if err := writer.Write(rosterColumns); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		for _, row := range rows {
+			if err := writer.Write(row.values()); err != nil {
+				return nil, errs.Wrap(err)
+			}
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		return buf.Bytes(), nil
+	case constant.GroupRosterJSON:
+		if rows == nil {
+			rows = []*rosterRow{}
+		}
+		data, err := json.Marshal(rows)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		return data, nil
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unsupported roster format " + format)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRosterCSV(t *testing.T) {
+	data := "\xef\xbb\xbfGroupID,userID,Role,class\n g1 ,u1,Owner,3a\ng1,u2\n"
+	rows, err := parseRoster(constant.GroupRosterCSV, []byte(data))
+	assert.Nil(t, err)
+	assert.Equal(t, []*rosterRow{
+		{UserID: "u1", GroupID: "g1", Role: "Owner"},
+		{UserID: "u2", GroupID: "g1"},
+	}, rows)
+
+	_, err = parseRoster(constant.GroupRosterCSV, []byte("userID,role\nu1,member\n"))
+	assert.NotNil(t, err)
+}
+
+func TestRosterRoundTrip(t *testing.T) {
+	rows := []*rosterRow{
+		{UserID: "u1", Nickname: "Ann, Lee", GroupID: "g1", GroupName: "Class 3A", Role: rosterRoleOwner},
+		{UserID: "u2", GroupID: "g1", Role: rosterRoleMember},
+	}
+	for _, format := range []string{constant.GroupRosterCSV, constant.GroupRosterJSON} {
+		data, err := formatRoster(format, rows)
+		assert.Nil(t, err)
+		parsed, err := parseRoster(format, data)
+		assert.Nil(t, err)
+		assert.Equal(t, rows, parsed, format)
+	}
+}
+
+func TestRosterRoleLevel(t *testing.T) {
+	for _, role := range []string{rosterRoleOwner, rosterRoleAdmin, rosterRoleMember} {
+		roleLevel, err := rosterRoleLevel(role)
+		assert.Nil(t, err)
+		assert.Equal(t, role, rosterRole(roleLevel))
+	}
+	roleLevel, err := rosterRoleLevel("")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(constant.GroupOrdinaryUsers), roleLevel)
+	_, err = rosterRoleLevel("teacher")
+	assert.NotNil(t, err)
+}
+
+func TestRosterFormat(t *testing.T) {
+	assert.Equal(t, constant.GroupRosterJSON, rosterFormat("", "import/roster.JSON"))
+	assert.Equal(t, constant.GroupRosterCSV, rosterFormat("", "import/roster.csv"))
+	assert.Equal(t, constant.GroupRosterJSON, rosterFormat(constant.GroupRosterJSON, "roster.csv"))
+}
@@ -0,0 +1,95 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/s3"
+	"github.com/openimsdk/tools/s3/aws"
+	"github.com/openimsdk/tools/s3/cos"
+	"github.com/openimsdk/tools/s3/kodo"
+	"github.com/openimsdk/tools/s3/minio"
+	"github.com/openimsdk/tools/s3/oss"
+	redisv9 "github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// newObjectStorage connects to the object storage of the third service, shared by the
+// cold archive and compliance exports.
+func newObjectStorage(ctx context.Context, config *Config, rdb redisv9.UniversalClient) (s3.Interface, error) {
+	switch enable := config.ThirdConfig.Object.Enable; enable {
+	case "minio":
+		return minio.NewMinio(ctx, redis.NewMinioCache(rdb), *config.MinioConfig.Build())
+	case "cos":
+		return cos.NewCos(*config.ThirdConfig.Object.Cos.Build())
+	case "oss":
+		return oss.NewOSS(*config.ThirdConfig.Object.Oss.Build())
+	case "kodo":
+		return kodo.NewKodo(*config.ThirdConfig.Object.Kodo.Build())
+	case "aws":
+		return aws.NewAws(*config.ThirdConfig.Object.Aws.Build())
+	default:
+		return nil, fmt.Errorf("invalid object enable: %s", enable)
+	}
+}
+
+func newMsgArchiveDatabase(config *Config, db *mongo.Database, o s3.Interface) (controller.MsgArchiveDatabase, error) {
+	archiveDB, err := mgo.NewMsgArchiveMgo(db)
+	if err != nil {
+		return nil, err
+	}
+	return controller.NewMsgArchiveDatabase(archiveDB, o, config.RpcConfig.Archive.CacheSize), nil
+}
+
+// ArchiveMsgs moves full msg docs whose messages were all sent before the timestamp to object storage.
+func (m *msgServer) ArchiveMsgs(ctx context.Context, req *msg.ArchiveMsgsReq) (*msg.ArchiveMsgsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if m.archiveDatabase == nil {
+		return nil, errs.ErrInternalServer.WrapMsg("msg archive is not enabled")
+	}
+	docs, err := m.MsgDatabase.GetFullDocsBefore(ctx, req.Timestamp, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	var count int32
+	for i, doc := range docs {
+		if err := m.archiveDatabase.ArchiveMsgDoc(ctx, doc); err != nil {
+			return nil, err
+		}
+		// a doc written after it was read stays live, the next run archives it again
+		deleted, err := m.MsgDatabase.DeleteArchivedDoc(ctx, doc)
+		if err != nil {
+			return nil, err
+		}
+		if !deleted {
+			log.ZDebug(ctx, "ArchiveMsgs doc changed while archiving", "index", i, "docID", doc.DocID)
+			continue
+		}
+		count++
+		log.ZDebug(ctx, "ArchiveMsgs archive doc", "index", i, "docID", doc.DocID)
+	}
+	return &msg.ArchiveMsgsResp{Count: count}, nil
+}
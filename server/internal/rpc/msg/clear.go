@@ -13,13 +13,34 @@ func (m *msgServer) DestructMsgs(ctx context.Context, req *msg.DestructMsgsReq)
 	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
 		return nil, err
 	}
-	docs, err := m.MsgDatabase.GetRandBeforeMsg(ctx, req.Timestamp, int(req.Limit))
+	policies, err := m.retentionDatabase.FindAllPolicies(ctx)
 	if err != nil {
 		return nil, err
 	}
+	include, exclude, err := retentionFilter(policies, req.PolicyID)
+	if err != nil {
+		return nil, err
+	}
+	holdPatterns, err := m.getLegalHoldPatterns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exclude = append(exclude, holdPatterns...)
+	count, err := m.destructMsgs(ctx, req.Timestamp, int(req.Limit), include, exclude)
+	if err != nil {
+		return nil, err
+	}
+	return &msg.DestructMsgsResp{Count: int32(count)}, nil
+}
+
+func (m *msgServer) destructMsgs(ctx context.Context, ts int64, limit int, include []string, exclude []string) (int, error) {
+	docs, err := m.MsgDatabase.GetRandBeforeMsg(ctx, ts, limit, include, exclude)
+	if err != nil {
+		return 0, err
+	}
 	for i, doc := range docs {
 		if err := m.MsgDatabase.DeleteDoc(ctx, doc.DocID); err != nil {
-			return nil, err
+			return 0, err
 		}
 		log.ZDebug(ctx, "DestructMsgs delete doc", "index", i, "docID", doc.DocID)
 		index := strings.LastIndex(doc.DocID, ":")
@@ -44,11 +65,32 @@ func (m *msgServer) DestructMsgs(ctx context.Context, req *msg.DestructMsgsReq)
 		}
 		minSeq++
 		if err := m.MsgDatabase.SetMinSeq(ctx, conversationID, minSeq); err != nil {
-			return nil, err
+			return 0, err
 		}
 		log.ZDebug(ctx, "DestructMsgs delete doc set min seq", "index", i, "docID", doc.DocID, "conversationID", conversationID, "setMinSeq", minSeq)
 	}
-	return &msg.DestructMsgsResp{Count: int32(len(docs))}, nil
+	if m.archiveDatabase == nil || len(docs) >= limit {
+		return len(docs), nil
+	}
+	archives, err := m.archiveDatabase.GetRandArchivesBefore(ctx, ts, limit-len(docs), include, exclude)
+	if err != nil {
+		return 0, err
+	}
+	for i, archive := range archives {
+		if err := m.archiveDatabase.DeleteArchive(ctx, archive); err != nil {
+			return 0, err
+		}
+		index := strings.LastIndex(archive.DocID, ":")
+		if index <= 0 || archive.MaxSeq <= 0 {
+			continue
+		}
+		conversationID := archive.DocID[:index]
+		if err := m.MsgDatabase.SetMinSeq(ctx, conversationID, archive.MaxSeq+1); err != nil {
+			return 0, err
+		}
+		log.ZDebug(ctx, "DestructMsgs delete archive set min seq", "index", i, "docID", archive.DocID, "conversationID", conversationID, "setMinSeq", archive.MaxSeq+1)
+	}
+	return len(docs) + len(archives), nil
 }
 
 func (m *msgServer) GetLastMessageSeqByTime(ctx context.Context, req *msg.GetLastMessageSeqByTimeReq) (*msg.GetLastMessageSeqByTimeResp, error) {
@@ -0,0 +1,567 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/third"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/encrypt"
+)
+
+const (
+	complianceExportURLExpire = time.Hour
+	complianceObjectTimeout   = time.Minute * 5
+	complianceUploadTimeout   = time.Minute * 30
+	complianceExportLease     = time.Minute * 2
+	complianceLeaseRenew      = time.Second * 30
+	maxComplianceAttempts     = 3
+)
+
+// parseExportSignKey decodes the base64 ed25519 seed used to sign export manifests.
+func parseExportSignKey(signKey string) (ed25519.PrivateKey, error) {
+	seed, err := base64.StdEncoding.DecodeString(signKey)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid compliance signKey")
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, errs.New("invalid compliance signKey length", "length", len(seed), "want", ed25519.SeedSize).Wrap()
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// complianceMsgRecord is one line of messages.jsonl. Revoked messages and messages deleted
+// by some users are kept as they are stored, with the revoke and delete state attached.
+type complianceMsgRecord struct {
+	ConversationID   string             `json:"conversationID"`
+	Seq              int64              `json:"seq"`
+	ServerMsgID      string             `json:"serverMsgID"`
+	ClientMsgID      string             `json:"clientMsgID"`
+	SendID           string             `json:"sendID"`
+	RecvID           string             `json:"recvID"`
+	GroupID          string             `json:"groupID"`
+	SenderPlatformID int32              `json:"senderPlatformID"`
+	SenderNickname   string             `json:"senderNickname"`
+	SessionType      int32              `json:"sessionType"`
+	MsgFrom          int32              `json:"msgFrom"`
+	ContentType      int32              `json:"contentType"`
+	Content          string             `json:"content"`
+	SendTime         int64              `json:"sendTime"`
+	CreateTime       int64              `json:"createTime"`
+	Status           int32              `json:"status"`
+	IsRead           bool               `json:"isRead"`
+	AtUserIDList     []string           `json:"atUserIDList,omitempty"`
+	AttachedInfo     string             `json:"attachedInfo,omitempty"`
+	Ex               string             `json:"ex,omitempty"`
+	Revoke           *model.RevokeModel `json:"revoke,omitempty"`
+	DelUserIDs       []string           `json:"delUserIDs,omitempty"`
+	Objects          []string           `json:"objects,omitempty"`
+}
+
+type complianceFile struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+// complianceManifest is stored as manifest.json and signed into manifest.sig, so the hash of
+// every file in the archive can be verified with the public key alone.
+type complianceManifest struct {
+	JobID           string            `json:"jobID"`
+	UserID          string            `json:"userID,omitempty"`
+	ConversationIDs []string          `json:"conversationIDs"`
+	CreateTime      int64             `json:"createTime"`
+	MsgCount        int64             `json:"msgCount"`
+	ObjectCount     int64             `json:"objectCount"`
+	MissingObjects  []string          `json:"missingObjects,omitempty"`
+	Files           []*complianceFile `json:"files"`
+	PublicKey       string            `json:"publicKey"`
+}
+
+func (m *msgServer) StartComplianceExport(ctx context.Context, req *msg.StartComplianceExportReq) (*msg.StartComplianceExportResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if m.exportSignKey == nil {
+		return nil, errs.ErrInternalServer.WrapMsg("compliance export is not enabled")
+	}
+	conversationIDs := datautil.Distinct(req.ConversationIDs)
+	if req.UserID != "" {
+		var err error
+		conversationIDs, err = m.conversationClient.GetConversationIDs(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	jobID := encrypt.Md5(strings.Join([]string{req.UserID, strings.Join(conversationIDs, ":"), mcontext.GetOperationID(ctx), strconv.FormatInt(now.UnixNano(), 10)}, ","))
+	job := &model.ComplianceExportJob{
+		JobID:           jobID,
+		UserID:          req.UserID,
+		ConversationIDs: conversationIDs,
+		Status:          constant.ComplianceExportRunning,
+		ObjectName:      "compliance-export/" + jobID + ".zip",
+		OpUserID:        mcontext.GetOpUserID(ctx),
+		Attempts:        1,
+		LeaseExpire:     now.Add(complianceExportLease),
+		CreateTime:      now,
+		UpdateTime:      now,
+	}
+	if err := m.complianceDatabase.CreateExportJob(ctx, job); err != nil {
+		return nil, err
+	}
+	go m.runComplianceExport(context.WithoutCancel(ctx), job)
+	return &msg.StartComplianceExportResp{JobID: job.JobID}, nil
+}
+
+func (m *msgServer) GetComplianceExport(ctx context.Context, req *msg.GetComplianceExportReq) (*msg.GetComplianceExportResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	job, err := m.complianceDatabase.TakeExportJob(ctx, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.GetComplianceExportResp{Job: convert.Db2PbComplianceExportJob(job)}
+	if job.Status == constant.ComplianceExportFinished && m.s3 != nil {
+		resp.Url, err = m.s3.AccessURL(ctx, job.ObjectName, complianceExportURLExpire, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (m *msgServer) runComplianceExport(ctx context.Context, job *model.ComplianceExportJob) {
+	stop := m.renewComplianceExportLease(ctx, job.JobID)
+	defer stop()
+	defer func() {
+		if r := recover(); r != nil {
+			log.ZPanic(ctx, "runComplianceExport Panic", errs.ErrPanic(r))
+			m.failComplianceExport(ctx, job, "export aborted by an internal error")
+		}
+	}()
+	if err := m.complianceExport(ctx, job); err != nil {
+		log.ZWarn(ctx, "compliance export failed", err, "jobID", job.JobID)
+		m.failComplianceExport(ctx, job, err.Error())
+	}
+}
+
+// renewComplianceExportLease keeps the lease of a running job alive until the returned func is called.
+func (m *msgServer) renewComplianceExportLease(ctx context.Context, jobID string) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(complianceLeaseRenew)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				data := map[string]any{"lease_expire": time.Now().Add(complianceExportLease)}
+				if err := m.complianceDatabase.UpdateExportJob(ctx, jobID, data); err != nil {
+					log.ZWarn(ctx, "renew compliance export lease failed", err, "jobID", jobID)
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// recoverComplianceExports takes over the jobs left running by an instance that stopped, until
+// ctx is done. An export starts over, a job that keeps getting interrupted fails after
+// maxComplianceAttempts.
+func (m *msgServer) recoverComplianceExports(ctx context.Context) {
+	ticker := time.NewTicker(complianceLeaseRenew)
+	defer ticker.Stop()
+	for {
+		m.recoverExpiredComplianceExports(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *msgServer) recoverExpiredComplianceExports(ctx context.Context) {
+	for {
+		now := time.Now()
+		job, err := m.complianceDatabase.TakeExpiredExportJob(ctx, now, now.Add(complianceExportLease))
+		if err != nil {
+			log.ZError(ctx, "take expired compliance export job failed", err)
+			return
+		}
+		if job == nil {
+			return
+		}
+		jobCtx := mcontext.SetOpUserID(mcontext.NewCtx("recover-export-"+job.JobID), job.OpUserID)
+		switch {
+		case job.Attempts > maxComplianceAttempts:
+			log.ZWarn(jobCtx, "compliance export interrupted too often", nil, "jobID", job.JobID, "attempts", job.Attempts)
+			m.failComplianceExport(jobCtx, job, "export interrupted")
+		case m.exportSignKey == nil:
+			m.failComplianceExport(jobCtx, job, "compliance export is not enabled")
+		default:
+			log.ZInfo(jobCtx, "resume interrupted compliance export", "jobID", job.JobID, "attempts", job.Attempts)
+			go m.runComplianceExport(jobCtx, job)
+		}
+	}
+}
+
+func (m *msgServer) failComplianceExport(ctx context.Context, job *model.ComplianceExportJob, errMsg string) {
+	data := map[string]any{"status": constant.ComplianceExportFailed, "err_msg": errMsg, "update_time": time.Now()}
+	if err := m.complianceDatabase.UpdateExportJob(ctx, job.JobID, data); err != nil {
+		log.ZError(ctx, "update compliance export job failed", err, "jobID", job.JobID)
+	}
+}
+
+// complianceExport writes the archive to a temp file, then uploads it and records its hash.
+func (m *msgServer) complianceExport(ctx context.Context, job *model.ComplianceExportJob) error {
+	file, err := os.CreateTemp("", "compliance-export-*.zip")
+	if err != nil {
+		return errs.WrapMsg(err, "create temp file failed")
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+	manifest := &complianceManifest{
+		JobID:           job.JobID,
+		UserID:          job.UserID,
+		ConversationIDs: job.ConversationIDs,
+		CreateTime:      job.CreateTime.UnixMilli(),
+		PublicKey:       base64.StdEncoding.EncodeToString(m.exportSignKey.Public().(ed25519.PublicKey)),
+	}
+	zw := zip.NewWriter(file)
+	objectNames, err := m.writeComplianceMsgs(ctx, zw, manifest)
+	if err != nil {
+		return err
+	}
+	for _, name := range objectNames {
+		ok, err := m.writeComplianceObject(ctx, zw, manifest, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			manifest.ObjectCount++
+		} else {
+			manifest.MissingObjects = append(manifest.MissingObjects, name)
+		}
+	}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if err := writeComplianceEntry(zw, "manifest.json", manifestData); err != nil {
+		return err
+	}
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(m.exportSignKey, manifestData))
+	if err := writeComplianceEntry(zw, "manifest.sig", []byte(signature)); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return errs.WrapMsg(err, "write compliance export failed")
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return errs.Wrap(err)
+	}
+	h := sha256.New()
+	size, err := io.Copy(h, file)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := m.uploadComplianceExport(ctx, job.ObjectName, file, size); err != nil {
+		return err
+	}
+	data := map[string]any{
+		"status":       constant.ComplianceExportFinished,
+		"msg_count":    manifest.MsgCount,
+		"object_count": manifest.ObjectCount,
+		"size":         size,
+		"sha256":       hex.EncodeToString(h.Sum(nil)),
+		"update_time":  time.Now(),
+	}
+	return m.complianceDatabase.UpdateExportJob(ctx, job.JobID, data)
+}
+
+// writeComplianceMsgs writes every stored message of the job's conversations to messages.jsonl
+// and returns the distinct object names they reference.
+func (m *msgServer) writeComplianceMsgs(ctx context.Context, zw *zip.Writer, manifest *complianceManifest) ([]string, error) {
+	entry, err := newComplianceEntry(zw, "messages.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	var objectNames []string
+	encoder := json.NewEncoder(entry)
+	for _, conversationID := range manifest.ConversationIDs {
+		err := m.rangeConversationMsgs(ctx, conversationID, func(info *model.MsgInfoModel) error {
+			record := newComplianceMsgRecord(conversationID, info)
+			objectNames = append(objectNames, record.Objects...)
+			if err := encoder.Encode(record); err != nil {
+				return errs.WrapMsg(err, "write compliance messages failed")
+			}
+			manifest.MsgCount++
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	manifest.Files = append(manifest.Files, entry.file())
+	return datautil.Distinct(objectNames), nil
+}
+
+// rangeConversationMsgs calls fn with every stored message of a conversation, including
+// revoked, deleted and archived ones.
+func (m *msgServer) rangeConversationMsgs(ctx context.Context, conversationID string, fn func(info *model.MsgInfoModel) error) error {
+	maxSeq, err := m.MsgDatabase.GetMaxSeq(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if maxSeq <= 0 {
+		return nil
+	}
+	var docModel model.MsgDocModel
+	for index := int64(0); index <= docModel.GetDocIndex(maxSeq); index++ {
+		doc, err := m.MsgDatabase.FindMsgDoc(ctx, conversationID+":"+strconv.FormatInt(index, 10))
+		if err != nil {
+			if IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		for _, info := range doc.Msg {
+			if info == nil || info.Msg == nil {
+				continue
+			}
+			if err := fn(info); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// conversationObjectNames returns the distinct object names referenced by the messages of the conversations.
+func (m *msgServer) conversationObjectNames(ctx context.Context, conversationIDs []string) ([]string, error) {
+	var objectNames []string
+	for _, conversationID := range conversationIDs {
+		err := m.rangeConversationMsgs(ctx, conversationID, func(info *model.MsgInfoModel) error {
+			objectNames = append(objectNames, contentObjectNames(info.Msg.Content)...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return datautil.Distinct(objectNames), nil
+}
+
+func newComplianceMsgRecord(conversationID string, info *model.MsgInfoModel) *complianceMsgRecord {
+	return &complianceMsgRecord{
+		ConversationID:   conversationID,
+		Seq:              info.Msg.Seq,
+		ServerMsgID:      info.Msg.ServerMsgID,
+		ClientMsgID:      info.Msg.ClientMsgID,
+		SendID:           info.Msg.SendID,
+		RecvID:           info.Msg.RecvID,
+		GroupID:          info.Msg.GroupID,
+		SenderPlatformID: info.Msg.SenderPlatformID,
+		SenderNickname:   info.Msg.SenderNickname,
+		SessionType:      info.Msg.SessionType,
+		MsgFrom:          info.Msg.MsgFrom,
+		ContentType:      info.Msg.ContentType,
+		Content:          info.Msg.Content,
+		SendTime:         info.Msg.SendTime,
+		CreateTime:       info.Msg.CreateTime,
+		Status:           info.Msg.Status,
+		IsRead:           info.IsRead || info.Msg.IsRead,
+		AtUserIDList:     info.Msg.AtUserIDList,
+		AttachedInfo:     info.Msg.AttachedInfo,
+		Ex:               info.Msg.Ex,
+		Revoke:           info.Revoke,
+		DelUserIDs:       info.DelList,
+		Objects:          contentObjectNames(info.Msg.Content),
+	}
+}
+
+// contentObjectNames returns the names of the uploaded objects a message content links to.
+func contentObjectNames(content string) []string {
+	return datautil.Distinct(datautil.Slice(contentObjectURLs(content), objectNameFromURL))
+}
+
+// contentObjectURLs returns every URL of the form {api}/object/{name} found in the content JSON.
+func contentObjectURLs(content string) []string {
+	var value any
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		return nil
+	}
+	var urls []string
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for _, item := range v {
+				walk(item)
+			}
+		case []any:
+			for _, item := range v {
+				walk(item)
+			}
+		case string:
+			if objectNameFromURL(v) != "" {
+				urls = append(urls, v)
+			}
+		}
+	}
+	walk(value)
+	return datautil.Distinct(urls)
+}
+
+func objectNameFromURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme == "" {
+		return ""
+	}
+	_, name, ok := strings.Cut(u.Path, "/object/")
+	if !ok {
+		return ""
+	}
+	return name
+}
+
+// writeComplianceObject copies an object into objects/. It returns false when the object can
+// no longer be read, which is recorded in the manifest instead of failing the export.
+func (m *msgServer) writeComplianceObject(ctx context.Context, zw *zip.Writer, manifest *complianceManifest, name string) (bool, error) {
+	resp, err := m.thirdClient.AccessURL(ctx, &third.AccessURLReq{Name: name})
+	if err != nil {
+		log.ZWarn(ctx, "compliance export object not found", err, "name", name)
+		return false, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, complianceObjectTimeout)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, resp.Url, nil)
+	if err != nil {
+		log.ZWarn(ctx, "compliance export invalid object url", err, "name", name)
+		return false, nil
+	}
+	httpResp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		log.ZWarn(ctx, "compliance export download object failed", err, "name", name)
+		return false, nil
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		log.ZWarn(ctx, "compliance export download object failed", nil, "name", name, "status", httpResp.Status)
+		return false, nil
+	}
+	entry, err := newComplianceEntry(zw, "objects/"+strings.TrimPrefix(path.Clean("/"+name), "/"))
+	if err != nil {
+		return false, err
+	}
+	// a broken download has already started the zip entry, so it fails the whole export
+	if _, err := io.Copy(entry, httpResp.Body); err != nil {
+		return false, errs.WrapMsg(err, "download object failed", "name", name)
+	}
+	manifest.Files = append(manifest.Files, entry.file())
+	return true, nil
+}
+
+func (m *msgServer) uploadComplianceExport(ctx context.Context, name string, body io.Reader, size int64) error {
+	rawURL, err := m.s3.PresignedPutObject(ctx, name, complianceUploadTimeout)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, complianceUploadTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, rawURL, body)
+	if err != nil {
+		return errs.WrapMsg(err, "invalid compliance export url")
+	}
+	req.ContentLength = size
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errs.WrapMsg(err, "upload compliance export failed", "name", name)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errs.New("upload compliance export failed", "name", name, "status", resp.Status).Wrap()
+	}
+	return nil
+}
+
+// complianceEntry hashes a zip entry while it is written.
+type complianceEntry struct {
+	path string
+	w    io.Writer
+	hash hash.Hash
+	size int64
+}
+
+func newComplianceEntry(zw *zip.Writer, name string) (*complianceEntry, error) {
+	w, err := zw.Create(name)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "create zip entry failed", "name", name)
+	}
+	return &complianceEntry{path: name, w: w, hash: sha256.New()}, nil
+}
+
+func (e *complianceEntry) Write(p []byte) (int, error) {
+	n, err := e.w.Write(p)
+	e.hash.Write(p[:n])
+	e.size += int64(n)
+	return n, err
+}
+
+func (e *complianceEntry) file() *complianceFile {
+	return &complianceFile{Path: e.path, Size: e.size, Sha256: hex.EncodeToString(e.hash.Sum(nil))}
+}
+
+func writeComplianceEntry(zw *zip.Writer, name string, data []byte) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return errs.WrapMsg(err, "create zip entry failed", "name", name)
+	}
+	_, err = w.Write(data)
+	return errs.Wrap(err)
+}
@@ -0,0 +1,154 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type complianceMsgDatabase struct {
+	controller.CommonMsgDatabase
+	maxSeqs map[string]int64
+	docs    map[string]*model.MsgDocModel
+}
+
+func (d *complianceMsgDatabase) GetMaxSeq(ctx context.Context, conversationID string) (int64, error) {
+	return d.maxSeqs[conversationID], nil
+}
+
+func (d *complianceMsgDatabase) FindMsgDoc(ctx context.Context, docID string) (*model.MsgDocModel, error) {
+	doc, ok := d.docs[docID]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return doc, nil
+}
+
+func objectMsg(seq int64, sendID string, content string) *model.MsgInfoModel {
+	return &model.MsgInfoModel{Msg: &model.MsgDataModel{Seq: seq, SendID: sendID, Content: content}}
+}
+
+func TestLegalHoldPatterns(t *testing.T) {
+	patterns := legalHoldPatterns([]*model.LegalHold{
+		{TargetType: constant.LegalHoldTargetUser, TargetID: "u1"},
+		{TargetType: constant.LegalHoldTargetConversation, TargetID: "sg_g1"},
+	}, map[string][]string{"u1": {"si_u1_u2", "sg_g2", "g_g4"}})
+	assert.True(t, matchAny(patterns, "si_u1_u2:0"))
+	assert.True(t, matchAny(patterns, "si_u0_u1:3"))
+	assert.True(t, matchAny(patterns, "sn_u1_admin:0"))
+	assert.False(t, matchAny(patterns, "si_u10_u2:0"))
+	assert.False(t, matchAny(patterns, "si_u0_u10:0"))
+	assert.True(t, matchAny(patterns, "sg_g1:12"))
+	assert.False(t, matchAny(patterns, "sg_g10:0"))
+	// group chats of a held user are held through the user, whoever sent the message
+	assert.True(t, matchAny(patterns, "sg_g2:0"))
+	assert.True(t, matchAny(patterns, "g_g4:3"))
+	assert.False(t, matchAny(patterns, "sg_g3:0"))
+	assert.False(t, matchAny(patterns, "sg_g20:0"))
+}
+
+func TestContentObjectNames(t *testing.T) {
+	content := `{"sourcePicture":{"url":"https://im.example.com/api/object/u1/a%20b.jpg?type=image"},` +
+		`"snapshotUrl":"https://im.example.com/api/object/u1/snapshot.jpg","url":"https://cdn.example.com/x.mp4",` +
+		`"list":["https://im.example.com/api/object/u1/snapshot.jpg"],"text":"object/u1/no-scheme"}`
+	assert.ElementsMatch(t, []string{"u1/a b.jpg", "u1/snapshot.jpg"}, contentObjectNames(content))
+	assert.Empty(t, contentObjectNames("not json"))
+}
+
+func TestParseExportSignKey(t *testing.T) {
+	key, err := parseExportSignKey("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=")
+	assert.NoError(t, err)
+	assert.Len(t, key, 64)
+	_, err = parseExportSignKey("AAEC")
+	assert.Error(t, err)
+}
+
+func TestMatchHeldConversations(t *testing.T) {
+	patterns := legalHoldPatterns([]*model.LegalHold{{TargetType: constant.LegalHoldTargetUser, TargetID: "u1"}}, map[string][]string{"u1": {"si_u1_u2", "sg_g2"}})
+	held := matchHeldConversations(patterns, []string{"si_u1_u2", "si_u0_u1", "sg_g1", "sg_g2", "si_u2_u3"})
+	assert.Equal(t, map[string]struct{}{"si_u1_u2": {}, "si_u0_u1": {}, "sg_g2": {}}, held)
+	assert.Empty(t, matchHeldConversations(nil, []string{"si_u1_u2"}))
+}
+
+func TestConversationObjectNames(t *testing.T) {
+	db := &complianceMsgDatabase{
+		maxSeqs: map[string]int64{"si_u1_u2": 150, "sg_g1": 1},
+		docs: map[string]*model.MsgDocModel{
+			// the first doc of si_u1_u2 was destructed, objects sent by the peer are held too
+			"si_u1_u2:1": {Msg: []*model.MsgInfoModel{
+				objectMsg(101, "u2", `{"sourcePicture":{"url":"https://im.example.com/api/object/u2/a.jpg"}}`),
+				nil,
+				objectMsg(103, "u1", `{"url":"https://im.example.com/api/object/u1/b.mp4"}`),
+				objectMsg(104, "u1", `{"url":"https://im.example.com/api/object/u2/a.jpg"}`),
+			}},
+			"sg_g1:0": {Msg: []*model.MsgInfoModel{
+				objectMsg(1, "u3", `{"url":"https://im.example.com/api/object/u3/c.pdf"}`),
+			}},
+		},
+	}
+	m := &msgServer{MsgDatabase: db}
+	names, err := m.conversationObjectNames(context.Background(), []string{"si_u1_u2", "si_u5_u6"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"u2/a.jpg", "u1/b.mp4"}, names)
+}
+
+type exportJobDatabase struct {
+	controller.ComplianceDatabase
+	expired []*model.ComplianceExportJob
+	updates map[string]map[string]any
+}
+
+func (d *exportJobDatabase) TakeExpiredExportJob(ctx context.Context, now time.Time, leaseExpire time.Time) (*model.ComplianceExportJob, error) {
+	if len(d.expired) == 0 {
+		return nil, nil
+	}
+	job := d.expired[0]
+	d.expired = d.expired[1:]
+	job.Attempts++
+	job.LeaseExpire = leaseExpire
+	return job, nil
+}
+
+func (d *exportJobDatabase) UpdateExportJob(ctx context.Context, jobID string, data map[string]any) error {
+	d.updates[jobID] = data
+	return nil
+}
+
+func TestRecoverExpiredComplianceExports(t *testing.T) {
+	db := &exportJobDatabase{
+		expired: []*model.ComplianceExportJob{
+			{JobID: "j1", Status: constant.ComplianceExportRunning, Attempts: maxComplianceAttempts},
+			{JobID: "j2", Status: constant.ComplianceExportRunning, Attempts: 1},
+		},
+		updates: make(map[string]map[string]any),
+	}
+	m := &msgServer{complianceDatabase: db}
+	m.recoverExpiredComplianceExports(context.Background())
+	assert.Empty(t, db.expired)
+	assert.Equal(t, constant.ComplianceExportFailed, db.updates["j1"]["status"])
+	assert.Equal(t, "export interrupted", db.updates["j1"]["err_msg"])
+	// without a sign key the export can not be resumed
+	assert.Equal(t, constant.ComplianceExportFailed, db.updates["j2"]["status"])
+	assert.Equal(t, "compliance export is not enabled", db.updates["j2"]["err_msg"])
+}
@@ -22,7 +22,9 @@ import (
 	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/timeutil"
 )
 
@@ -70,6 +72,17 @@ func (m *msgServer) DeleteMsgs(ctx context.Context, req *msg.DeleteMsgsReq) (*ms
 		return nil, err
 	}
 	isSyncSelf, isSyncOther := m.validateDeleteSyncOpt(req.DeleteSyncOpt)
+	if isSyncOther {
+		held, err := m.getHeldConversationIDs(ctx, []string{req.ConversationID})
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := held[req.ConversationID]; ok {
+			// messages under legal hold are only deleted for the user
+			log.ZInfo(ctx, "DeleteMsgs conversation under legal hold", "conversationID", req.ConversationID, "userID", req.UserID)
+			isSyncOther = false
+		}
+	}
 	if isSyncOther {
 		if err := m.MsgDatabase.DeleteMsgsPhysicalBySeqs(ctx, req.ConversationID, req.Seqs); err != nil {
 			return nil, err
@@ -94,7 +107,14 @@ func (m *msgServer) DeleteMsgs(ctx context.Context, req *msg.DeleteMsgsReq) (*ms
 }
 
 func (m *msgServer) DeleteMsgPhysicalBySeq(ctx context.Context, req *msg.DeleteMsgPhysicalBySeqReq) (*msg.DeleteMsgPhysicalBySeqResp, error) {
-	err := m.MsgDatabase.DeleteMsgsPhysicalBySeqs(ctx, req.ConversationID, req.Seqs)
+	held, err := m.getHeldConversationIDs(ctx, []string{req.ConversationID})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := held[req.ConversationID]; ok {
+		return nil, errs.ErrNoPermission.WrapMsg("conversation is under legal hold", "conversationID", req.ConversationID)
+	}
+	err = m.MsgDatabase.DeleteMsgsPhysicalBySeqs(ctx, req.ConversationID, req.Seqs)
 	if err != nil {
 		return nil, err
 	}
@@ -105,8 +125,12 @@ func (m *msgServer) DeleteMsgPhysical(ctx context.Context, req *msg.DeleteMsgPhy
 	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
 		return nil, err
 	}
+	holdPatterns, err := m.getLegalHoldPatterns(ctx)
+	if err != nil {
+		return nil, err
+	}
 	remainTime := timeutil.GetCurrentTimestampBySecond() - req.Timestamp
-	if _, err := m.DestructMsgs(ctx, &msg.DestructMsgsReq{Timestamp: remainTime, Limit: 9999}); err != nil {
+	if _, err := m.destructMsgs(ctx, remainTime, 9999, nil, holdPatterns); err != nil {
 		return nil, err
 	}
 	return &msg.DeleteMsgPhysicalResp{}, nil
@@ -129,27 +153,46 @@ func (m *msgServer) clearConversation(ctx context.Context, conversationIDs []str
 		return err
 	}
 	isSyncSelf, isSyncOther := m.validateDeleteSyncOpt(deleteSyncOpt)
+	setSeqs := m.getMinSeqs(maxSeqs)
 	if !isSyncOther {
-		setSeqs := m.getMinSeqs(maxSeqs)
-		if err := m.MsgDatabase.SetUserConversationsMinSeqs(ctx, userID, setSeqs); err != nil {
+		if err := m.setUserConversationsMinSeqs(ctx, userID, setSeqs); err != nil {
 			return err
 		}
-		ownerUserIDs := []string{userID}
-		for conversationID, seq := range setSeqs {
-			if err := m.conversationClient.SetConversationMinSeq(ctx, conversationID, ownerUserIDs, seq); err != nil {
-				return err
-			}
-		}
 		// notification 2 self
 		if isSyncSelf {
 			tips := &sdkws.ClearConversationTips{UserID: userID, ConversationIDs: existConversationIDs}
 			m.notificationSender.NotificationWithSessionType(ctx, userID, userID, constant.ClearConversationNotification, constant.SingleChatType, tips)
 		}
 	} else {
-		if err := m.MsgDatabase.SetMinSeqs(ctx, m.getMinSeqs(maxSeqs)); err != nil {
+		held, err := m.getHeldConversationIDs(ctx, existConversationIDs)
+		if err != nil {
 			return err
 		}
+		// conversations under legal hold are only cleared for the user
+		heldSeqs := make(map[string]int64)
+		for conversationID := range held {
+			if seq, ok := setSeqs[conversationID]; ok {
+				heldSeqs[conversationID] = seq
+				delete(setSeqs, conversationID)
+			}
+		}
+		if len(setSeqs) > 0 {
+			if err := m.MsgDatabase.SetMinSeqs(ctx, setSeqs); err != nil {
+				return err
+			}
+		}
+		if len(heldSeqs) > 0 {
+			log.ZInfo(ctx, "ClearConversationsMsg conversations under legal hold", "conversationIDs", datautil.Keys(heldSeqs), "userID", userID)
+			if err := m.setUserConversationsMinSeqs(ctx, userID, heldSeqs); err != nil {
+				return err
+			}
+			tips := &sdkws.ClearConversationTips{UserID: userID, ConversationIDs: datautil.Keys(heldSeqs)}
+			m.notificationSender.NotificationWithSessionType(ctx, userID, userID, constant.ClearConversationNotification, constant.SingleChatType, tips)
+		}
 		for _, conversation := range existConversations {
+			if _, ok := held[conversation.ConversationID]; ok {
+				continue
+			}
 			tips := &sdkws.ClearConversationTips{UserID: userID, ConversationIDs: []string{conversation.ConversationID}}
 			m.notificationSender.NotificationWithSessionType(ctx, userID, m.conversationAndGetRecvID(conversation, userID), constant.ClearConversationNotification, conversation.ConversationType, tips)
 		}
@@ -159,3 +202,16 @@ func (m *msgServer) clearConversation(ctx context.Context, conversationIDs []str
 	}
 	return nil
 }
+
+func (m *msgServer) setUserConversationsMinSeqs(ctx context.Context, userID string, setSeqs map[string]int64) error {
+	if err := m.MsgDatabase.SetUserConversationsMinSeqs(ctx, userID, setSeqs); err != nil {
+		return err
+	}
+	ownerUserIDs := []string{userID}
+	for conversationID, seq := range setSeqs {
+		if err := m.conversationClient.SetConversationMinSeq(ctx, conversationID, ownerUserIDs, seq); err != nil {
+			return err
+		}
+	}
+	return nil
+}
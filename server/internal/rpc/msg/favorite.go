@@ -0,0 +1,248 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/open-im-server/v3/internal/rpc/incrversion"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/protocol/third"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/encrypt"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// favoriteID is derived from the message, adding the same message again returns the favorite.
+func favoriteID(conversationID string, seq int64) string {
+	return encrypt.Md5(conversationID + "_" + strconv.FormatInt(seq, 10))
+}
+
+// favoriteObjectName is the name of the copy of an object kept for the favorite.
+func favoriteObjectName(userID string, favoriteID string, copyID string, name string) string {
+	return strings.Join([]string{model.FavoriteObjectGroup, userID, favoriteID, copyID, name}, "/")
+}
+
+func (m *msgServer) AddFavorite(ctx context.Context, req *msg.AddFavoriteReq) (*msg.AddFavoriteResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	id := favoriteID(req.ConversationID, req.Seq)
+	if favorite, err := m.favoriteDatabase.TakeFavorite(ctx, req.UserID, id); err == nil {
+		return &msg.AddFavoriteResp{Favorite: convert.Db2PbFavorite(favorite)}, nil
+	} else if !mgo.IsNotFound(err) {
+		return nil, err
+	}
+	if _, err := m.ConversationLocalCache.GetConversation(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil || msgs[0].Status == constant.MsgStatusHasDeleted || msgs[0].Status == constant.MsgDeleted {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	if msgs[0].ContentType == constant.MsgRevokeNotification {
+		return nil, servererrs.ErrMsgAlreadyRevoke.WrapMsg("msg already revoke")
+	}
+	now := time.Now()
+	favorite := &model.Favorite{
+		FavoriteID:     id,
+		UserID:         req.UserID,
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		Tags:           datautil.Distinct(req.Tags),
+		Note:           req.Note,
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	uid, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.WrapMsg(err, "uuid NewRandom failed")
+	}
+	favorite.Msg = convert.MsgPb2DB(m.snapshotFavoriteMsg(ctx, favorite, hex.EncodeToString(uid[:]), msgs[0]))
+	if err := m.favoriteDatabase.CreateFavorite(ctx, favorite); err != nil {
+		// the copies are named after this attempt, so those of a concurrent add stay
+		m.deleteFavoriteObjects(ctx, []*model.Favorite{favorite})
+		if !mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+			return nil, err
+		}
+		// the message was added by a concurrent request, return that favorite
+		existing, err := m.favoriteDatabase.TakeFavorite(ctx, req.UserID, id)
+		if err != nil {
+			return nil, err
+		}
+		return &msg.AddFavoriteResp{Favorite: convert.Db2PbFavorite(existing)}, nil
+	}
+	m.msgNotificationSender.FavoritesChangedNotification(ctx, req.UserID)
+	return &msg.AddFavoriteResp{Favorite: convert.Db2PbFavorite(favorite)}, nil
+}
+
+// snapshotFavoriteMsg copies the objects the message links to and points the content to the
+// copies, so the favorite keeps its files after the message and its uploads are cleaned up.
+// The copies are named after copyID, unique to the attempt. An object that can no longer be
+// copied keeps its source URL.
+func (m *msgServer) snapshotFavoriteMsg(ctx context.Context, favorite *model.Favorite, copyID string, msgData *sdkws.MsgData) *sdkws.MsgData {
+	content := string(msgData.Content)
+	for _, rawURL := range contentObjectURLs(content) {
+		name := objectNameFromURL(rawURL)
+		newName := favoriteObjectName(favorite.UserID, favorite.FavoriteID, copyID, name)
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			continue
+		}
+		u.Path = strings.Replace(u.Path, "/object/"+name, "/object/"+newName, 1)
+		u.RawPath = ""
+		if _, err := m.thirdClient.CopyObject(ctx, &third.CopyObjectReq{Name: name, NewName: newName, Group: model.FavoriteObjectGroup}); err != nil {
+			log.ZWarn(ctx, "favorite copy object failed", err, "name", name)
+			continue
+		}
+		favorite.Attachments = append(favorite.Attachments, &model.FavoriteAttachment{
+			SourceURL: rawURL,
+			URL:       u.String(),
+			Name:      newName,
+		})
+		content = strings.ReplaceAll(content, rawURL, u.String())
+	}
+	msgData.Content = []byte(content)
+	return msgData
+}
+
+func (m *msgServer) deleteFavoriteObjects(ctx context.Context, favorites []*model.Favorite) {
+	var names []string
+	for _, favorite := range favorites {
+		for _, attachment := range favorite.Attachments {
+			names = append(names, attachment.Name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	if _, err := m.thirdClient.DeleteObjects(ctx, &third.DeleteObjectsReq{Names: names}); err != nil {
+		log.ZWarn(ctx, "delete favorite objects failed", err, "names", names)
+	}
+}
+
+func (m *msgServer) DeleteFavorites(ctx context.Context, req *msg.DeleteFavoritesReq) (*msg.DeleteFavoritesResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	favorites, err := m.favoriteDatabase.FindFavorites(ctx, req.UserID, datautil.Distinct(req.FavoriteIDs))
+	if err != nil {
+		return nil, err
+	}
+	if len(favorites) == 0 {
+		return &msg.DeleteFavoritesResp{}, nil
+	}
+	favoriteIDs := datautil.Slice(favorites, func(f *model.Favorite) string { return f.FavoriteID })
+	if err := m.favoriteDatabase.DeleteFavorites(ctx, req.UserID, favoriteIDs); err != nil {
+		return nil, err
+	}
+	m.deleteFavoriteObjects(ctx, favorites)
+	m.msgNotificationSender.FavoritesChangedNotification(ctx, req.UserID)
+	return &msg.DeleteFavoritesResp{}, nil
+}
+
+// UpdateFavorite replaces the tags and the note of the favorite.
+func (m *msgServer) UpdateFavorite(ctx context.Context, req *msg.UpdateFavoriteReq) (*msg.UpdateFavoriteResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := m.favoriteDatabase.TakeFavorite(ctx, req.UserID, req.FavoriteID); err != nil {
+		return nil, err
+	}
+	data := map[string]any{
+		"tags":        datautil.Distinct(req.Tags),
+		"note":        req.Note,
+		"update_time": time.Now(),
+	}
+	if err := m.favoriteDatabase.UpdateFavorite(ctx, req.UserID, req.FavoriteID, data); err != nil {
+		return nil, err
+	}
+	m.msgNotificationSender.FavoritesChangedNotification(ctx, req.UserID)
+	return &msg.UpdateFavoriteResp{}, nil
+}
+
+func (m *msgServer) SearchFavorites(ctx context.Context, req *msg.SearchFavoritesReq) (*msg.SearchFavoritesResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, favorites, err := m.favoriteDatabase.SearchFavorites(ctx, req.UserID, req.Keyword, req.Tags, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &msg.SearchFavoritesResp{
+		Total:     total,
+		Favorites: datautil.Slice(favorites, convert.Db2PbFavorite),
+	}, nil
+}
+
+func (m *msgServer) GetIncrementalFavorites(ctx context.Context, req *msg.GetIncrementalFavoritesReq) (*msg.GetIncrementalFavoritesResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	opt := incrversion.Option[*msg.FavoriteInfo, msg.GetIncrementalFavoritesResp]{
+		Ctx:           ctx,
+		VersionKey:    req.UserID,
+		VersionID:     req.VersionID,
+		VersionNumber: req.Version,
+		Version:       m.favoriteDatabase.FindFavoriteVersion,
+		Find: func(ctx context.Context, favoriteIDs []string) ([]*msg.FavoriteInfo, error) {
+			favorites, err := m.favoriteDatabase.FindFavorites(ctx, req.UserID, favoriteIDs)
+			if err != nil {
+				return nil, err
+			}
+			return datautil.Slice(favorites, convert.Db2PbFavorite), nil
+		},
+		Resp: func(version *model.VersionLog, delIDs []string, insertList, updateList []*msg.FavoriteInfo, full bool) *msg.GetIncrementalFavoritesResp {
+			return &msg.GetIncrementalFavoritesResp{
+				VersionID: version.ID.Hex(),
+				Version:   uint64(version.Version),
+				Full:      full,
+				Delete:    delIDs,
+				Insert:    insertList,
+				Update:    updateList,
+			}
+		},
+	}
+	return opt.Build()
+}
+
+func (m *msgServer) GetFullFavoriteIDs(ctx context.Context, req *msg.GetFullFavoriteIDsReq) (*msg.GetFullFavoriteIDsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	favoriteIDs, err := m.favoriteDatabase.FindFavoriteIDs(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &msg.GetFullFavoriteIDsResp{FavoriteIDs: favoriteIDs}, nil
+}
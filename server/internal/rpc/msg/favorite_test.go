@@ -0,0 +1,74 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/protocol/third"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type favoriteThirdClient struct {
+	third.ThirdClient
+	copies map[string]string
+}
+
+func (c *favoriteThirdClient) CopyObject(ctx context.Context, in *third.CopyObjectReq, opts ...grpc.CallOption) (*third.CopyObjectResp, error) {
+	if strings.HasPrefix(in.Name, "gone/") {
+		return nil, assert.AnError
+	}
+	c.copies[in.NewName] = in.Name
+	return &third.CopyObjectResp{}, nil
+}
+
+func TestSnapshotFavoriteMsg(t *testing.T) {
+	client := &favoriteThirdClient{copies: make(map[string]string)}
+	m := &msgServer{thirdClient: &rpcli.ThirdClient{ThirdClient: client}}
+	newMsg := func() *sdkws.MsgData {
+		return &sdkws.MsgData{
+			ContentType: constant.Picture,
+			Content:     []byte(`{"sourcePicture":{"url":"https://im.example.com/api/object/u2/a.png"},"bigPicture":{"url":"https://im.example.com/api/object/gone/b.png"}}`),
+		}
+	}
+	favorite := &model.Favorite{FavoriteID: "f1", UserID: "u1"}
+	snapshot := m.snapshotFavoriteMsg(context.Background(), favorite, "c1", newMsg())
+
+	// the snapshot points to the copy, so it does not depend on the source conversation
+	copyName := "favorite/u1/f1/c1/u2/a.png"
+	assert.Equal(t, map[string]string{copyName: "u2/a.png"}, client.copies)
+	assert.Contains(t, string(snapshot.Content), "https://im.example.com/api/object/"+copyName)
+	assert.NotContains(t, string(snapshot.Content), "object/u2/a.png")
+	assert.Equal(t, []*model.FavoriteAttachment{{
+		SourceURL: "https://im.example.com/api/object/u2/a.png",
+		URL:       "https://im.example.com/api/object/" + copyName,
+		Name:      copyName,
+	}}, favorite.Attachments)
+	// an object that can no longer be copied keeps its source URL
+	assert.Contains(t, string(snapshot.Content), "object/gone/b.png")
+
+	// a concurrent add of the same message copies to other names, deleting them leaves these
+	other := &model.Favorite{FavoriteID: "f1", UserID: "u1"}
+	m.snapshotFavoriteMsg(context.Background(), other, "c2", newMsg())
+	assert.Len(t, client.copies, 2)
+	assert.NotEqual(t, favorite.Attachments[0].Name, other.Attachments[0].Name)
+}
@@ -0,0 +1,358 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+const (
+	maxForwardSeqs    = 100
+	maxForwardTargets = 50
+)
+
+// forwardElemKeys maps the content types that can be forwarded to the field of the sdk
+// message struct holding the content, merged messages carry the content there.
+var forwardElemKeys = map[int32]string{
+	constant.Text:         "textElem",
+	constant.Picture:      "pictureElem",
+	constant.Voice:        "soundElem",
+	constant.Video:        "videoElem",
+	constant.File:         "fileElem",
+	constant.AtText:       "atTextElem",
+	constant.Merger:       "mergeElem",
+	constant.Card:         "cardElem",
+	constant.Location:     "locationElem",
+	constant.Custom:       "customElem",
+	constant.Quote:        "quoteElem",
+	constant.AdvancedText: "advancedTextElem",
+}
+
+// atTextElem and quoteElem are the parts of the mention and quote contents kept when forwarded.
+type atTextElem struct {
+	Text        string `json:"text"`
+	AtUsersInfo []struct {
+		AtUserID      string `json:"atUserID"`
+		GroupNickname string `json:"groupNickname"`
+	} `json:"atUsersInfo"`
+}
+
+type quoteElem struct {
+	Text string `json:"text"`
+}
+
+type textElem struct {
+	Content string `json:"content"`
+}
+
+// forwardInfo is the origin of a forwarded message, kept in the attached info so the
+// receivers can show who sent it first and when.
+type forwardInfo struct {
+	SendID         string `json:"sendID"`
+	SenderNickname string `json:"senderNickname"`
+	SenderFaceURL  string `json:"senderFaceUrl"`
+	SendTime       int64  `json:"sendTime"`
+}
+
+type forwardAttachedInfo struct {
+	ForwardInfo *forwardInfo `json:"forwardInfo,omitempty"`
+	// IsPrivateChat is only read, a message burnt after reading is never forwarded
+	IsPrivateChat bool `json:"isPrivateChat,omitempty"`
+}
+
+// mergeElem is the content of a merger message, in the layout the sdk builds it.
+type mergeElem struct {
+	Title        string           `json:"title,omitempty"`
+	AbstractList []string         `json:"abstractList,omitempty"`
+	MultiMessage []map[string]any `json:"multiMessage,omitempty"`
+}
+
+// ForwardMsgs forwards the messages of a conversation the user can see to the targets. The
+// content, including the object URLs, is reused as is, so nothing is uploaded again. Every
+// target goes through the same checks as a message sent to it, a failed target is reported
+// in its result and does not stop the others.
+func (m *msgServer) ForwardMsgs(ctx context.Context, req *msg.ForwardMsgsReq) (*msg.ForwardMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	seqs := datautil.Distinct(req.Seqs)
+	if len(seqs) > maxForwardSeqs {
+		return nil, errs.ErrArgs.WrapMsg("too many seqs", "max", maxForwardSeqs)
+	}
+	if len(req.Targets) > maxForwardTargets {
+		return nil, errs.ErrArgs.WrapMsg("too many targets", "max", maxForwardTargets)
+	}
+	if _, err := m.ConversationLocalCache.GetConversation(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	msgs, err := m.getForwardMsgs(ctx, req.UserID, req.ConversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	user, err := m.UserLocalCache.GetUserInfo(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	var contents []*sdkws.MsgData
+	if req.Merge {
+		merger, err := newForwardMergerMsg(msgs, req.MergeTitle, req.MergeAbstracts)
+		if err != nil {
+			return nil, err
+		}
+		contents = []*sdkws.MsgData{merger}
+	} else {
+		contents = make([]*sdkws.MsgData, 0, len(msgs))
+		for _, msgData := range msgs {
+			content, err := newForwardMsg(msgData)
+			if err != nil {
+				return nil, err
+			}
+			contents = append(contents, content)
+		}
+	}
+	platformID := int32(constant.PlatformNameToID(mcontext.GetOpUserPlatform(ctx)))
+	resp := &msg.ForwardMsgsResp{Results: make([]*msg.ForwardMsgResult, 0, len(req.Targets))}
+	for _, target := range req.Targets {
+		result := &msg.ForwardMsgResult{Target: target}
+		resp.Results = append(resp.Results, result)
+		if err := checkForwardTarget(target); err != nil {
+			setForwardResultErr(result, err)
+			continue
+		}
+		if target.SessionType == constant.SingleChatType {
+			result.ConversationID = msgprocessor.GetConversationIDBySessionType(constant.SingleChatType, user.UserID, target.RecvID)
+		} else {
+			result.ConversationID = msgprocessor.GetConversationIDBySessionType(constant.ReadGroupChatType, target.GroupID)
+		}
+		for _, content := range contents {
+			msgData := &sdkws.MsgData{
+				SendID:           user.UserID,
+				RecvID:           target.RecvID,
+				GroupID:          target.GroupID,
+				ClientMsgID:      GetMsgID(user.UserID),
+				SenderPlatformID: platformID,
+				SenderNickname:   user.Nickname,
+				SenderFaceURL:    user.FaceURL,
+				SessionType:      target.SessionType,
+				MsgFrom:          constant.UserMsgType,
+				ContentType:      content.ContentType,
+				Content:          content.Content,
+				CreateTime:       timeutil.GetCurrentTimestampByMill(),
+				Options:          make(map[string]bool),
+				AttachedInfo:     content.AttachedInfo,
+				Ex:               content.Ex,
+			}
+			sendResp, err := m.SendMsg(ctx, &msg.SendMsgReq{MsgData: msgData})
+			if err != nil {
+				setForwardResultErr(result, err)
+				break
+			}
+			result.Msgs = append(result.Msgs, sendResp)
+		}
+	}
+	return resp, nil
+}
+
+// getForwardMsgs returns the messages in seq order, every seq has to be a message the user
+// can still see.
+func (m *msgServer) getForwardMsgs(ctx context.Context, userID string, conversationID string, seqs []int64) ([]*sdkws.MsgData, error) {
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[int64]*sdkws.MsgData, len(msgs))
+	for _, msgData := range msgs {
+		if msgData == nil || msgData.Status == constant.MsgStatusHasDeleted || msgData.Status == constant.MsgDeleted {
+			continue
+		}
+		found[msgData.Seq] = msgData
+	}
+	res := make([]*sdkws.MsgData, 0, len(seqs))
+	for _, seq := range seqs {
+		msgData, ok := found[seq]
+		if !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("msg not found", "seq", seq)
+		}
+		if msgData.ContentType == constant.MsgRevokeNotification {
+			return nil, servererrs.ErrMsgAlreadyRevoke.WrapMsg("msg already revoke", "seq", seq)
+		}
+		if _, ok := forwardElemKeys[msgData.ContentType]; !ok {
+			return nil, errs.ErrArgs.WrapMsg("msg can not be forwarded", "seq", seq, "contentType", msgData.ContentType)
+		}
+		if isPrivateChatMsg(msgData) {
+			return nil, errs.ErrArgs.WrapMsg("private chat msg can not be forwarded", "seq", seq)
+		}
+		res = append(res, msgData)
+	}
+	sort.Sort(msgprocessor.MsgBySeq(res))
+	return res, nil
+}
+
+// isPrivateChatMsg reports whether the message was sent in a private chat, the client burns it
+// after reading.
+func isPrivateChatMsg(msgData *sdkws.MsgData) bool {
+	if msgData.AttachedInfo == "" {
+		return false
+	}
+	var attached forwardAttachedInfo
+	if err := json.Unmarshal([]byte(msgData.AttachedInfo), &attached); err != nil {
+		return false
+	}
+	return attached.IsPrivateChat
+}
+
+// forwardContent returns the content type and the content a message is forwarded with. A
+// mention or a quote becomes plain text: the mentioned users belong to the source conversation,
+// as does the quoted message.
+func forwardContent(msgData *sdkws.MsgData) (int32, []byte, error) {
+	var text string
+	switch msgData.ContentType {
+	case constant.AtText:
+		var elem atTextElem
+		if err := json.Unmarshal(msgData.Content, &elem); err != nil {
+			return 0, nil, errs.ErrArgs.WrapMsg("msg content is invalid", "seq", msgData.Seq, "contentType", msgData.ContentType)
+		}
+		text = elem.Text
+		for _, info := range elem.AtUsersInfo {
+			if info.AtUserID != "" && info.GroupNickname != "" {
+				text = strings.ReplaceAll(text, "@"+info.AtUserID, "@"+info.GroupNickname)
+			}
+		}
+	case constant.Quote:
+		var elem quoteElem
+		if err := json.Unmarshal(msgData.Content, &elem); err != nil {
+			return 0, nil, errs.ErrArgs.WrapMsg("msg content is invalid", "seq", msgData.Seq, "contentType", msgData.ContentType)
+		}
+		text = elem.Text
+	default:
+		return msgData.ContentType, msgData.Content, nil
+	}
+	content, err := json.Marshal(&textElem{Content: text})
+	if err != nil {
+		return 0, nil, errs.WrapMsg(err, "marshal text elem failed")
+	}
+	return constant.Text, content, nil
+}
+
+// newForwardMsg keeps the content of the message and records its origin. A message that was
+// already forwarded keeps the origin it came with.
+func newForwardMsg(msgData *sdkws.MsgData) (*sdkws.MsgData, error) {
+	contentType, content, err := forwardContent(msgData)
+	if err != nil {
+		return nil, err
+	}
+	var attached forwardAttachedInfo
+	if msgData.AttachedInfo != "" {
+		_ = json.Unmarshal([]byte(msgData.AttachedInfo), &attached)
+	}
+	if attached.ForwardInfo == nil {
+		attached.ForwardInfo = &forwardInfo{
+			SendID:         msgData.SendID,
+			SenderNickname: msgData.SenderNickname,
+			SenderFaceURL:  msgData.SenderFaceURL,
+			SendTime:       msgData.SendTime,
+		}
+	}
+	data, _ := json.Marshal(&forwardAttachedInfo{ForwardInfo: attached.ForwardInfo})
+	return &sdkws.MsgData{
+		ContentType:  contentType,
+		Content:      content,
+		AttachedInfo: string(data),
+		Ex:           msgData.Ex,
+	}, nil
+}
+
+// newForwardMergerMsg puts the messages into one merger message, each of them keeps its
+// sender and send time.
+func newForwardMergerMsg(msgs []*sdkws.MsgData, title string, abstracts []string) (*sdkws.MsgData, error) {
+	elem := mergeElem{
+		Title:        title,
+		AbstractList: abstracts,
+		MultiMessage: make([]map[string]any, 0, len(msgs)),
+	}
+	for _, msgData := range msgs {
+		contentType, content, err := forwardContent(msgData)
+		if err != nil {
+			return nil, err
+		}
+		elem.MultiMessage = append(elem.MultiMessage, map[string]any{
+			"clientMsgID":                msgData.ClientMsgID,
+			"serverMsgID":                msgData.ServerMsgID,
+			"createTime":                 msgData.CreateTime,
+			"sendTime":                   msgData.SendTime,
+			"sessionType":                msgData.SessionType,
+			"sendID":                     msgData.SendID,
+			"recvID":                     msgData.RecvID,
+			"msgFrom":                    msgData.MsgFrom,
+			"contentType":                contentType,
+			"senderPlatformID":           msgData.SenderPlatformID,
+			"senderNickname":             msgData.SenderNickname,
+			"senderFaceUrl":              msgData.SenderFaceURL,
+			"groupID":                    msgData.GroupID,
+			"seq":                        msgData.Seq,
+			"isRead":                     msgData.IsRead,
+			"status":                     constant.MsgStatusSendSuccess,
+			"ex":                         msgData.Ex,
+			forwardElemKeys[contentType]: json.RawMessage(content),
+		})
+	}
+	content, err := json.Marshal(&elem)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "marshal merge elem failed")
+	}
+	return &sdkws.MsgData{ContentType: constant.Merger, Content: content}, nil
+}
+
+func checkForwardTarget(target *msg.ForwardMsgTarget) error {
+	switch target.SessionType {
+	case constant.SingleChatType:
+		if target.RecvID == "" {
+			return errs.ErrArgs.WrapMsg("recvID is empty")
+		}
+		target.GroupID = ""
+	case constant.ReadGroupChatType:
+		if target.GroupID == "" {
+			return errs.ErrArgs.WrapMsg("groupID is empty")
+		}
+		target.RecvID = ""
+	default:
+		return errs.ErrArgs.WrapMsg("unsupported sessionType", "sessionType", target.SessionType)
+	}
+	return nil
+}
+
+func setForwardResultErr(result *msg.ForwardMsgResult, err error) {
+	if codeErr, ok := errs.Unwrap(err).(errs.CodeError); ok {
+		result.ErrCode = int32(codeErr.Code())
+		result.ErrMsg = codeErr.Msg()
+		return
+	}
+	result.ErrCode = errs.ServerInternalError
+	result.ErrMsg = err.Error()
+}
@@ -0,0 +1,139 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewForwardMsg(t *testing.T) {
+	source := &sdkws.MsgData{
+		SendID:         "u1",
+		SenderNickname: "alice",
+		SendTime:       1000,
+		ContentType:    constant.Picture,
+		Content:        []byte(`{"sourcePicture":{"url":"https://s3/object/a.png"}}`),
+		AttachedInfo:   `{"groupHasReadInfo":{"hasReadCount":2}}`,
+	}
+	forwarded, err := newForwardMsg(source)
+	assert.NoError(t, err)
+	assert.Equal(t, source.Content, forwarded.Content)
+	var attached forwardAttachedInfo
+	assert.NoError(t, json.Unmarshal([]byte(forwarded.AttachedInfo), &attached))
+	assert.Equal(t, &forwardInfo{SendID: "u1", SenderNickname: "alice", SendTime: 1000}, attached.ForwardInfo)
+	assert.NotContains(t, forwarded.AttachedInfo, "groupHasReadInfo")
+
+	// forwarding again keeps the first origin
+	forwarded.SendID = "u2"
+	forwarded.SendTime = 2000
+	again, err := newForwardMsg(forwarded)
+	assert.NoError(t, err)
+	assert.Equal(t, forwarded.AttachedInfo, again.AttachedInfo)
+}
+
+func TestForwardContent(t *testing.T) {
+	// the mentions and the quoted message belong to the source conversation, only the text is forwarded
+	at := &sdkws.MsgData{
+		ContentType:  constant.AtText,
+		Content:      []byte(`{"text":"@u2 @u3 hi","atUserList":["u2","u3"],"atUsersInfo":[{"atUserID":"u2","groupNickname":"bob"}],"isAtSelf":false}`),
+		AtUserIDList: []string{"u2", "u3"},
+	}
+	forwarded, err := newForwardMsg(at)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(constant.Text), forwarded.ContentType)
+	assert.JSONEq(t, `{"content":"@bob @u3 hi"}`, string(forwarded.Content))
+	assert.Empty(t, forwarded.AtUserIDList)
+
+	quote := &sdkws.MsgData{
+		ContentType: constant.Quote,
+		Content:     []byte(`{"text":"agreed","quoteMessage":{"contentType":106,"atTextElem":{"text":"@u2","atUserList":["u2"]}}}`),
+	}
+	forwarded, err = newForwardMsg(quote)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(constant.Text), forwarded.ContentType)
+	assert.JSONEq(t, `{"content":"agreed"}`, string(forwarded.Content))
+
+	merger, err := newForwardMergerMsg([]*sdkws.MsgData{at}, "chat", nil)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(merger.Content), "atUserList")
+	assert.Contains(t, string(merger.Content), `"textElem":{"content":"@bob @u3 hi"}`)
+
+	_, err = newForwardMsg(&sdkws.MsgData{ContentType: constant.AtText, Content: []byte("{")})
+	assert.True(t, errs.ErrArgs.Is(err))
+}
+
+type forwardMsgDatabase struct {
+	controller.CommonMsgDatabase
+	msgs []*sdkws.MsgData
+}
+
+func (d *forwardMsgDatabase) GetMsgBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) (int64, int64, []*sdkws.MsgData, error) {
+	return 0, 0, d.msgs, nil
+}
+
+func TestGetForwardMsgs(t *testing.T) {
+	db := &forwardMsgDatabase{msgs: []*sdkws.MsgData{
+		{Seq: 1, ContentType: constant.Text, Content: []byte(`{"content":"hi"}`)},
+		{Seq: 2, ContentType: constant.Picture, Content: []byte(`{}`), AttachedInfo: `{"isPrivateChat":true,"burnDuration":30}`},
+		{Seq: 3, ContentType: constant.Text, Content: []byte(`{"content":"bye"}`), AttachedInfo: `{"isPrivateChat":false}`},
+	}}
+	m := &msgServer{MsgDatabase: db}
+	msgs, err := m.getForwardMsgs(context.Background(), "u1", "si_u1_u2", []int64{3, 1})
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, int64(1), msgs[0].Seq)
+
+	// a private chat message is burnt after reading, it is not forwarded alone or merged
+	_, err = m.getForwardMsgs(context.Background(), "u1", "si_u1_u2", []int64{2})
+	assert.True(t, errs.ErrArgs.Is(err))
+	_, err = m.getForwardMsgs(context.Background(), "u1", "si_u1_u2", []int64{1, 2})
+	assert.True(t, errs.ErrArgs.Is(err))
+}
+
+func TestNewForwardMergerMsg(t *testing.T) {
+	msgs := []*sdkws.MsgData{
+		{SendID: "u1", SendTime: 1000, Seq: 1, ContentType: constant.Text, Content: []byte(`{"content":"hi"}`)},
+		{SendID: "u2", SendTime: 2000, Seq: 2, ContentType: constant.File, Content: []byte(`{"sourceUrl":"https://s3/object/b.txt"}`)},
+	}
+	merger, err := newForwardMergerMsg(msgs, "chat", []string{"u1: hi"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(constant.Merger), merger.ContentType)
+	var elem struct {
+		Title        string   `json:"title"`
+		AbstractList []string `json:"abstractList"`
+		MultiMessage []struct {
+			SendID   string          `json:"sendID"`
+			SendTime int64           `json:"sendTime"`
+			TextElem json.RawMessage `json:"textElem"`
+			FileElem json.RawMessage `json:"fileElem"`
+		} `json:"multiMessage"`
+	}
+	assert.NoError(t, json.Unmarshal(merger.Content, &elem))
+	assert.Equal(t, "chat", elem.Title)
+	assert.Equal(t, []string{"u1: hi"}, elem.AbstractList)
+	assert.Len(t, elem.MultiMessage, 2)
+	assert.Equal(t, "u1", elem.MultiMessage[0].SendID)
+	assert.JSONEq(t, `{"content":"hi"}`, string(elem.MultiMessage[0].TextElem))
+	assert.Equal(t, int64(2000), elem.MultiMessage[1].SendTime)
+	assert.JSONEq(t, `{"sourceUrl":"https://s3/object/b.txt"}`, string(elem.MultiMessage[1].FileElem))
+}
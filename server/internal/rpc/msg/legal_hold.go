@@ -0,0 +1,217 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// legalHoldPatterns returns the doc_id regexes of the conversations under legal hold.
+// A held user covers their single chat and notification conversations, and the group
+// chats among userConversationIDs, the conversations of each held user.
+func legalHoldPatterns(holds []*model.LegalHold, userConversationIDs map[string][]string) []string {
+	patterns := make([]string, 0, len(holds)*2)
+	for _, hold := range holds {
+		quoted := regexp.QuoteMeta(hold.TargetID)
+		switch hold.TargetType {
+		case constant.LegalHoldTargetUser:
+			patterns = append(patterns, "^(si|sn|n)_"+quoted+"_", "^(si|sn|n)_.+_"+quoted+":")
+			for _, conversationID := range userConversationIDs[hold.TargetID] {
+				if msgprocessor.IsGroupConversationID(conversationID) {
+					patterns = append(patterns, "^"+regexp.QuoteMeta(conversationID)+":")
+				}
+			}
+		case constant.LegalHoldTargetConversation:
+			patterns = append(patterns, "^"+quoted+":")
+		}
+	}
+	return patterns
+}
+
+// getHeldUserConversationIDs returns the conversationIDs of each user under legal hold.
+func (m *msgServer) getHeldUserConversationIDs(ctx context.Context, holds []*model.LegalHold) (map[string][]string, error) {
+	userConversationIDs := make(map[string][]string)
+	for _, hold := range holds {
+		if hold.TargetType != constant.LegalHoldTargetUser {
+			continue
+		}
+		conversationIDs, err := m.conversationClient.GetConversationIDs(ctx, hold.TargetID)
+		if err != nil {
+			return nil, err
+		}
+		userConversationIDs[hold.TargetID] = conversationIDs
+	}
+	return userConversationIDs, nil
+}
+
+func (m *msgServer) getLegalHoldPatterns(ctx context.Context) ([]string, error) {
+	holds, err := m.complianceDatabase.FindAllLegalHolds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userConversationIDs, err := m.getHeldUserConversationIDs(ctx, holds)
+	if err != nil {
+		return nil, err
+	}
+	return legalHoldPatterns(holds, userConversationIDs), nil
+}
+
+// getHeldConversationIDs returns the conversationIDs under legal hold.
+func (m *msgServer) getHeldConversationIDs(ctx context.Context, conversationIDs []string) (map[string]struct{}, error) {
+	patterns, err := m.getLegalHoldPatterns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return matchHeldConversations(patterns, conversationIDs), nil
+}
+
+// matchHeldConversations returns the conversationIDs matched by the legal hold patterns.
+func matchHeldConversations(patterns []string, conversationIDs []string) map[string]struct{} {
+	held := make(map[string]struct{})
+	if len(patterns) == 0 {
+		return held
+	}
+	regexps := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		regexps = append(regexps, regexp.MustCompile(pattern))
+	}
+	for _, conversationID := range conversationIDs {
+		// patterns match doc IDs, which are conversationID:index
+		docID := conversationID + ":"
+		for _, re := range regexps {
+			if re.MatchString(docID) {
+				held[conversationID] = struct{}{}
+				break
+			}
+		}
+	}
+	return held
+}
+
+func (m *msgServer) SetLegalHold(ctx context.Context, req *msg.SetLegalHoldReq) (*msg.SetLegalHoldResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	holds := make([]*model.LegalHold, 0, len(req.TargetIDs))
+	for _, targetID := range datautil.Distinct(req.TargetIDs) {
+		holds = append(holds, &model.LegalHold{
+			TargetType: req.TargetType,
+			TargetID:   targetID,
+			Reason:     req.Reason,
+			OpUserID:   mcontext.GetOpUserID(ctx),
+			CreateTime: now,
+		})
+	}
+	if err := m.complianceDatabase.SetLegalHolds(ctx, holds); err != nil {
+		return nil, err
+	}
+	return &msg.SetLegalHoldResp{}, nil
+}
+
+func (m *msgServer) RemoveLegalHold(ctx context.Context, req *msg.RemoveLegalHoldReq) (*msg.RemoveLegalHoldResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := m.complianceDatabase.RemoveLegalHolds(ctx, req.TargetType, req.TargetIDs); err != nil {
+		return nil, err
+	}
+	return &msg.RemoveLegalHoldResp{}, nil
+}
+
+func (m *msgServer) GetLegalHolds(ctx context.Context, req *msg.GetLegalHoldsReq) (*msg.GetLegalHoldsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, holds, err := m.complianceDatabase.PageLegalHolds(ctx, req.TargetType, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.GetLegalHoldsResp{Total: total, Holds: make([]*msg.LegalHold, 0, len(holds))}
+	for _, hold := range holds {
+		resp.Holds = append(resp.Holds, convert.Db2PbLegalHold(hold))
+	}
+	return resp, nil
+}
+
+// GetLegalHoldUserIDs returns the users whose uploaded objects must be kept, and the objects
+// sent in held conversations, whoever uploaded them.
+func (m *msgServer) GetLegalHoldUserIDs(ctx context.Context, req *msg.GetLegalHoldUserIDsReq) (*msg.GetLegalHoldUserIDsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	holds, err := m.complianceDatabase.FindAllLegalHolds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		userIDs         []string
+		conversationIDs []string
+		// the conversations whose messages are held, like the ones retention skips
+		heldConversationIDs []string
+	)
+	userConversationIDs, err := m.getHeldUserConversationIDs(ctx, holds)
+	if err != nil {
+		return nil, err
+	}
+	patterns := legalHoldPatterns(holds, userConversationIDs)
+	for _, hold := range holds {
+		switch hold.TargetType {
+		case constant.LegalHoldTargetUser:
+			userIDs = append(userIDs, hold.TargetID)
+			heldConversationIDs = append(heldConversationIDs, datautil.Keys(matchHeldConversations(patterns, userConversationIDs[hold.TargetID]))...)
+		case constant.LegalHoldTargetConversation:
+			conversationIDs = append(conversationIDs, hold.TargetID)
+			heldConversationIDs = append(heldConversationIDs, hold.TargetID)
+		}
+	}
+	if len(conversationIDs) > 0 {
+		conversations, err := m.conversationClient.GetConversationsByConversationIDs(ctx, conversationIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, conversation := range conversations {
+			if conversation.GroupID != "" {
+				memberIDs, err := m.GroupLocalCache.GetGroupMemberIDs(ctx, conversation.GroupID)
+				if err != nil && !errs.ErrRecordNotFound.Is(err) {
+					return nil, err
+				}
+				userIDs = append(userIDs, memberIDs...)
+				continue
+			}
+			userIDs = append(userIDs, conversation.OwnerUserID, conversation.UserID)
+		}
+	}
+	userIDs = datautil.Filter(datautil.Distinct(userIDs), func(userID string) (string, bool) {
+		return userID, userID != ""
+	})
+	objectNames, err := m.conversationObjectNames(ctx, datautil.Distinct(heldConversationIDs))
+	if err != nil {
+		return nil, err
+	}
+	return &msg.GetLegalHoldUserIDsResp{UserIDs: userIDs, ObjectNames: objectNames}, nil
+}
@@ -39,6 +39,15 @@ func (m *MsgNotificationSender) UserDeleteMsgsNotification(ctx context.Context,
 	m.Notification(ctx, userID, userID, constant.DeleteMsgsNotification, &tips)
 }
 
+// FavoritesChangedNotification tells the other devices of the user to sync their favorites.
+func (m *MsgNotificationSender) FavoritesChangedNotification(ctx context.Context, userID string) {
+	tips := sdkws.FavoritesChangedTips{
+		FromUserID: userID,
+		ToUserID:   userID,
+	}
+	m.Notification(ctx, userID, userID, constant.UserFavoriteChangedNotification, &tips)
+}
+
 func (m *MsgNotificationSender) MarkAsReadNotification(ctx context.Context, conversationID string, sessionType int32, sendID, recvID string, seqs []int64, hasReadSeq int64) {
 	tips := &sdkws.MarkAsReadTips{
 		MarkAsReadUserID: sendID,
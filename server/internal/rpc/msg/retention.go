@@ -0,0 +1,158 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/encrypt"
+)
+
+// retentionPatterns returns the doc_id regexes of the conversations covered by a policy.
+func retentionPatterns(policy *model.MsgRetentionPolicy) []string {
+	patterns := make([]string, 0, len(policy.ScopeIDs))
+	for _, id := range policy.ScopeIDs {
+		switch policy.Scope {
+		case constant.MsgRetentionScopeGroup:
+			quoted := regexp.QuoteMeta(id)
+			patterns = append(patterns, "^sg_"+quoted+":", "^g_"+quoted+":")
+		case constant.MsgRetentionScopeUser:
+			// single chat conversation IDs join the two sorted user IDs with "_"
+			quoted := regexp.QuoteMeta(id)
+			patterns = append(patterns, "^si_"+quoted+"_", "^si_.+_"+quoted+":")
+		case constant.MsgRetentionScopeSessionType:
+			sessionType, _ := strconv.Atoi(id)
+			switch sessionType {
+			case constant.SingleChatType:
+				patterns = append(patterns, "^si_")
+			case constant.WriteGroupChatType:
+				patterns = append(patterns, "^g_")
+			case constant.ReadGroupChatType:
+				patterns = append(patterns, "^sg_")
+			case constant.NotificationChatType:
+				patterns = append(patterns, "^sn_", "^n_")
+			}
+		}
+	}
+	return patterns
+}
+
+// retentionFilter returns the doc_id regexes a destruct pass must and must not match.
+// A conversation belongs to its most specific matching policy; among policies of the same
+// scope the longest retention wins. An empty policyID selects the conversations that no
+// policy covers, which keep the global RetainChatRecords.
+func retentionFilter(policies []*model.MsgRetentionPolicy, policyID string) (include []string, exclude []string, err error) {
+	if policyID == "" {
+		for _, policy := range policies {
+			exclude = append(exclude, retentionPatterns(policy)...)
+		}
+		return nil, exclude, nil
+	}
+	var target *model.MsgRetentionPolicy
+	for _, policy := range policies {
+		if policy.PolicyID == policyID {
+			target = policy
+			break
+		}
+	}
+	if target == nil {
+		return nil, nil, errs.ErrRecordNotFound.WrapMsg("retention policy not found", "policyID", policyID)
+	}
+	for _, policy := range policies {
+		if policy == target {
+			continue
+		}
+		if policy.Scope > target.Scope || (policy.Scope == target.Scope && policy.RetainDays > target.RetainDays) {
+			exclude = append(exclude, retentionPatterns(policy)...)
+		}
+	}
+	return retentionPatterns(target), exclude, nil
+}
+
+func (m *msgServer) SetMsgRetentionPolicy(ctx context.Context, req *msg.SetMsgRetentionPolicyReq) (*msg.SetMsgRetentionPolicyResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	policy := req.Policy
+	if policy.PolicyID == "" {
+		dbPolicy := &model.MsgRetentionPolicy{
+			PolicyID:      encrypt.Md5(strings.Join([]string{policy.Name, mcontext.GetOperationID(ctx), strconv.FormatInt(now.UnixNano(), 10)}, ",")),
+			Name:          policy.Name,
+			Scope:         policy.Scope,
+			ScopeIDs:      policy.ScopeIDs,
+			RetainDays:    policy.RetainDays,
+			CreatorUserID: mcontext.GetOpUserID(ctx),
+			CreateTime:    now,
+			UpdateTime:    now,
+			Ex:            policy.Ex,
+		}
+		if err := m.retentionDatabase.CreatePolicy(ctx, dbPolicy); err != nil {
+			return nil, err
+		}
+		return &msg.SetMsgRetentionPolicyResp{PolicyID: dbPolicy.PolicyID}, nil
+	}
+	if _, err := m.retentionDatabase.TakePolicy(ctx, policy.PolicyID); err != nil {
+		return nil, err
+	}
+	data := map[string]any{
+		"name":        policy.Name,
+		"scope":       policy.Scope,
+		"scope_ids":   policy.ScopeIDs,
+		"retain_days": policy.RetainDays,
+		"ex":          policy.Ex,
+		"update_time": now,
+	}
+	if err := m.retentionDatabase.UpdatePolicy(ctx, policy.PolicyID, data); err != nil {
+		return nil, err
+	}
+	return &msg.SetMsgRetentionPolicyResp{PolicyID: policy.PolicyID}, nil
+}
+
+func (m *msgServer) DeleteMsgRetentionPolicies(ctx context.Context, req *msg.DeleteMsgRetentionPoliciesReq) (*msg.DeleteMsgRetentionPoliciesResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := m.retentionDatabase.DeletePolicies(ctx, req.PolicyIDs); err != nil {
+		return nil, err
+	}
+	return &msg.DeleteMsgRetentionPoliciesResp{}, nil
+}
+
+func (m *msgServer) GetMsgRetentionPolicies(ctx context.Context, req *msg.GetMsgRetentionPoliciesReq) (*msg.GetMsgRetentionPoliciesResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, policies, err := m.retentionDatabase.PagePolicies(ctx, req.Scope, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.GetMsgRetentionPoliciesResp{Total: total, Policies: make([]*msg.MsgRetentionPolicy, 0, len(policies))}
+	for _, policy := range policies {
+		resp.Policies = append(resp.Policies, convert.Db2PbMsgRetentionPolicy(policy))
+	}
+	return resp, nil
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func matchAny(patterns []string, docID string) bool {
+	for _, pattern := range patterns {
+		if regexp.MustCompile(pattern).MatchString(docID) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRetentionFilter(t *testing.T) {
+	policies := []*model.MsgRetentionPolicy{
+		{PolicyID: "class", Scope: constant.MsgRetentionScopeGroup, ScopeIDs: []string{"g1"}, RetainDays: 90},
+		{PolicyID: "teacher", Scope: constant.MsgRetentionScopeUser, ScopeIDs: []string{"t1"}, RetainDays: 1095},
+		{PolicyID: "vip", Scope: constant.MsgRetentionScopeUser, ScopeIDs: []string{"u2"}, RetainDays: 30},
+		{PolicyID: "group", Scope: constant.MsgRetentionScopeSessionType, ScopeIDs: []string{"3"}, RetainDays: 1825},
+		{PolicyID: "notification", Scope: constant.MsgRetentionScopeSessionType, ScopeIDs: []string{"4"}, RetainDays: 30},
+	}
+	selected := func(policyID, docID string) bool {
+		include, exclude, err := retentionFilter(policies, policyID)
+		assert.NoError(t, err)
+		return (len(include) == 0 || matchAny(include, docID)) && !matchAny(exclude, docID)
+	}
+
+	assert.True(t, selected("class", "sg_g1:0"))
+	assert.False(t, selected("class", "sg_g10:0"))
+	assert.False(t, selected("group", "sg_g1:0"))
+	assert.True(t, selected("group", "sg_g2:3"))
+
+	assert.True(t, selected("teacher", "si_p1_t1:0"))
+	assert.True(t, selected("teacher", "si_t1_u2:0"))
+	// the longer teacher policy wins over the vip policy in a shared chat
+	assert.False(t, selected("vip", "si_t1_u2:0"))
+	assert.True(t, selected("vip", "si_u1_u2:0"))
+
+	assert.True(t, selected("notification", "sn_a_b:0"))
+	assert.True(t, selected("notification", "n_g1:0"))
+
+	assert.True(t, selected("", "si_u1_u3:0"))
+	assert.False(t, selected("", "si_p1_t1:0"))
+	assert.False(t, selected("", "sg_g2:0"))
+	assert.False(t, selected("", "n_g1:0"))
+
+	_, _, err := retentionFilter(policies, "missing")
+	assert.Error(t, err)
+}
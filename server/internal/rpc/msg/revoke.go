@@ -80,6 +80,9 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 				switch members[req.UserID].RoleLevel {
 				case constant.GroupOwner:
 				case constant.GroupAdmin:
+					if !authverify.HasGroupPermission(members[req.UserID].RoleLevel, members[req.UserID].Permissions, constant.GroupPermissionRevokeMsg) {
+						return nil, errs.ErrNoPermission.WrapMsg("no revoke permission")
+					}
 					if sendMember, ok := members[msgs[0].SendID]; ok {
 						if sendMember.RoleLevel != constant.GroupOrdinaryUsers {
 							return nil, errs.ErrNoPermission.WrapMsg("no permission")
@@ -16,6 +16,7 @@ package msg
 
 import (
 	"context"
+	"sync"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
@@ -29,6 +30,8 @@ import (
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/datautil"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 )
 
 func (m *msgServer) SendMsg(ctx context.Context, req *pbmsg.SendMsgReq) (*pbmsg.SendMsgResp, error) {
@@ -48,6 +51,47 @@ func (m *msgServer) SendMsg(ctx context.Context, req *pbmsg.SendMsgReq) (*pbmsg.
 	return nil, errs.ErrArgs.WrapMsg("msgData is nil")
 }
 
+// SendNotifications sends one notification to many receivers in a single call, every
+// receiver gets its own copy of the message.
+const sendNotificationsWorkers = 16
+
+func (m *msgServer) SendNotifications(ctx context.Context, req *pbmsg.SendNotificationsReq) (*pbmsg.SendNotificationsResp, error) {
+	if req.MsgData == nil {
+		return nil, errs.ErrArgs.WrapMsg("msgData is nil")
+	}
+	if req.MsgData.ContentType < constant.NotificationBegin || req.MsgData.ContentType > constant.NotificationEnd {
+		return nil, errs.ErrArgs.WrapMsg("not a notification", "contentType", req.MsgData.ContentType)
+	}
+	switch req.MsgData.SessionType {
+	case constant.SingleChatType, constant.NotificationChatType:
+	default:
+		return nil, errs.ErrArgs.WrapMsg("sessionType not supported", "sessionType", req.MsgData.SessionType)
+	}
+	var (
+		mu   sync.Mutex
+		wg   errgroup.Group
+		resp = &pbmsg.SendNotificationsResp{}
+	)
+	// receivers are sent to concurrently so a long list fits in the caller's deadline
+	wg.SetLimit(sendNotificationsWorkers)
+	for _, recvID := range datautil.Distinct(req.RecvIDs) {
+		msgData := proto.Clone(req.MsgData).(*sdkws.MsgData)
+		msgData.RecvID = recvID
+		msgData.ClientMsgID = GetMsgID(msgData.SendID)
+		wg.Go(func() error {
+			if _, err := m.SendMsg(ctx, &pbmsg.SendMsgReq{MsgData: msgData}); err != nil {
+				log.ZWarn(ctx, "send notification failed", err, "recvID", msgData.RecvID, "contentType", msgData.ContentType)
+				mu.Lock()
+				resp.FailedRecvIDs = append(resp.FailedRecvIDs, msgData.RecvID)
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	_ = wg.Wait()
+	return resp, nil
+}
+
 func (m *msgServer) sendMsgGroupChat(ctx context.Context, req *pbmsg.SendMsgReq) (resp *pbmsg.SendMsgResp, err error) {
 	if err = m.messageVerification(ctx, req); err != nil {
 		prommetrics.GroupChatMsgProcessFailedCounter.Inc()
@@ -16,12 +16,14 @@ package msg
 
 import (
 	"context"
+	"crypto/ed25519"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/notification"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/protocol/sdkws"
@@ -34,6 +36,7 @@ import (
 	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/s3"
 	"google.golang.org/grpc"
 )
 
@@ -52,6 +55,8 @@ type Config struct {
 	WebhooksConfig     config.Webhooks
 	LocalCacheConfig   config.LocalCache
 	Discovery          config.Discovery
+	ThirdConfig        config.Third
+	MinioConfig        config.Minio
 }
 
 // MsgServer encapsulates dependencies required for message handling.
@@ -69,6 +74,13 @@ type msgServer struct {
 	config                 *Config                          // Global configuration settings.
 	webhookClient          *webhook.Client
 	conversationClient     *rpcli.ConversationClient
+	retentionDatabase      controller.MsgRetentionDatabase
+	archiveDatabase        controller.MsgArchiveDatabase // nil unless the cold archive is enabled
+	complianceDatabase     controller.ComplianceDatabase
+	favoriteDatabase       controller.FavoriteDatabase
+	thirdClient            *rpcli.ThirdClient
+	s3                     s3.Interface       // nil unless the cold archive or compliance export is enabled
+	exportSignKey          ed25519.PrivateKey // nil unless compliance export is enabled
 }
 
 func (m *msgServer) addInterceptorHandler(interceptorFunc ...MessageInterceptorFunc) {
@@ -85,7 +97,33 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
-	msgDocModel, err := mgo.NewMsgMongo(mgocli.GetDB())
+	var (
+		msgDocModel     database.Msg
+		archiveDatabase controller.MsgArchiveDatabase
+		objectStorage   s3.Interface
+		exportSignKey   ed25519.PrivateKey
+	)
+	if config.RpcConfig.Compliance.Enable {
+		exportSignKey, err = parseExportSignKey(config.RpcConfig.Compliance.SignKey)
+		if err != nil {
+			return err
+		}
+	}
+	if config.RpcConfig.Archive.Enable || config.RpcConfig.Compliance.Enable {
+		objectStorage, err = newObjectStorage(ctx, config, rdb)
+		if err != nil {
+			return err
+		}
+	}
+	if config.RpcConfig.Archive.Enable {
+		archiveDatabase, err = newMsgArchiveDatabase(config, mgocli.GetDB(), objectStorage)
+		if err != nil {
+			return err
+		}
+		msgDocModel, err = mgo.NewArchivedMsgMongo(mgocli.GetDB(), archiveDatabase)
+	} else {
+		msgDocModel, err = mgo.NewMsgMongo(mgocli.GetDB())
+	}
 	if err != nil {
 		return err
 	}
@@ -100,6 +138,22 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 	seqUserCache := redis.NewSeqUserCacheRedis(rdb, seqUser)
+	retentionPolicy, err := mgo.NewMsgRetentionPolicyMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	legalHold, err := mgo.NewLegalHoldMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	exportJob, err := mgo.NewComplianceExportJobMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	favorite, err := mgo.NewFavoriteMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, msgModel, seqUserCache, seqConversationCache, &config.KafkaConfig)
 	if err != nil {
 		return err
@@ -120,6 +174,10 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	thirdConn, err := client.GetConn(ctx, config.Share.RpcRegisterName.Third)
+	if err != nil {
+		return err
+	}
 	conversationClient := rpcli.NewConversationClient(conversationConn)
 	s := &msgServer{
 		MsgDatabase:            msgDatabase,
@@ -131,12 +189,20 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		config:                 config,
 		webhookClient:          webhook.NewWebhookClient(config.WebhooksConfig.URL),
 		conversationClient:     conversationClient,
+		retentionDatabase:      controller.NewMsgRetentionDatabase(retentionPolicy),
+		archiveDatabase:        archiveDatabase,
+		complianceDatabase:     controller.NewComplianceDatabase(legalHold, exportJob),
+		favoriteDatabase:       controller.NewFavoriteDatabase(favorite),
+		thirdClient:            rpcli.NewThirdClient(thirdConn),
+		s3:                     objectStorage,
+		exportSignKey:          exportSignKey,
 	}
 
 	s.notificationSender = notification.NewNotificationSender(&config.NotificationConfig, notification.WithLocalSendMsg(s.SendMsg))
 	s.msgNotificationSender = NewMsgNotificationSender(config, notification.WithLocalSendMsg(s.SendMsg))
 
 	msg.RegisterMsgServer(server, s)
+	go s.recoverComplianceExports(ctx)
 
 	return nil
 }
@@ -16,6 +16,7 @@ package msg
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/encrypt"
@@ -50,6 +51,9 @@ type MessageRevoked struct {
 }
 
 func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgReq) error {
+	defer func(start time.Time) {
+		prommetrics.MsgVerifyDurationHistogram.WithLabelValues(prommetrics.SessionTypeLabel(data.MsgData.SessionType)).Observe(time.Since(start).Seconds())
+	}(time.Now())
 	switch data.MsgData.SessionType {
 	case constant.SingleChatType:
 		if datautil.Contain(data.MsgData.SendID, m.config.Share.IMAdminUserID...) {
@@ -382,6 +382,14 @@ func (s *friendServer) IsFriend(ctx context.Context, req *relation.IsFriendReq)
 	return resp, nil
 }
 
+func (s *friendServer) GetReversalFriends(ctx context.Context, req *relation.GetReversalFriendsReq) (*relation.GetReversalFriendsResp, error) {
+	ownerUserIDs, err := s.db.FindReversalFriendUserIDs(ctx, req.FriendUserID, req.OwnerUserIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &relation.GetReversalFriendsResp{OwnerUserIDs: ownerUserIDs}, nil
+}
+
 func (s *friendServer) GetPaginationFriends(ctx context.Context, req *relation.GetPaginationFriendsReq) (resp *relation.GetPaginationFriendsResp, err error) {
 	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
 		return nil, err
@@ -16,17 +16,20 @@ package third
 
 import (
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 
 	"github.com/google/uuid"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/errs"
@@ -61,26 +64,18 @@ func (t *thirdServer) InitiateMultipartUpload(ctx context.Context, req *third.In
 	if err := t.checkUploadName(ctx, req.Name); err != nil {
 		return nil, err
 	}
+	// the same content uploaded before, by anyone: the name points to the stored file
+	// without uploading it again
+	if blob, err := t.s3dataBase.TakeObjectBlob(ctx, req.Hash, req.Size); err == nil {
+		return t.aliasObject(ctx, req, blob.Key, blob.Size)
+	} else if !mgo.IsNotFound(err) {
+		return nil, err
+	}
 	expireTime := time.Now().Add(t.defaultExpire)
 	result, err := t.s3dataBase.InitiateMultipartUpload(ctx, req.Hash, req.Size, t.defaultExpire, int(req.MaxParts))
 	if err != nil {
 		if haErr, ok := errs.Unwrap(err).(*cont.HashAlreadyExistsError); ok {
-			obj := &model.Object{
-				Name:        req.Name,
-				UserID:      mcontext.GetOpUserID(ctx),
-				Hash:        req.Hash,
-				Key:         haErr.Object.Key,
-				Size:        haErr.Object.Size,
-				ContentType: req.ContentType,
-				Group:       req.Cause,
-				CreateTime:  time.Now(),
-			}
-			if err := t.s3dataBase.SetObject(ctx, obj); err != nil {
-				return nil, err
-			}
-			return &third.InitiateMultipartUploadResp{
-				Url: t.apiAddress(req.UrlPrefix, obj.Name),
-			}, nil
+			return t.aliasObject(ctx, req, haErr.Object.Key, haErr.Object.Size)
 		}
 		return nil, err
 	}
@@ -111,6 +106,28 @@ func (t *thirdServer) InitiateMultipartUpload(ctx context.Context, req *third.In
 	}, nil
 }
 
+// aliasObject stores the upload name as another reference to an existing file, the upload is
+// complete at once.
+func (t *thirdServer) aliasObject(ctx context.Context, req *third.InitiateMultipartUploadReq, key string, size int64) (*third.InitiateMultipartUploadResp, error) {
+	obj := &model.Object{
+		Name:        req.Name,
+		UserID:      mcontext.GetOpUserID(ctx),
+		Hash:        req.Hash,
+		Key:         key,
+		Size:        size,
+		ContentType: req.ContentType,
+		Group:       req.Cause,
+		CreateTime:  time.Now(),
+	}
+	if err := t.s3dataBase.SetObject(ctx, obj); err != nil {
+		return nil, err
+	}
+	log.ZDebug(ctx, "upload deduplicated", "name", obj.Name, "hash", obj.Hash, "key", obj.Key)
+	return &third.InitiateMultipartUploadResp{
+		Url: t.apiAddress(req.UrlPrefix, obj.Name),
+	}, nil
+}
+
 func (t *thirdServer) AuthSign(ctx context.Context, req *third.AuthSignReq) (*third.AuthSignResp, error) {
 	partNumbers := datautil.Slice(req.PartNumbers, func(partNumber int32) int { return int(partNumber) })
 	result, err := t.s3dataBase.AuthSign(ctx, req.UploadID, partNumbers)
@@ -142,10 +159,12 @@ func (t *thirdServer) CompleteMultipartUpload(ctx context.Context, req *third.Co
 	if err != nil {
 		return nil, err
 	}
+	// the content hash the upload was initiated with, as the client computes it
+	hash := md5.Sum([]byte(strings.Join(req.Parts, ",")))
 	obj := &model.Object{
 		Name:        req.Name,
 		UserID:      mcontext.GetOpUserID(ctx),
-		Hash:        result.Hash,
+		Hash:        hex.EncodeToString(hash[:]),
 		Key:         result.Key,
 		Size:        result.Size,
 		ContentType: req.ContentType,
@@ -294,8 +313,8 @@ func (t *thirdServer) DeleteOutdatedData(ctx context.Context, req *third.DeleteO
 	}
 	engine := t.config.RpcConfig.Object.Enable
 	expireTime := time.UnixMilli(req.ExpireTime)
-	// Find all expired data in S3 database
-	models, err := t.s3dataBase.FindExpirationObject(ctx, engine, expireTime, req.ObjectGroup, int64(req.Limit))
+	// Find all expired data in S3 database, keeping the objects under legal hold
+	models, err := t.s3dataBase.FindExpirationObject(ctx, engine, expireTime, req.ObjectGroup, req.ExcludeUserIDs, req.ExcludeNames, int64(req.Limit))
 	if err != nil {
 		return nil, err
 	}
@@ -306,18 +325,95 @@ func (t *thirdServer) DeleteOutdatedData(ctx context.Context, req *third.DeleteO
 		if err := t.s3dataBase.DelS3Key(ctx, engine, obj.Name); err != nil {
 			return nil, err
 		}
-		count, err := t.s3dataBase.GetKeyCount(ctx, engine, obj.Key)
-		if err != nil {
+		if err := t.s3dataBase.ReleaseObjectKey(ctx, engine, obj.Key); err != nil {
 			return nil, err
 		}
-		log.ZDebug(ctx, "delete s3 object record", "index", i, "s3", obj, "count", count)
-		if count == 0 {
-			if err := t.s3.DeleteObject(ctx, obj.Key); err != nil {
-				return nil, err
+		log.ZDebug(ctx, "delete s3 object record", "index", i, "s3", obj)
+	}
+	if err := t.deleteUnreferencedBlobs(ctx, engine, int64(req.Limit)); err != nil {
+		return nil, err
+	}
+	return &third.DeleteOutdatedDataResp{Count: int32(len(models))}, nil
+}
+
+// CopyObject stores newName as another reference to the file of name, the copy is kept after
+// the original object expires or is deleted.
+func (t *thirdServer) CopyObject(ctx context.Context, req *third.CopyObjectReq) (*third.CopyObjectResp, error) {
+	if err := t.checkFavoriteName(ctx, req.NewName); err != nil {
+		return nil, err
+	}
+	obj, err := t.s3dataBase.TakeObject(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	cp := &model.Object{
+		Name:        req.NewName,
+		UserID:      mcontext.GetOpUserID(ctx),
+		Hash:        obj.Hash,
+		Key:         obj.Key,
+		Size:        obj.Size,
+		ContentType: obj.ContentType,
+		Group:       req.Group,
+		CreateTime:  time.Now(),
+	}
+	if err := t.s3dataBase.SetObject(ctx, cp); err != nil {
+		return nil, err
+	}
+	return &third.CopyObjectResp{}, nil
+}
+
+func (t *thirdServer) DeleteObjects(ctx context.Context, req *third.DeleteObjectsReq) (*third.DeleteObjectsResp, error) {
+	for _, name := range req.Names {
+		if err := t.checkFavoriteName(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+	engine := t.config.RpcConfig.Object.Enable
+	for _, name := range req.Names {
+		obj, err := t.s3dataBase.TakeObject(ctx, name)
+		if err != nil {
+			if mgo.IsNotFound(err) {
+				continue
 			}
+			return nil, err
+		}
+		if err := t.s3dataBase.DeleteSpecifiedData(ctx, engine, []string{obj.Name}); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if err := t.s3dataBase.DelS3Key(ctx, engine, obj.Name); err != nil {
+			return nil, err
+		}
+		if err := t.s3dataBase.ReleaseObjectKey(ctx, engine, obj.Key); err != nil {
+			return nil, err
 		}
 	}
-	return &third.DeleteOutdatedDataResp{Count: int32(len(models))}, nil
+	return &third.DeleteObjectsResp{}, nil
+}
+
+// deleteUnreferencedBlobs deletes the stored files whose last object expired or was replaced.
+// A blob is marked first so that no upload starts pointing to it while the file is deleted.
+func (t *thirdServer) deleteUnreferencedBlobs(ctx context.Context, engine string, limit int64) error {
+	blobs, err := t.s3dataBase.FindUnreferencedBlob(ctx, engine, limit)
+	if err != nil {
+		return err
+	}
+	for _, blob := range blobs {
+		ok, err := t.s3dataBase.MarkBlobDeleting(ctx, engine, blob.Key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if err := t.s3.DeleteObject(ctx, blob.Key); err != nil {
+			return err
+		}
+		if err := t.s3dataBase.DeleteBlob(ctx, engine, blob.Key); err != nil {
+			return err
+		}
+		log.ZDebug(ctx, "delete s3 blob", "key", blob.Key, "hash", blob.Hash, "size", blob.Size)
+	}
+	return nil
 }
 
 type FormDataMate struct {
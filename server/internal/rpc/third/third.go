@@ -76,6 +76,10 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	blobDB, err := mgo.NewObjectBlobMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 
 	// Select the oss method according to the profile policy
 	enable := config.RpcConfig.Object.Enable
@@ -106,7 +110,7 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	localcache.InitLocalCache(&config.LocalCacheConfig)
 	third.RegisterThirdServer(server, &thirdServer{
 		thirdDatabase: controller.NewThirdDatabase(redis.NewThirdCache(rdb), logdb),
-		s3dataBase:    controller.NewS3Database(rdb, o, s3db),
+		s3dataBase:    controller.NewS3Database(rdb, o, s3db, blobDB),
 		defaultExpire: time.Hour * 24 * 7,
 		config:        config,
 		s3:            o,
@@ -21,6 +21,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/mcontext"
@@ -62,6 +63,19 @@ func (t *thirdServer) checkUploadName(ctx context.Context, name string) error {
 	return nil
 }
 
+// checkFavoriteName allows the favorite copies of the caller only, or of any user for an admin.
+func (t *thirdServer) checkFavoriteName(ctx context.Context, name string) error {
+	if err := checkValidObjectName(name); err != nil {
+		return errs.ErrArgs.WrapMsg(err.Error())
+	}
+	rest, ok := strings.CutPrefix(name, model.FavoriteObjectGroup+"/")
+	userID, _, _ := strings.Cut(rest, "/")
+	if !ok || userID == "" || userID == rest {
+		return errs.ErrNoPermission.WrapMsg(fmt.Sprintf("name must start with `%s/{userID}/`", model.FavoriteObjectGroup))
+	}
+	return authverify.CheckAccessV3(ctx, userID, t.config.Share.IMAdminUserID)
+}
+
 func checkValidObjectNamePrefix(objectName string) error {
 	if len(objectName) > 1024 {
 		return errs.New("object name cannot be longer than 1024 characters")
@@ -3,6 +3,9 @@ package user
 import (
 	"context"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/datautil"
 
 	"github.com/openimsdk/protocol/constant"
@@ -20,12 +23,69 @@ func (s *userServer) getUserOnlineStatus(ctx context.Context, userID string) (*p
 	}
 	if len(platformIDs) > 0 {
 		status.Status = constant.Online
+		background, err := s.online.GetBackground(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		status.Away = true
+		for _, platformID := range platformIDs {
+			if !datautil.Contain(platformID, background...) {
+				status.Away = false
+				break
+			}
+		}
 	} else {
 		status.Status = constant.Offline
 	}
 	return &status, nil
 }
 
+// setLastSeen fills in the last seen time of the users the caller may see, as allowed by each
+// user's last seen privacy.
+func (s *userServer) setLastSeen(ctx context.Context, statusList []*pbuser.OnlineStatus) error {
+	userIDs := datautil.Slice(statusList, func(status *pbuser.OnlineStatus) string { return status.UserID })
+	lastSeen, err := s.online.GetLastSeen(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	users, err := s.db.Find(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	privacy := datautil.SliceToMapAny(users, func(user *model.User) (string, int32) { return user.UserID, user.LastSeenPrivacy })
+	opUserID := mcontext.GetOpUserID(ctx)
+	isAdmin := authverify.IsManagerUserID(opUserID, s.config.Share.IMAdminUserID)
+	var friendsOnly []string
+	for _, status := range statusList {
+		if _, ok := lastSeen[status.UserID]; ok && !isAdmin && status.UserID != opUserID && privacy[status.UserID] == constant.LastSeenFriends {
+			friendsOnly = append(friendsOnly, status.UserID)
+		}
+	}
+	// the users showing their last seen time to friends only, who have the caller as a friend
+	friendOf, err := s.relationClient.GetReversalFriendUserIDs(ctx, opUserID, datautil.Distinct(friendsOnly))
+	if err != nil {
+		return err
+	}
+	for _, status := range statusList {
+		if _, ok := lastSeen[status.UserID]; !ok {
+			continue
+		}
+		visible := isAdmin || status.UserID == opUserID
+		if !visible {
+			switch privacy[status.UserID] {
+			case constant.LastSeenEveryone:
+				visible = true
+			case constant.LastSeenFriends:
+				visible = datautil.Contain(status.UserID, friendOf...)
+			}
+		}
+		if visible {
+			status.LastSeen = lastSeen[status.UserID]
+		}
+	}
+	return nil
+}
+
 func (s *userServer) getUsersOnlineStatus(ctx context.Context, userIDs []string) ([]*pbuser.OnlineStatus, error) {
 	res := make([]*pbuser.OnlineStatus, 0, len(userIDs))
 	for _, userID := range userIDs {
@@ -49,6 +109,9 @@ func (s *userServer) GetUserStatus(ctx context.Context, req *pbuser.GetUserStatu
 	if err != nil {
 		return nil, err
 	}
+	if err := s.setLastSeen(ctx, res); err != nil {
+		return nil, err
+	}
 	return &pbuser.GetUserStatusResp{StatusList: res}, nil
 }
 
@@ -80,6 +143,15 @@ func (s *userServer) SetUserOnlineStatus(ctx context.Context, req *pbuser.SetUse
 		if err := s.online.SetUserOnline(ctx, status.UserID, status.Online, status.Offline); err != nil {
 			return nil, err
 		}
+		foreground := append([]int32{}, status.Offline...)
+		for _, platformID := range status.Online {
+			if !datautil.Contain(platformID, status.Background...) {
+				foreground = append(foreground, platformID)
+			}
+		}
+		if err := s.online.SetUserBackground(ctx, status.UserID, foreground, status.Background); err != nil {
+			return nil, err
+		}
 	}
 	return &pbuser.SetUserOnlineStatusResp{}, nil
 }
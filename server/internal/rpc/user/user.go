@@ -221,6 +221,24 @@ func (s *userServer) SetGlobalRecvMessageOpt(ctx context.Context, req *pbuser.Se
 	return resp, nil
 }
 
+func (s *userServer) SetLastSeenPrivacy(ctx context.Context, req *pbuser.SetLastSeenPrivacyReq) (*pbuser.SetLastSeenPrivacyResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if req.LastSeenPrivacy < constant.LastSeenEveryone || req.LastSeenPrivacy > constant.LastSeenNobody {
+		return nil, errs.ErrArgs.WrapMsg("invalid lastSeenPrivacy", "lastSeenPrivacy", req.LastSeenPrivacy)
+	}
+	if _, err := s.db.FindWithError(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	m := make(map[string]any, 1)
+	m["last_seen_privacy"] = req.LastSeenPrivacy
+	if err := s.db.UpdateByMap(ctx, req.UserID, m); err != nil {
+		return nil, err
+	}
+	return &pbuser.SetLastSeenPrivacyResp{}, nil
+}
+
 func (s *userServer) AccountCheck(ctx context.Context, req *pbuser.AccountCheckReq) (resp *pbuser.AccountCheckResp, err error) {
 	resp = &pbuser.AccountCheckResp{}
 	if datautil.Duplicate(req.CheckUserIDs) {
@@ -332,6 +350,17 @@ func (s *userServer) GetGlobalRecvMessageOpt(ctx context.Context, req *pbuser.Ge
 	return &pbuser.GetGlobalRecvMessageOptResp{GlobalRecvMsgOpt: user[0].GlobalRecvMsgOpt}, nil
 }
 
+func (s *userServer) GetLastSeenPrivacy(ctx context.Context, req *pbuser.GetLastSeenPrivacyReq) (*pbuser.GetLastSeenPrivacyResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	user, err := s.db.FindWithError(ctx, []string{req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return &pbuser.GetLastSeenPrivacyResp{LastSeenPrivacy: user[0].LastSeenPrivacy}, nil
+}
+
 // GetAllUserID Get user account by page.
 func (s *userServer) GetAllUserID(ctx context.Context, req *pbuser.GetAllUserIDReq) (resp *pbuser.GetAllUserIDResp, err error) {
 	total, userIDs, err := s.db.GetAllUserID(ctx, req.Pagination)
@@ -81,6 +81,9 @@ func Start(ctx context.Context, config *CronTaskConfig) error {
 	if err := srv.registerDeleteMsg(); err != nil {
 		return err
 	}
+	if err := srv.registerArchiveMsg(); err != nil {
+		return err
+	}
 	if err := srv.registerClearUserMsg(); err != nil {
 		return err
 	}
@@ -117,6 +120,18 @@ func (c *cronServer) registerDeleteMsg() error {
 	return errs.WrapMsg(err, "failed to register delete msg cron task")
 }
 
+func (c *cronServer) registerArchiveMsg() error {
+	if c.config.CronTask.ArchiveChatRecords <= 0 {
+		log.ZInfo(c.ctx, "disable scheduled archive of chat records", "archiveChatRecords", c.config.CronTask.ArchiveChatRecords)
+		return nil
+	}
+	if c.config.CronTask.ArchiveChatRecords >= c.config.CronTask.RetainChatRecords {
+		log.ZWarn(c.ctx, "chat records are destructed before they can be archived", nil, "archiveChatRecords", c.config.CronTask.ArchiveChatRecords, "retainChatRecords", c.config.CronTask.RetainChatRecords)
+	}
+	_, err := c.cron.AddFunc(c.config.CronTask.CronExecuteTime, c.archiveMsg)
+	return errs.WrapMsg(err, "failed to register archive msg cron task")
+}
+
 func (c *cronServer) registerClearUserMsg() error {
 	_, err := c.cron.AddFunc(c.config.CronTask.CronExecuteTime, c.clearUserMsg)
 	return errs.WrapMsg(err, "failed to register clear user msg cron task")
@@ -1,12 +1,15 @@
 package tools
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"time"
+
 	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
-	"os"
-	"time"
 )
 
 func (c *cronServer) deleteMsg() {
@@ -14,7 +17,40 @@ func (c *cronServer) deleteMsg() {
 	deltime := now.Add(-time.Hour * 24 * time.Duration(c.config.CronTask.RetainChatRecords))
 	operationID := fmt.Sprintf("cron_msg_%d_%d", os.Getpid(), deltime.UnixMilli())
 	ctx := mcontext.SetOperationID(c.ctx, operationID)
+	policies, err := c.getMsgRetentionPolicies(ctx)
+	if err != nil {
+		// without the policies the global pass could delete conversations they protect
+		log.ZError(ctx, "cron get msg retention policies failed", err)
+		return
+	}
+	for _, policy := range policies {
+		policyTime := now.Add(-time.Hour * 24 * time.Duration(policy.RetainDays))
+		log.ZDebug(ctx, "Destruct chat records by retention policy", "policyID", policy.PolicyID, "name", policy.Name, "deltime", policyTime, "timestamp", policyTime.UnixMilli())
+		c.destructMsgs(fmt.Sprintf("%s_%s", operationID, policy.PolicyID), policyTime, policy.PolicyID)
+	}
 	log.ZDebug(ctx, "Destruct chat records", "deltime", deltime, "timestamp", deltime.UnixMilli())
+	c.destructMsgs(operationID, deltime, "")
+}
+
+func (c *cronServer) getMsgRetentionPolicies(ctx context.Context) ([]*msg.MsgRetentionPolicy, error) {
+	const showNumber = 100
+	var policies []*msg.MsgRetentionPolicy
+	for pageNumber := int32(1); ; pageNumber++ {
+		resp, err := c.msgClient.GetMsgRetentionPolicies(ctx, &msg.GetMsgRetentionPoliciesReq{
+			Pagination: &sdkws.RequestPagination{PageNumber: pageNumber, ShowNumber: showNumber},
+		})
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, resp.Policies...)
+		if len(resp.Policies) < showNumber {
+			return policies, nil
+		}
+	}
+}
+
+func (c *cronServer) destructMsgs(operationID string, deltime time.Time, policyID string) {
+	start := time.Now()
 	const (
 		deleteCount = 10000
 		deleteLimit = 50
@@ -22,9 +58,9 @@ func (c *cronServer) deleteMsg() {
 	var count int
 	for i := 1; i <= deleteCount; i++ {
 		ctx := mcontext.SetOperationID(c.ctx, fmt.Sprintf("%s_%d", operationID, i))
-		resp, err := c.msgClient.DestructMsgs(ctx, &msg.DestructMsgsReq{Timestamp: deltime.UnixMilli(), Limit: deleteLimit})
+		resp, err := c.msgClient.DestructMsgs(ctx, &msg.DestructMsgsReq{Timestamp: deltime.UnixMilli(), Limit: deleteLimit, PolicyID: policyID})
 		if err != nil {
-			log.ZError(ctx, "cron destruct chat records failed", err)
+			log.ZError(ctx, "cron destruct chat records failed", err, "policyID", policyID)
 			break
 		}
 		count += int(resp.Count)
@@ -32,5 +68,32 @@ func (c *cronServer) deleteMsg() {
 			break
 		}
 	}
-	log.ZDebug(ctx, "cron destruct chat records end", "deltime", deltime, "cont", time.Since(now), "count", count)
+	ctx := mcontext.SetOperationID(c.ctx, operationID)
+	log.ZDebug(ctx, "cron destruct chat records end", "deltime", deltime, "policyID", policyID, "cont", time.Since(start), "count", count)
+}
+
+func (c *cronServer) archiveMsg() {
+	now := time.Now()
+	archiveTime := now.Add(-time.Hour * 24 * time.Duration(c.config.CronTask.ArchiveChatRecords))
+	operationID := fmt.Sprintf("cron_archive_msg_%d_%d", os.Getpid(), archiveTime.UnixMilli())
+	ctx := mcontext.SetOperationID(c.ctx, operationID)
+	log.ZDebug(ctx, "Archive chat records", "archiveTime", archiveTime, "timestamp", archiveTime.UnixMilli())
+	const (
+		archiveCount = 10000
+		archiveLimit = 20
+	)
+	var count int
+	for i := 1; i <= archiveCount; i++ {
+		ctx := mcontext.SetOperationID(c.ctx, fmt.Sprintf("%s_%d", operationID, i))
+		resp, err := c.msgClient.ArchiveMsgs(ctx, &msg.ArchiveMsgsReq{Timestamp: archiveTime.UnixMilli(), Limit: archiveLimit})
+		if err != nil {
+			log.ZError(ctx, "cron archive chat records failed", err)
+			break
+		}
+		count += int(resp.Count)
+		if resp.Count < archiveLimit {
+			break
+		}
+	}
+	log.ZDebug(ctx, "cron archive chat records end", "archiveTime", archiveTime, "cont", time.Since(now), "count", count)
 }
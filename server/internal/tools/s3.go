@@ -2,6 +2,7 @@ package tools
 
 import (
 	"fmt"
+	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
@@ -20,9 +21,14 @@ func (c *cronServer) clearS3() {
 		deleteLimit = 100
 	)
 
+	holdResp, err := c.msgClient.GetLegalHoldUserIDs(ctx, &msg.GetLegalHoldUserIDsReq{})
+	if err != nil {
+		log.ZError(ctx, "cron get legal hold users failed", err)
+		return
+	}
 	var count int
 	for i := 1; i <= deleteCount; i++ {
-		resp, err := c.thirdClient.DeleteOutdatedData(ctx, &third.DeleteOutdatedDataReq{ExpireTime: deleteTime.UnixMilli(), ObjectGroup: c.config.CronTask.DeleteObjectType, Limit: deleteLimit})
+		resp, err := c.thirdClient.DeleteOutdatedData(ctx, &third.DeleteOutdatedDataReq{ExpireTime: deleteTime.UnixMilli(), ObjectGroup: c.config.CronTask.DeleteObjectType, Limit: deleteLimit, ExcludeUserIDs: holdResp.UserIDs, ExcludeNames: holdResp.ObjectNames})
 		if err != nil {
 			log.ZError(ctx, "cron deleteoutDatedData failed", err)
 			return
@@ -0,0 +1,33 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"github.com/openimsdk/protocol/constant"
+)
+
+// HasGroupPermission reports whether a group member with the given role level and
+// admin permission bitmask may perform the action guarded by perm.
+// The owner may do everything; an admin only holds the bits set in the bitmask.
+func HasGroupPermission(roleLevel int32, permissions int32, perm int32) bool {
+	switch roleLevel {
+	case constant.GroupOwner:
+		return true
+	case constant.GroupAdmin:
+		return permissions&perm == perm
+	default:
+		return false
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestHasGroupPermission(t *testing.T) {
+	tests := []struct {
+		name        string
+		roleLevel   int32
+		permissions int32
+		perm        int32
+		want        bool
+	}{
+		{"owner", constant.GroupOwner, 0, constant.GroupPermissionKick, true},
+		{"admin without permissions", constant.GroupAdmin, 0, constant.GroupPermissionKick, false},
+		{"admin promoted", constant.GroupAdmin, constant.GroupPermissionAll, constant.GroupPermissionPinMsg, true},
+		{"admin granted", constant.GroupAdmin, constant.GroupPermissionApproveJoin, constant.GroupPermissionApproveJoin, true},
+		{"admin not granted", constant.GroupAdmin, constant.GroupPermissionApproveJoin, constant.GroupPermissionKick, false},
+		{"ordinary", constant.GroupOrdinaryUsers, constant.GroupPermissionAll, constant.GroupPermissionKick, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasGroupPermission(tt.roleLevel, tt.permissions, tt.perm); got != tt.want {
+				t.Errorf("HasGroupPermission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -115,10 +115,11 @@ type API struct {
 }
 
 type CronTask struct {
-	CronExecuteTime   string   `mapstructure:"cronExecuteTime"`
-	RetainChatRecords int      `mapstructure:"retainChatRecords"`
-	FileExpireTime    int      `mapstructure:"fileExpireTime"`
-	DeleteObjectType  []string `mapstructure:"deleteObjectType"`
+	CronExecuteTime    string   `mapstructure:"cronExecuteTime"`
+	RetainChatRecords  int      `mapstructure:"retainChatRecords"`
+	ArchiveChatRecords int      `mapstructure:"archiveChatRecords"`
+	FileExpireTime     int      `mapstructure:"fileExpireTime"`
+	DeleteObjectType   []string `mapstructure:"deleteObjectType"`
 }
 
 type OfflinePushConfig struct {
@@ -136,39 +137,41 @@ type NotificationConfig struct {
 }
 
 type Notification struct {
-	GroupCreated              NotificationConfig `mapstructure:"groupCreated"`
-	GroupInfoSet              NotificationConfig `mapstructure:"groupInfoSet"`
-	JoinGroupApplication      NotificationConfig `mapstructure:"joinGroupApplication"`
-	MemberQuit                NotificationConfig `mapstructure:"memberQuit"`
-	GroupApplicationAccepted  NotificationConfig `mapstructure:"groupApplicationAccepted"`
-	GroupApplicationRejected  NotificationConfig `mapstructure:"groupApplicationRejected"`
-	GroupOwnerTransferred     NotificationConfig `mapstructure:"groupOwnerTransferred"`
-	MemberKicked              NotificationConfig `mapstructure:"memberKicked"`
-	MemberInvited             NotificationConfig `mapstructure:"memberInvited"`
-	MemberEnter               NotificationConfig `mapstructure:"memberEnter"`
-	GroupDismissed            NotificationConfig `mapstructure:"groupDismissed"`
-	GroupMuted                NotificationConfig `mapstructure:"groupMuted"`
-	GroupCancelMuted          NotificationConfig `mapstructure:"groupCancelMuted"`
-	GroupMemberMuted          NotificationConfig `mapstructure:"groupMemberMuted"`
-	GroupMemberCancelMuted    NotificationConfig `mapstructure:"groupMemberCancelMuted"`
-	GroupMemberInfoSet        NotificationConfig `mapstructure:"groupMemberInfoSet"`
-	GroupMemberSetToAdmin     NotificationConfig `yaml:"groupMemberSetToAdmin"`
-	GroupMemberSetToOrdinary  NotificationConfig `yaml:"groupMemberSetToOrdinaryUser"`
-	GroupInfoSetAnnouncement  NotificationConfig `mapstructure:"groupInfoSetAnnouncement"`
-	GroupInfoSetName          NotificationConfig `mapstructure:"groupInfoSetName"`
-	FriendApplicationAdded    NotificationConfig `mapstructure:"friendApplicationAdded"`
-	FriendApplicationApproved NotificationConfig `mapstructure:"friendApplicationApproved"`
-	FriendApplicationRejected NotificationConfig `mapstructure:"friendApplicationRejected"`
-	FriendAdded               NotificationConfig `mapstructure:"friendAdded"`
-	FriendDeleted             NotificationConfig `mapstructure:"friendDeleted"`
-	FriendRemarkSet           NotificationConfig `mapstructure:"friendRemarkSet"`
-	BlackAdded                NotificationConfig `mapstructure:"blackAdded"`
-	BlackDeleted              NotificationConfig `mapstructure:"blackDeleted"`
-	FriendInfoUpdated         NotificationConfig `mapstructure:"friendInfoUpdated"`
-	UserInfoUpdated           NotificationConfig `mapstructure:"userInfoUpdated"`
-	UserStatusChanged         NotificationConfig `mapstructure:"userStatusChanged"`
-	ConversationChanged       NotificationConfig `mapstructure:"conversationChanged"`
-	ConversationSetPrivate    NotificationConfig `mapstructure:"conversationSetPrivate"`
+	GroupCreated               NotificationConfig `mapstructure:"groupCreated"`
+	GroupInfoSet               NotificationConfig `mapstructure:"groupInfoSet"`
+	JoinGroupApplication       NotificationConfig `mapstructure:"joinGroupApplication"`
+	MemberQuit                 NotificationConfig `mapstructure:"memberQuit"`
+	GroupApplicationAccepted   NotificationConfig `mapstructure:"groupApplicationAccepted"`
+	GroupApplicationRejected   NotificationConfig `mapstructure:"groupApplicationRejected"`
+	GroupOwnerTransferred      NotificationConfig `mapstructure:"groupOwnerTransferred"`
+	MemberKicked               NotificationConfig `mapstructure:"memberKicked"`
+	MemberInvited              NotificationConfig `mapstructure:"memberInvited"`
+	MemberEnter                NotificationConfig `mapstructure:"memberEnter"`
+	GroupDismissed             NotificationConfig `mapstructure:"groupDismissed"`
+	GroupMuted                 NotificationConfig `mapstructure:"groupMuted"`
+	GroupCancelMuted           NotificationConfig `mapstructure:"groupCancelMuted"`
+	GroupMemberMuted           NotificationConfig `mapstructure:"groupMemberMuted"`
+	GroupMemberCancelMuted     NotificationConfig `mapstructure:"groupMemberCancelMuted"`
+	GroupMemberInfoSet         NotificationConfig `mapstructure:"groupMemberInfoSet"`
+	GroupMemberSetToAdmin      NotificationConfig `yaml:"groupMemberSetToAdmin"`
+	GroupMemberSetToOrdinary   NotificationConfig `yaml:"groupMemberSetToOrdinaryUser"`
+	GroupInfoSetAnnouncement   NotificationConfig `mapstructure:"groupInfoSetAnnouncement"`
+	GroupInfoSetName           NotificationConfig `mapstructure:"groupInfoSetName"`
+	GroupAnnouncementRemind    NotificationConfig `mapstructure:"groupAnnouncementRemind"`
+	GroupAnnouncementConfirmed NotificationConfig `mapstructure:"groupAnnouncementConfirmed"`
+	FriendApplicationAdded     NotificationConfig `mapstructure:"friendApplicationAdded"`
+	FriendApplicationApproved  NotificationConfig `mapstructure:"friendApplicationApproved"`
+	FriendApplicationRejected  NotificationConfig `mapstructure:"friendApplicationRejected"`
+	FriendAdded                NotificationConfig `mapstructure:"friendAdded"`
+	FriendDeleted              NotificationConfig `mapstructure:"friendDeleted"`
+	FriendRemarkSet            NotificationConfig `mapstructure:"friendRemarkSet"`
+	BlackAdded                 NotificationConfig `mapstructure:"blackAdded"`
+	BlackDeleted               NotificationConfig `mapstructure:"blackDeleted"`
+	FriendInfoUpdated          NotificationConfig `mapstructure:"friendInfoUpdated"`
+	UserInfoUpdated            NotificationConfig `mapstructure:"userInfoUpdated"`
+	UserStatusChanged          NotificationConfig `mapstructure:"userStatusChanged"`
+	ConversationChanged        NotificationConfig `mapstructure:"conversationChanged"`
+	ConversationSetPrivate     NotificationConfig `mapstructure:"conversationSetPrivate"`
 }
 
 type Prometheus struct {
@@ -190,6 +193,24 @@ type MsgGateway struct {
 		WebsocketMaxMsgLen  int   `mapstructure:"websocketMaxMsgLen"`
 		WebsocketTimeout    int   `mapstructure:"websocketTimeout"`
 	} `mapstructure:"longConnSvr"`
+	PushAck struct {
+		Enable        bool `mapstructure:"enable"`
+		WindowSize    int  `mapstructure:"windowSize"`
+		AckTimeout    int  `mapstructure:"ackTimeout"`
+		MaxRetransmit int  `mapstructure:"maxRetransmit"`
+	} `mapstructure:"pushAck"`
+	OutboundQueue struct {
+		Size       int    `mapstructure:"size"`
+		FullPolicy string `mapstructure:"fullPolicy"`
+	} `mapstructure:"outboundQueue"`
+	SessionResume struct {
+		Enable       bool `mapstructure:"enable"`
+		GraceSeconds int  `mapstructure:"graceSeconds"`
+		MaxMissed    int  `mapstructure:"maxMissed"`
+	} `mapstructure:"sessionResume"`
+	Presence struct {
+		OfflineGrace int `mapstructure:"offlineGrace"`
+	} `mapstructure:"presence"`
 }
 
 type MsgTransfer struct {
@@ -209,6 +230,7 @@ type Push struct {
 	} `mapstructure:"rpc"`
 	Prometheus           Prometheus `mapstructure:"prometheus"`
 	MaxConcurrentWorkers int        `mapstructure:"maxConcurrentWorkers"`
+	OnlinePushRoute      bool       `mapstructure:"onlinePushRoute"`
 	Enable               string     `mapstructure:"enable"`
 	GeTui                struct {
 		PushUrl      string `mapstructure:"pushUrl"`
@@ -276,8 +298,21 @@ type Group struct {
 		AutoSetPorts bool   `mapstructure:"autoSetPorts"`
 		Ports        []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
-	Prometheus                 Prometheus `mapstructure:"prometheus"`
-	EnableHistoryForNewMembers bool       `mapstructure:"enableHistoryForNewMembers"`
+	Prometheus                 Prometheus       `mapstructure:"prometheus"`
+	EnableHistoryForNewMembers bool             `mapstructure:"enableHistoryForNewMembers"`
+	MemberLimit                GroupMemberLimit `mapstructure:"memberLimit"`
+	MaxCreatedGroups           int64            `mapstructure:"maxCreatedGroups"`
+	MaxJoinedGroups            int64            `mapstructure:"maxJoinedGroups"`
+}
+
+type GroupMemberLimit struct {
+	NormalGroup  int64 `mapstructure:"normalGroup"`
+	SuperGroup   int64 `mapstructure:"superGroup"`
+	WorkingGroup int64 `mapstructure:"workingGroup"`
+	Owners       []struct {
+		UserID      string `mapstructure:"userID"`
+		MemberLimit int64  `mapstructure:"memberLimit"`
+	} `mapstructure:"owners"`
 }
 
 type Msg struct {
@@ -289,6 +324,19 @@ type Msg struct {
 	} `mapstructure:"rpc"`
 	Prometheus   Prometheus `mapstructure:"prometheus"`
 	FriendVerify bool       `mapstructure:"friendVerify"`
+	Archive      MsgArchive `mapstructure:"archive"`
+	Compliance   Compliance `mapstructure:"compliance"`
+}
+
+type MsgArchive struct {
+	Enable    bool `mapstructure:"enable"`
+	CacheSize int  `mapstructure:"cacheSize"`
+}
+
+type Compliance struct {
+	Enable bool `mapstructure:"enable"`
+	// SignKey is the base64 encoded ed25519 seed used to sign export manifests.
+	SignKey string `mapstructure:"signKey"`
 }
 
 type Third struct {
@@ -384,6 +432,14 @@ type Share struct {
 	RpcRegisterName RpcRegisterName `mapstructure:"rpcRegisterName"`
 	IMAdminUserID   []string        `mapstructure:"imAdminUserID"`
 	MultiLogin      MultiLogin      `mapstructure:"multiLogin"`
+	Tracing         Tracing         `mapstructure:"tracing"`
+}
+
+type Tracing struct {
+	Enable      bool    `mapstructure:"enable"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sampleRatio"`
 }
 
 type MultiLogin struct {
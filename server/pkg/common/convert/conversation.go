@@ -59,3 +59,33 @@ func ConversationsPb2DB(conversationsPB []*conversation.Conversation) (conversat
 	}
 	return conversationsDB
 }
+
+func ConversationFolderRulePb2DB(rule *conversation.ConversationFolderRule) *model.ConversationFolderRule {
+	if rule == nil {
+		return nil
+	}
+	return &model.ConversationFolderRule{
+		SessionTypes:  rule.SessionTypes,
+		GroupIDPrefix: rule.GroupIDPrefix,
+		Unread:        rule.Unread,
+	}
+}
+
+func ConversationFolderDB2Pb(folder *model.ConversationFolder) *conversation.ConversationFolder {
+	res := &conversation.ConversationFolder{
+		FolderID:    folder.FolderID,
+		OwnerUserID: folder.OwnerUserID,
+		Name:        folder.Name,
+		Sort:        folder.Sort,
+		CreateTime:  folder.CreateTime.UnixMilli(),
+		UpdateTime:  folder.UpdateTime.UnixMilli(),
+	}
+	if folder.Rule != nil {
+		res.Rule = &conversation.ConversationFolderRule{
+			SessionTypes:  folder.Rule.SessionTypes,
+			GroupIDPrefix: folder.Rule.GroupIDPrefix,
+			Unread:        folder.Rule.Unread,
+		}
+	}
+	return res
+}
@@ -77,6 +77,7 @@ func Db2PbGroupMember(m *model.GroupMember) *sdkws.GroupMemberFullInfo {
 		Ex:             m.Ex,
 		MuteEndTime:    m.MuteEndTime.UnixMilli(),
 		InviterUserID:  m.InviterUserID,
+		Permissions:    m.Permissions,
 	}
 }
 
@@ -145,3 +146,57 @@ func Pb2DBGroupInfo(m *sdkws.GroupInfo) *model.Group {
 //		Ex:       m.Ex,
 //	}
 //}
+
+func Db2PbGroupInvite(m *model.GroupInvite) *pbgroup.GroupInvite {
+	var expireTime int64
+	if m.ExpireTime.UnixMilli() > 0 {
+		expireTime = m.ExpireTime.UnixMilli()
+	}
+	return &pbgroup.GroupInvite{
+		Token:         m.Token,
+		GroupID:       m.GroupID,
+		CreatorUserID: m.CreatorUserID,
+		CreateTime:    m.CreateTime.UnixMilli(),
+		ExpireTime:    expireTime,
+		MaxUses:       m.MaxUses,
+		UsedCount:     m.UsedCount,
+		NeedApproval:  m.NeedApproval,
+		Revoked:       m.Revoked,
+		Ex:            m.Ex,
+	}
+}
+
+func Db2PbGroupAnnouncement(m *model.GroupAnnouncement) *sdkws.GroupAnnouncement {
+	return &sdkws.GroupAnnouncement{
+		AnnouncementID: m.AnnouncementID,
+		GroupID:        m.GroupID,
+		Content:        m.Content,
+		CreatorUserID:  m.CreatorUserID,
+		CreateTime:     m.CreateTime.UnixMilli(),
+		RequireConfirm: m.RequireConfirm,
+		Ex:             m.Ex,
+	}
+}
+
+func Db2PbGroupImportJob(m *model.GroupImportJob) *pbgroup.GroupImportJob {
+	rowErrors := make([]*pbgroup.GroupImportRowError, 0, len(m.RowErrors))
+	for _, e := range m.RowErrors {
+		rowErrors = append(rowErrors, &pbgroup.GroupImportRowError{Row: e.Row, UserID: e.UserID, GroupID: e.GroupID, ErrMsg: e.ErrMsg})
+	}
+	return &pbgroup.GroupImportJob{
+		JobID:      m.JobID,
+		ObjectName: m.ObjectName,
+		Format:     m.Format,
+		Status:     m.Status,
+		ErrMsg:     m.ErrMsg,
+		Total:      m.Total,
+		Processed:  m.Processed,
+		Succeeded:  m.Succeeded,
+		Skipped:    m.Skipped,
+		Failed:     m.Failed,
+		RowErrors:  rowErrors,
+		OpUserID:   m.OpUserID,
+		CreateTime: m.CreateTime.UnixMilli(),
+		UpdateTime: m.UpdateTime.UnixMilli(),
+	}
+}
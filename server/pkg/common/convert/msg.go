@@ -17,6 +17,7 @@ package convert
 import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/protocol/constant"
+	pbmsg "github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
 )
 
@@ -96,3 +97,68 @@ func MsgDB2Pb(msgModel *model.MsgDataModel) *sdkws.MsgData {
 	msg.Ex = msgModel.Ex
 	return &msg
 }
+
+func Db2PbMsgRetentionPolicy(m *model.MsgRetentionPolicy) *pbmsg.MsgRetentionPolicy {
+	return &pbmsg.MsgRetentionPolicy{
+		PolicyID:      m.PolicyID,
+		Name:          m.Name,
+		Scope:         m.Scope,
+		ScopeIDs:      m.ScopeIDs,
+		RetainDays:    m.RetainDays,
+		CreatorUserID: m.CreatorUserID,
+		CreateTime:    m.CreateTime.UnixMilli(),
+		UpdateTime:    m.UpdateTime.UnixMilli(),
+		Ex:            m.Ex,
+	}
+}
+
+func Db2PbLegalHold(m *model.LegalHold) *pbmsg.LegalHold {
+	return &pbmsg.LegalHold{
+		TargetType: m.TargetType,
+		TargetID:   m.TargetID,
+		Reason:     m.Reason,
+		OpUserID:   m.OpUserID,
+		CreateTime: m.CreateTime.UnixMilli(),
+	}
+}
+
+func Db2PbComplianceExportJob(m *model.ComplianceExportJob) *pbmsg.ComplianceExportJob {
+	return &pbmsg.ComplianceExportJob{
+		JobID:           m.JobID,
+		UserID:          m.UserID,
+		ConversationIDs: m.ConversationIDs,
+		Status:          m.Status,
+		ErrMsg:          m.ErrMsg,
+		MsgCount:        m.MsgCount,
+		ObjectCount:     m.ObjectCount,
+		Size:            m.Size,
+		Sha256:          m.Sha256,
+		OpUserID:        m.OpUserID,
+		CreateTime:      m.CreateTime.UnixMilli(),
+		UpdateTime:      m.UpdateTime.UnixMilli(),
+	}
+}
+
+func Db2PbFavorite(m *model.Favorite) *pbmsg.FavoriteInfo {
+	favorite := &pbmsg.FavoriteInfo{
+		FavoriteID:     m.FavoriteID,
+		UserID:         m.UserID,
+		ConversationID: m.ConversationID,
+		Seq:            m.Seq,
+		Attachments:    make([]*pbmsg.FavoriteAttachment, 0, len(m.Attachments)),
+		Tags:           m.Tags,
+		Note:           m.Note,
+		CreateTime:     m.CreateTime.UnixMilli(),
+		UpdateTime:     m.UpdateTime.UnixMilli(),
+	}
+	if m.Msg != nil {
+		favorite.Msg = MsgDB2Pb(m.Msg)
+	}
+	for _, attachment := range m.Attachments {
+		favorite.Attachments = append(favorite.Attachments, &pbmsg.FavoriteAttachment{
+			SourceURL: attachment.SourceURL,
+			Url:       attachment.URL,
+		})
+	}
+	return favorite
+}
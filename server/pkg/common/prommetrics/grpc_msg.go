@@ -35,4 +35,9 @@ var (
 		Name: "group_chat_msg_process_failed_total",
 		Help: "The number of group chat msg failed processed",
 	})
+	MsgVerifyDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "msg_send_verify_duration_seconds",
+		Help:    "The time to verify a message before it is sent",
+		Buckets: latencyBuckets,
+	}, []string{"session_type"})
 )
@@ -23,4 +23,22 @@ var (
 		Name: "online_user_num",
 		Help: "The number of online user num",
 	})
+	OutboundQueueDepthHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "msg_gateway_outbound_queue_depth",
+		Help:    "The frames already queued on a connection when another one is queued",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 11),
+	})
+	OutboundDroppedCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "msg_gateway_outbound_dropped_total",
+		Help: "The number of frames dropped because the connection queue was full",
+	})
+	SlowConsumerDisconnectCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "msg_gateway_slow_consumer_disconnect_total",
+		Help: "The number of connections closed because they could not keep up with their queue",
+	})
+	GatewayWriteDurationHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "msg_gateway_write_duration_seconds",
+		Help:    "The time to write a frame to a connection",
+		Buckets: latencyBuckets,
+	})
 )
@@ -27,4 +27,9 @@ var (
 		Name: "msg_long_time_push_total",
 		Help: "The number of messages with a push time exceeding 10 seconds",
 	})
+	PushFanoutDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "msg_push_fanout_duration_seconds",
+		Help:    "The time to push a message to the gateways of its receivers, offline push included",
+		Buckets: latencyBuckets,
+	}, []string{"session_type"})
 )
@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prommetrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// latencyBuckets has a bound at 1s, the p99 delivery objective.
+var latencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, .75, 1, 1.5, 2.5, 5, 10}
+
+var (
+	KafkaProduceDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "kafka_produce_duration_seconds",
+		Help:    "The time to produce a message to kafka",
+		Buckets: latencyBuckets,
+	}, []string{"topic"})
+	KafkaConsumerLagGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kafka_consumer_lag",
+		Help: "The messages of a partition not consumed yet, as of the last message consumed",
+	}, []string{"topic", "partition"})
+	MsgDeliveryLatencyHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "msg_delivery_latency_seconds",
+		Help:    "The time from the send time of a message to its push written to a connection",
+		Buckets: latencyBuckets,
+	}, []string{"session_type", "group_size"})
+)
+
+// KafkaProduced records the time taken by a message produced to topic since start.
+func KafkaProduced(topic string, start time.Time) {
+	KafkaProduceDurationHistogram.WithLabelValues(topic).Observe(time.Since(start).Seconds())
+}
+
+// KafkaConsumed records the lag of the partition given its high water mark and the offset just consumed.
+func KafkaConsumed(topic string, partition int32, highWaterMark int64, offset int64) {
+	lag := highWaterMark - offset - 1
+	if lag < 0 {
+		lag = 0
+	}
+	KafkaConsumerLagGauge.With(prometheus.Labels{"topic": topic, "partition": strconv.Itoa(int(partition))}).Set(float64(lag))
+}
+
+// SessionTypeLabel returns the session_type label of a message.
+func SessionTypeLabel(sessionType int32) string {
+	switch sessionType {
+	case constant.SingleChatType:
+		return "single"
+	case constant.ReadGroupChatType, constant.WriteGroupChatType:
+		return "group"
+	case constant.NotificationChatType:
+		return "notification"
+	default:
+		return strconv.Itoa(int(sessionType))
+	}
+}
+
+// GroupSizeLabel returns the group_size label of a message pushed to receivers users, with few
+// buckets to keep the series low.
+func GroupSizeLabel(receivers int) string {
+	switch {
+	case receivers <= 2:
+		return "1-2"
+	case receivers <= 50:
+		return "3-50"
+	case receivers <= 500:
+		return "51-500"
+	case receivers <= 2000:
+		return "501-2000"
+	default:
+		return "2000+"
+	}
+}
@@ -46,18 +46,30 @@ func GetGrpcServerMetrics() *gp.ServerMetrics {
 func GetGrpcCusMetrics(registerName string, share *config.Share) []prometheus.Collector {
 	switch registerName {
 	case share.RpcRegisterName.MessageGateway:
-		return []prometheus.Collector{OnlineUserGauge}
+		return []prometheus.Collector{
+			OnlineUserGauge,
+			OutboundQueueDepthHistogram,
+			OutboundDroppedCounter,
+			SlowConsumerDisconnectCounter,
+			GatewayWriteDurationHistogram,
+			MsgDeliveryLatencyHistogram,
+		}
 	case share.RpcRegisterName.Msg:
 		return []prometheus.Collector{
 			SingleChatMsgProcessSuccessCounter,
 			SingleChatMsgProcessFailedCounter,
 			GroupChatMsgProcessSuccessCounter,
 			GroupChatMsgProcessFailedCounter,
+			MsgVerifyDurationHistogram,
+			KafkaProduceDurationHistogram,
 		}
 	case share.RpcRegisterName.Push:
 		return []prometheus.Collector{
 			MsgOfflinePushFailedCounter,
 			MsgLoneTimePushCounter,
+			PushFanoutDurationHistogram,
+			KafkaProduceDurationHistogram,
+			KafkaConsumerLagGauge,
 		}
 	case share.RpcRegisterName.Auth:
 		return []prometheus.Collector{UserLoginCounter}
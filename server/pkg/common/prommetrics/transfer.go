@@ -41,6 +41,16 @@ var (
 		Name: "seq_set_failed_total",
 		Help: "The number of failed set seq",
 	})
+	MsgBatchInsertRedisDurationHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "msg_batch_insert_redis_duration_seconds",
+		Help:    "The time to allocate seqs and insert a batch of msgs to redis",
+		Buckets: latencyBuckets,
+	})
+	MsgInsertMongoDurationHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "msg_insert_mongo_duration_seconds",
+		Help:    "The time to insert a batch of msgs to mongo",
+		Buckets: latencyBuckets,
+	})
 )
 
 func TransferInit(listener net.Listener) error {
@@ -52,6 +62,10 @@ func TransferInit(listener net.Listener) error {
 		MsgInsertMongoSuccessCounter,
 		MsgInsertMongoFailedCounter,
 		SeqSetFailedCounter,
+		MsgBatchInsertRedisDurationHistogram,
+		MsgInsertMongoDurationHistogram,
+		KafkaProduceDurationHistogram,
+		KafkaConsumerLagGauge,
 	)
 	return Init(reg, listener, commonPath, promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}), cs...)
 }
@@ -62,6 +62,8 @@ const (
 	DismissedAlreadyError = 1204 // Group has already been dismissed
 	GroupTypeNotSupport   = 1205
 	GroupRequestHandled   = 1206
+	GroupInviteInvalid    = 1207 // Group invite is revoked, expired or used up
+	GroupMemberLimit      = 1208 // Group member or joined/created group limit reached
 
 	// Relationship error codes.
 	CanNotAddYourselfError   = 1301 // Cannot add yourself as a friend
@@ -89,6 +91,7 @@ const (
 	ConnArgsErr          = 1602
 	PushMsgErr           = 1603
 	IOSBackgroundPushErr = 1604
+	PushAckTimeoutErr    = 1605 // Pushed message not acknowledged by the client in time
 
 	// S3 error codes.
 	FileUploadedExpiredError = 1701 // Upload expired
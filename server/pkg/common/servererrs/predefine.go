@@ -37,6 +37,8 @@ var (
 	ErrRegisteredAlready   = errs.NewCodeError(RegisteredAlreadyError, "RegisteredAlreadyError")
 	ErrGroupTypeNotSupport = errs.NewCodeError(GroupTypeNotSupport, "")
 	ErrGroupRequestHandled = errs.NewCodeError(GroupRequestHandled, "GroupRequestHandled")
+	ErrGroupInviteInvalid  = errs.NewCodeError(GroupInviteInvalid, "GroupInviteInvalid")
+	ErrGroupMemberLimit    = errs.NewCodeError(GroupMemberLimit, "GroupMemberLimit")
 
 	ErrData             = errs.NewCodeError(DataError, "DataError")
 	ErrTokenExpired     = errs.NewCodeError(TokenExpiredError, "TokenExpiredError")
@@ -63,6 +65,7 @@ var (
 	ErrConnArgsErr          = errs.NewCodeError(ConnArgsErr, "args err, need token, sendID, platformID")
 	ErrPushMsgErr           = errs.NewCodeError(PushMsgErr, "push msg err")
 	ErrIOSBackgroundPushErr = errs.NewCodeError(IOSBackgroundPushErr, "ios background push err")
+	ErrPushAckTimeout       = errs.NewCodeError(PushAckTimeoutErr, "push ack timeout")
 
 	ErrFileUploadedExpired = errs.NewCodeError(FileUploadedExpiredError, "FileUploadedExpiredError")
 )
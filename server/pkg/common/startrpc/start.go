@@ -34,6 +34,7 @@ import (
 
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
@@ -93,6 +94,15 @@ func Start[T any](ctx context.Context, discovery *conf.Discovery, prometheusConf
 	defer client.Close()
 	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
 
+	shutdownTracing, err := tracing.Init(ctx, &share.Tracing, "openim-"+rpcRegisterName)
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing(context.Background())
+	if share.Tracing.Enable {
+		client.AddOption(tracing.GrpcDialOption())
+	}
+
 	// var reg *prometheus.Registry
 	// var metric *grpcprometheus.ServerMetrics
 	if prometheusConfig.Enable {
@@ -150,6 +160,10 @@ func Start[T any](ctx context.Context, discovery *conf.Discovery, prometheusConf
 		options = append(options, mw.GrpcServer())
 	}
 
+	if share.Tracing.Enable {
+		options = append(options, tracing.GrpcServerOptions()...)
+	}
+
 	listener, port, err := getAutoPort()
 	if err != nil {
 		return err
@@ -6,9 +6,13 @@ import (
 )
 
 const (
-	OnlineKey     = "ONLINE:"
-	OnlineChannel = "online_change"
-	OnlineExpire  = time.Hour / 2
+	OnlineKey           = "ONLINE:"
+	OnlineGatewayKey    = "ONLINE_GATEWAY:"
+	OnlineBackgroundKey = "ONLINE_BACKGROUND:"
+	LastSeenKey         = "LAST_SEEN:"
+	OnlineChannel       = "online_change"
+	OnlineExpire        = time.Hour / 2
+	LastSeenExpire      = time.Hour * 24 * 90
 )
 
 func GetOnlineKey(userID string) string {
@@ -18,3 +22,15 @@ func GetOnlineKey(userID string) string {
 func GetOnlineKeyUserID(key string) string {
 	return strings.TrimPrefix(key, OnlineKey)
 }
+
+func GetOnlineGatewayKey(userID string) string {
+	return OnlineGatewayKey + userID
+}
+
+func GetOnlineBackgroundKey(userID string) string {
+	return OnlineBackgroundKey + userID
+}
+
+func GetLastSeenKey(userID string) string {
+	return LastSeenKey + userID
+}
@@ -6,4 +6,22 @@ type OnlineCache interface {
 	GetOnline(ctx context.Context, userID string) ([]int32, error)
 	SetUserOnline(ctx context.Context, userID string, online, offline []int32) error
 	GetAllOnlineUsers(ctx context.Context, cursor uint64) (map[string][]int32, uint64, error)
+	// SetUserBackground records the platforms of the user whose app is in the background, and the
+	// ones that are no longer.
+	SetUserBackground(ctx context.Context, userID string, foreground, background []int32) error
+	// GetBackground returns the platforms of the user whose app is in the background.
+	GetBackground(ctx context.Context, userID string) ([]int32, error)
+	// GetLastSeen returns the last time in milliseconds each user was online, leaving out the
+	// users never seen.
+	GetLastSeen(ctx context.Context, userIDs []string) (map[string]int64, error)
+}
+
+// OnlineGatewayCache records which gateway instances hold the connections of each user, so that
+// online push goes only to those instances.
+type OnlineGatewayCache interface {
+	// SetUsersGateway marks the online users as connected to gateway and the offline ones as no
+	// longer connected to it.
+	SetUsersGateway(ctx context.Context, gateway string, online []string, offline []string) error
+	// GetUsersGateways returns the gateways of the users, leaving out expired records.
+	GetUsersGateways(ctx context.Context, userIDs []string) (map[string][]string, error)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
 	"strconv"
 	"strings"
@@ -120,6 +121,11 @@ func (s *userOnline) SetUserOnline(ctx context.Context, userID string, online, o
 		log.ZError(ctx, "redis SetUserOnline", err, "userID", userID, "online", online, "offline", offline)
 		return err
 	}
+	if len(online) > 0 || len(offline) > 0 {
+		if err := s.rdb.Set(ctx, cachekey.GetLastSeenKey(userID), now.UnixMilli(), cachekey.LastSeenExpire).Err(); err != nil {
+			return errs.Wrap(err)
+		}
+	}
 	if len(platformIDs) == 0 {
 		return errs.ErrInternalServer.WrapMsg("SetUserOnline redis lua invalid return value")
 	}
@@ -135,3 +141,61 @@ func (s *userOnline) SetUserOnline(ctx context.Context, userID string, online, o
 	}
 	return nil
 }
+
+func (s *userOnline) SetUserBackground(ctx context.Context, userID string, foreground, background []int32) error {
+	if len(foreground) == 0 && len(background) == 0 {
+		return nil
+	}
+	key := cachekey.GetOnlineBackgroundKey(userID)
+	pipe := s.rdb.TxPipeline()
+	if len(foreground) > 0 {
+		pipe.SRem(ctx, key, datautil.Slice(foreground, func(platformID int32) any { return platformID })...)
+	}
+	if len(background) > 0 {
+		pipe.SAdd(ctx, key, datautil.Slice(background, func(platformID int32) any { return platformID })...)
+	}
+	pipe.Expire(ctx, key, s.expire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (s *userOnline) GetBackground(ctx context.Context, userID string) ([]int32, error) {
+	members, err := s.rdb.SMembers(ctx, cachekey.GetOnlineBackgroundKey(userID)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	platformIDs := make([]int32, 0, len(members))
+	for _, member := range members {
+		val, err := strconv.Atoi(member)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		platformIDs = append(platformIDs, int32(val))
+	}
+	return platformIDs, nil
+}
+
+func (s *userOnline) GetLastSeen(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	if len(userIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	pipe := s.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, len(userIDs))
+	for i, userID := range userIDs {
+		cmds[i] = pipe.Get(ctx, cachekey.GetLastSeenKey(userID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errs.Wrap(err)
+	}
+	res := make(map[string]int64, len(userIDs))
+	for i, cmd := range cmds {
+		lastSeen, err := cmd.Int64()
+		if err != nil {
+			continue
+		}
+		res[userIDs[i]] = lastSeen
+	}
+	return res, nil
+}
@@ -0,0 +1,69 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewOnlineGateway(rdb redis.UniversalClient) cache.OnlineGatewayCache {
+	return &onlineGateway{
+		rdb:    rdb,
+		expire: cachekey.OnlineExpire,
+	}
+}
+
+// onlineGateway keeps a sorted set per user of gateway addresses, scored by the time the
+// record expires. Gateways renew the records of their users along with the online status.
+type onlineGateway struct {
+	rdb    redis.UniversalClient
+	expire time.Duration
+}
+
+func (s *onlineGateway) SetUsersGateway(ctx context.Context, gateway string, online []string, offline []string) error {
+	if len(online) == 0 && len(offline) == 0 {
+		return nil
+	}
+	now := time.Now()
+	pipe := s.rdb.Pipeline()
+	for _, userID := range online {
+		key := cachekey.GetOnlineGatewayKey(userID)
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Unix(), 10))
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.Add(s.expire).Unix()), Member: gateway})
+		pipe.Expire(ctx, key, s.expire)
+	}
+	for _, userID := range offline {
+		pipe.ZRem(ctx, cachekey.GetOnlineGatewayKey(userID), gateway)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (s *onlineGateway) GetUsersGateways(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	if len(userIDs) == 0 {
+		return map[string][]string{}, nil
+	}
+	by := &redis.ZRangeBy{Min: strconv.FormatInt(time.Now().Unix(), 10), Max: "+inf"}
+	pipe := s.rdb.Pipeline()
+	cmds := make([]*redis.StringSliceCmd, len(userIDs))
+	for i, userID := range userIDs {
+		cmds[i] = pipe.ZRangeByScore(ctx, cachekey.GetOnlineGatewayKey(userID), by)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errs.Wrap(err)
+	}
+	res := make(map[string][]string, len(userIDs))
+	for i, cmd := range cmds {
+		if gateways := cmd.Val(); len(gateways) > 0 {
+			res[userIDs[i]] = gateways
+		}
+	}
+	return res, nil
+}
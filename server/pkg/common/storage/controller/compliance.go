@@ -0,0 +1,78 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type ComplianceDatabase interface {
+	SetLegalHolds(ctx context.Context, holds []*model.LegalHold) error
+	RemoveLegalHolds(ctx context.Context, targetType int32, targetIDs []string) error
+	// FindAllLegalHolds returns every hold, used to decide which data deletions must skip.
+	FindAllLegalHolds(ctx context.Context) ([]*model.LegalHold, error)
+	PageLegalHolds(ctx context.Context, targetType int32, pagination pagination.Pagination) (int64, []*model.LegalHold, error)
+	CreateExportJob(ctx context.Context, job *model.ComplianceExportJob) error
+	TakeExportJob(ctx context.Context, jobID string) (*model.ComplianceExportJob, error)
+	UpdateExportJob(ctx context.Context, jobID string, data map[string]any) error
+	// TakeExpiredExportJob takes over a running export job whose lease expired, or returns nil.
+	TakeExpiredExportJob(ctx context.Context, now time.Time, leaseExpire time.Time) (*model.ComplianceExportJob, error)
+}
+
+func NewComplianceDatabase(hold database.LegalHold, exportJob database.ComplianceExportJob) ComplianceDatabase {
+	return &complianceDatabase{hold: hold, exportJob: exportJob}
+}
+
+type complianceDatabase struct {
+	hold      database.LegalHold
+	exportJob database.ComplianceExportJob
+}
+
+func (c *complianceDatabase) SetLegalHolds(ctx context.Context, holds []*model.LegalHold) error {
+	return c.hold.Set(ctx, holds)
+}
+
+func (c *complianceDatabase) RemoveLegalHolds(ctx context.Context, targetType int32, targetIDs []string) error {
+	return c.hold.Delete(ctx, targetType, targetIDs)
+}
+
+func (c *complianceDatabase) FindAllLegalHolds(ctx context.Context) ([]*model.LegalHold, error) {
+	return c.hold.FindAll(ctx)
+}
+
+func (c *complianceDatabase) PageLegalHolds(ctx context.Context, targetType int32, pagination pagination.Pagination) (int64, []*model.LegalHold, error) {
+	return c.hold.Page(ctx, targetType, pagination)
+}
+
+func (c *complianceDatabase) CreateExportJob(ctx context.Context, job *model.ComplianceExportJob) error {
+	return c.exportJob.Create(ctx, job)
+}
+
+func (c *complianceDatabase) TakeExportJob(ctx context.Context, jobID string) (*model.ComplianceExportJob, error) {
+	return c.exportJob.Take(ctx, jobID)
+}
+
+func (c *complianceDatabase) UpdateExportJob(ctx context.Context, jobID string, data map[string]any) error {
+	return c.exportJob.Update(ctx, jobID, data)
+}
+
+func (c *complianceDatabase) TakeExpiredExportJob(ctx context.Context, now time.Time, leaseExpire time.Time) (*model.ComplianceExportJob, error) {
+	return c.exportJob.TakeExpired(ctx, now, leaseExpire)
+}
@@ -0,0 +1,116 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/tx"
+)
+
+type ConversationFolderDatabase interface {
+	// CreateFolder fails when the owner has maxFolders folders already.
+	CreateFolder(ctx context.Context, folder *model.ConversationFolder, maxFolders int) error
+	UpdateFolder(ctx context.Context, ownerUserID string, folderID string, data map[string]any) error
+	// DeleteFolder deletes the folder and takes its conversations out of it.
+	DeleteFolder(ctx context.Context, ownerUserID string, folderID string) error
+	TakeFolder(ctx context.Context, ownerUserID string, folderID string) (*model.ConversationFolder, error)
+	FindFolders(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error)
+	AddConversationsToFolder(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+	RemoveConversationsFromFolder(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+}
+
+func NewConversationFolderDatabase(folder database.ConversationFolder, conversation database.Conversation, cache cache.ConversationCache, tx tx.Tx) ConversationFolderDatabase {
+	return &conversationFolderDatabase{
+		folderDB:       folder,
+		conversationDB: conversation,
+		cache:          cache,
+		tx:             tx,
+	}
+}
+
+type conversationFolderDatabase struct {
+	folderDB       database.ConversationFolder
+	conversationDB database.Conversation
+	cache          cache.ConversationCache
+	tx             tx.Tx
+}
+
+func (c *conversationFolderDatabase) CreateFolder(ctx context.Context, folder *model.ConversationFolder, maxFolders int) error {
+	if err := c.folderDB.Create(ctx, folder, maxFolders); err != nil {
+		return err
+	}
+	return c.cache.CloneConversationCache().DelConversationVersionUserIDs(folder.OwnerUserID).ChainExecDel(ctx)
+}
+
+func (c *conversationFolderDatabase) UpdateFolder(ctx context.Context, ownerUserID string, folderID string, data map[string]any) error {
+	if err := c.folderDB.Update(ctx, ownerUserID, folderID, data); err != nil {
+		return err
+	}
+	return c.cache.CloneConversationCache().DelConversationVersionUserIDs(ownerUserID).ChainExecDel(ctx)
+}
+
+func (c *conversationFolderDatabase) DeleteFolder(ctx context.Context, ownerUserID string, folderID string) error {
+	var conversationIDs []string
+	err := c.tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		conversationIDs, err = c.conversationDB.FindFolderConversationIDs(ctx, ownerUserID, folderID)
+		if err != nil {
+			return err
+		}
+		if err := c.conversationDB.RemoveFolder(ctx, ownerUserID, folderID, conversationIDs); err != nil {
+			return err
+		}
+		return c.folderDB.Delete(ctx, ownerUserID, folderID)
+	})
+	if err != nil {
+		return err
+	}
+	return c.cache.CloneConversationCache().
+		DelConversations(ownerUserID, conversationIDs...).
+		DelConversationVersionUserIDs(ownerUserID).
+		ChainExecDel(ctx)
+}
+
+func (c *conversationFolderDatabase) TakeFolder(ctx context.Context, ownerUserID string, folderID string) (*model.ConversationFolder, error) {
+	return c.folderDB.Take(ctx, ownerUserID, folderID)
+}
+
+func (c *conversationFolderDatabase) FindFolders(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error) {
+	return c.folderDB.Find(ctx, ownerUserID)
+}
+
+func (c *conversationFolderDatabase) AddConversationsToFolder(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	if err := c.conversationDB.AddFolder(ctx, ownerUserID, folderID, conversationIDs); err != nil {
+		return err
+	}
+	return c.cache.CloneConversationCache().
+		DelConversations(ownerUserID, conversationIDs...).
+		DelConversationVersionUserIDs(ownerUserID).
+		ChainExecDel(ctx)
+}
+
+func (c *conversationFolderDatabase) RemoveConversationsFromFolder(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	if err := c.conversationDB.RemoveFolder(ctx, ownerUserID, folderID, conversationIDs); err != nil {
+		return err
+	}
+	return c.cache.CloneConversationCache().
+		DelConversations(ownerUserID, conversationIDs...).
+		DelConversationVersionUserIDs(ownerUserID).
+		ChainExecDel(ctx)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type FavoriteDatabase interface {
+	CreateFavorite(ctx context.Context, favorite *model.Favorite) error
+	UpdateFavorite(ctx context.Context, userID string, favoriteID string, data map[string]any) error
+	DeleteFavorites(ctx context.Context, userID string, favoriteIDs []string) error
+	TakeFavorite(ctx context.Context, userID string, favoriteID string) (*model.Favorite, error)
+	FindFavorites(ctx context.Context, userID string, favoriteIDs []string) ([]*model.Favorite, error)
+	FindFavoriteIDs(ctx context.Context, userID string) ([]string, error)
+	SearchFavorites(ctx context.Context, userID string, keyword string, tags []string, pagination pagination.Pagination) (int64, []*model.Favorite, error)
+	FindFavoriteVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error)
+}
+
+func NewFavoriteDatabase(favorite database.Favorite) FavoriteDatabase {
+	return &favoriteDatabase{favorite: favorite}
+}
+
+type favoriteDatabase struct {
+	favorite database.Favorite
+}
+
+func (f *favoriteDatabase) CreateFavorite(ctx context.Context, favorite *model.Favorite) error {
+	return f.favorite.Create(ctx, favorite)
+}
+
+func (f *favoriteDatabase) UpdateFavorite(ctx context.Context, userID string, favoriteID string, data map[string]any) error {
+	return f.favorite.Update(ctx, userID, favoriteID, data)
+}
+
+func (f *favoriteDatabase) DeleteFavorites(ctx context.Context, userID string, favoriteIDs []string) error {
+	return f.favorite.Delete(ctx, userID, favoriteIDs)
+}
+
+func (f *favoriteDatabase) TakeFavorite(ctx context.Context, userID string, favoriteID string) (*model.Favorite, error) {
+	return f.favorite.Take(ctx, userID, favoriteID)
+}
+
+func (f *favoriteDatabase) FindFavorites(ctx context.Context, userID string, favoriteIDs []string) ([]*model.Favorite, error) {
+	return f.favorite.Find(ctx, userID, favoriteIDs)
+}
+
+func (f *favoriteDatabase) FindFavoriteIDs(ctx context.Context, userID string) ([]string, error) {
+	return f.favorite.FindFavoriteIDs(ctx, userID)
+}
+
+func (f *favoriteDatabase) SearchFavorites(ctx context.Context, userID string, keyword string, tags []string, pagination pagination.Pagination) (int64, []*model.Favorite, error) {
+	return f.favorite.Search(ctx, userID, keyword, tags, pagination)
+}
+
+func (f *favoriteDatabase) FindFavoriteVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error) {
+	return f.favorite.FindFavoriteVersion(ctx, userID, version, limit)
+}
@@ -86,6 +86,9 @@ type FriendDatabase interface {
 
 	FindFriendUserID(ctx context.Context, friendUserID string) ([]string, error)
 
+	// FindReversalFriendUserIDs returns the users of ownerUserIDs having friendUserID in their friend list
+	FindReversalFriendUserIDs(ctx context.Context, friendUserID string, ownerUserIDs []string) ([]string, error)
+
 	OwnerIncrVersion(ctx context.Context, ownerUserID string, friendUserIDs []string, state int32) error
 }
 
@@ -387,6 +390,17 @@ func (f *friendDatabase) FindFriendUserID(ctx context.Context, friendUserID stri
 	return f.friend.FindFriendUserID(ctx, friendUserID)
 }
 
+func (f *friendDatabase) FindReversalFriendUserIDs(ctx context.Context, friendUserID string, ownerUserIDs []string) ([]string, error) {
+	if len(ownerUserIDs) == 0 {
+		return nil, nil
+	}
+	friends, err := f.friend.FindReversalFriends(ctx, friendUserID, ownerUserIDs)
+	if err != nil {
+		return nil, err
+	}
+	return datautil.Slice(friends, func(friend *model.Friend) string { return friend.OwnerUserID }), nil
+}
+
 //func (f *friendDatabase) SearchFriend(ctx context.Context, ownerUserID, keyword string, pagination pagination.Pagination) (int64, []*model.Friend, error) {
 //	return f.friend.SearchFriend(ctx, ownerUserID, keyword, pagination)
 //}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	redis2 "github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/common"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
@@ -36,6 +37,9 @@ import (
 type GroupDatabase interface {
 	// CreateGroup creates new groups along with their members.
 	CreateGroup(ctx context.Context, groups []*model.Group, groupMembers []*model.GroupMember) error
+	// AddGroupMembers adds members to an existing group, failing with ErrGroupMemberLimit if the group
+	// would exceed maxMemberCount members. A maxMemberCount of 0 means unlimited.
+	AddGroupMembers(ctx context.Context, groupID string, groupMembers []*model.GroupMember, maxMemberCount int64) error
 	// TakeGroup retrieves a single group by its ID.
 	TakeGroup(ctx context.Context, groupID string) (group *model.Group, err error)
 	// FindGroup retrieves multiple groups by their IDs.
@@ -78,8 +82,9 @@ type GroupDatabase interface {
 	PageGetGroupMember(ctx context.Context, groupID string, pagination pagination.Pagination) (total int64, totalGroupMembers []*model.GroupMember, err error)
 	// SearchGroupMember searches for group members based on a keyword, group ID, and pagination settings.
 	SearchGroupMember(ctx context.Context, keyword string, groupID string, pagination pagination.Pagination) (int64, []*model.GroupMember, error)
-	// HandlerGroupRequest processes a group join request with a specified result.
-	HandlerGroupRequest(ctx context.Context, groupID string, userID string, handledMsg string, handleResult int32, member *model.GroupMember) error
+	// HandlerGroupRequest processes a group join request with a specified result, adding member if not nil
+	// while keeping the group within maxMemberCount members.
+	HandlerGroupRequest(ctx context.Context, groupID string, userID string, handledMsg string, handleResult int32, member *model.GroupMember, maxMemberCount int64) error
 	// DeleteGroupMember removes specified users from a group.
 	DeleteGroupMember(ctx context.Context, groupID string, userIDs []string) error
 	// MapGroupMemberUserID maps group IDs to their members' simplified user IDs.
@@ -102,6 +107,8 @@ type GroupDatabase interface {
 	// PageGroupRequestUser paginates through group join requests made by a user.
 	PageGroupRequestUser(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*model.GroupRequest, error)
 
+	// CountCreatedGroup counts the groups created by a user that are not dismissed.
+	CountCreatedGroup(ctx context.Context, userID string) (int64, error)
 	// CountTotal counts the total number of groups as of a certain date.
 	CountTotal(ctx context.Context, before *time.Time) (count int64, err error)
 	// CountRangeEverydayTotal counts the daily group creation total within a specified date range.
@@ -124,6 +131,41 @@ type GroupDatabase interface {
 	SearchJoinGroup(ctx context.Context, userID string, keyword string, pagination pagination.Pagination) (int64, []*model.Group, error)
 
 	FindJoinGroupID(ctx context.Context, userID string) ([]string, error)
+
+	// CreateGroupInvite stores a new group invite token.
+	CreateGroupInvite(ctx context.Context, invite *model.GroupInvite) error
+	// TakeGroupInvite retrieves a group invite by its token.
+	TakeGroupInvite(ctx context.Context, token string) (*model.GroupInvite, error)
+	// PageGroupInvite paginates through the invites of a group, newest first.
+	PageGroupInvite(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupInvite, error)
+	// RevokeGroupInvite marks a group invite as revoked.
+	RevokeGroupInvite(ctx context.Context, groupID string, token string) error
+	// RedeemGroupInvite consumes one use of an invite and either adds the member or stores the join request, atomically.
+	RedeemGroupInvite(ctx context.Context, token string, member *model.GroupMember, request *model.GroupRequest, maxMemberCount int64) error
+
+	// CreateGroupAnnouncement appends an entry to the group's announcement history.
+	CreateGroupAnnouncement(ctx context.Context, announcement *model.GroupAnnouncement) error
+	// PublishGroupAnnouncement sets the group's current announcement and appends it to the history.
+	PublishGroupAnnouncement(ctx context.Context, announcement *model.GroupAnnouncement) error
+	// TakeGroupAnnouncement retrieves one announcement of a group.
+	TakeGroupAnnouncement(ctx context.Context, groupID string, announcementID string) (*model.GroupAnnouncement, error)
+	// PageGroupAnnouncement paginates through a group's announcement history, newest first.
+	PageGroupAnnouncement(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupAnnouncement, error)
+	// ConfirmGroupAnnouncement records a member's confirmation of an announcement.
+	ConfirmGroupAnnouncement(ctx context.Context, confirm *model.GroupAnnouncementConfirm) error
+	// FindGroupAnnouncementConfirm returns all confirmations of an announcement.
+	FindGroupAnnouncementConfirm(ctx context.Context, announcementID string) ([]*model.GroupAnnouncementConfirm, error)
+	// FindConfirmedGroupAnnouncementIDs returns which of the announcements the user has confirmed.
+	FindConfirmedGroupAnnouncementIDs(ctx context.Context, userID string, announcementIDs []string) ([]string, error)
+
+	// CreateGroupImportJob stores a new group member import job.
+	CreateGroupImportJob(ctx context.Context, job *model.GroupImportJob) error
+	// TakeGroupImportJob retrieves a group member import job by its ID.
+	TakeGroupImportJob(ctx context.Context, jobID string) (*model.GroupImportJob, error)
+	// UpdateGroupImportJob updates the status and progress of an import job.
+	UpdateGroupImportJob(ctx context.Context, jobID string, data map[string]any) error
+	// TakeExpiredGroupImportJob takes over a running import job whose lease expired, or returns nil.
+	TakeExpiredGroupImportJob(ctx context.Context, now time.Time, leaseExpire time.Time) (*model.GroupImportJob, error)
 }
 
 func NewGroupDatabase(
@@ -132,24 +174,36 @@ func NewGroupDatabase(
 	groupDB database.Group,
 	groupMemberDB database.GroupMember,
 	groupRequestDB database.GroupRequest,
+	groupInviteDB database.GroupInvite,
+	announcementDB database.GroupAnnouncement,
+	announcementConfirmDB database.GroupAnnouncementConfirm,
+	importJobDB database.GroupImportJob,
 	ctxTx tx.Tx,
 	groupHash cache.GroupHash,
 ) GroupDatabase {
 	return &groupDatabase{
-		groupDB:        groupDB,
-		groupMemberDB:  groupMemberDB,
-		groupRequestDB: groupRequestDB,
-		ctxTx:          ctxTx,
-		cache:          redis2.NewGroupCacheRedis(rdb, localCache, groupDB, groupMemberDB, groupRequestDB, groupHash, redis2.GetRocksCacheOptions()),
+		groupDB:               groupDB,
+		groupMemberDB:         groupMemberDB,
+		groupRequestDB:        groupRequestDB,
+		groupInviteDB:         groupInviteDB,
+		announcementDB:        announcementDB,
+		announcementConfirmDB: announcementConfirmDB,
+		importJobDB:           importJobDB,
+		ctxTx:                 ctxTx,
+		cache:                 redis2.NewGroupCacheRedis(rdb, localCache, groupDB, groupMemberDB, groupRequestDB, groupHash, redis2.GetRocksCacheOptions()),
 	}
 }
 
 type groupDatabase struct {
-	groupDB        database.Group
-	groupMemberDB  database.GroupMember
-	groupRequestDB database.GroupRequest
-	ctxTx          tx.Tx
-	cache          cache.GroupCache
+	groupDB               database.Group
+	groupMemberDB         database.GroupMember
+	groupRequestDB        database.GroupRequest
+	groupInviteDB         database.GroupInvite
+	announcementDB        database.GroupAnnouncement
+	announcementConfirmDB database.GroupAnnouncementConfirm
+	importJobDB           database.GroupImportJob
+	ctxTx                 tx.Tx
+	cache                 cache.GroupCache
 }
 
 func (g *groupDatabase) FindJoinGroupID(ctx context.Context, userID string) ([]string, error) {
@@ -219,6 +273,49 @@ func (g *groupDatabase) CreateGroup(ctx context.Context, groups []*model.Group,
 	})
 }
 
+func (g *groupDatabase) AddGroupMembers(ctx context.Context, groupID string, groupMembers []*model.GroupMember, maxMemberCount int64) error {
+	if len(groupMembers) == 0 {
+		return nil
+	}
+	return g.ctxTx.Transaction(ctx, func(ctx context.Context) error {
+		if err := g.checkGroupMemberNum(ctx, groupID, len(groupMembers), maxMemberCount); err != nil {
+			return err
+		}
+		if err := g.groupMemberDB.Create(ctx, groupMembers); err != nil {
+			return err
+		}
+		c := g.cache.CloneGroupCache().
+			DelGroupMembersHash(groupID).
+			DelGroupsMemberNum(groupID).
+			DelGroupMemberIDs(groupID).
+			DelGroupAllRoleLevel(groupID).
+			DelMaxGroupMemberVersion(groupID)
+		for _, groupMember := range groupMembers {
+			c = c.DelJoinedGroupID(groupMember.UserID).
+				DelGroupMembersInfo(groupID, groupMember.UserID).
+				DelMaxJoinGroupVersion(groupMember.UserID)
+		}
+		return c.ChainExecDel(ctx)
+	})
+}
+
+// checkGroupMemberNum must run inside the transaction that inserts the members. The count is read from
+// the transaction snapshot and the insert bumps the group's member version, so two concurrent joins
+// conflict on that document and the retried one sees the other's members.
+func (g *groupDatabase) checkGroupMemberNum(ctx context.Context, groupID string, add int, maxMemberCount int64) error {
+	if maxMemberCount <= 0 {
+		return nil
+	}
+	num, err := g.groupMemberDB.TakeGroupMemberNum(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if num+int64(add) > maxMemberCount {
+		return servererrs.ErrGroupMemberLimit.WrapMsg("group member limit reached", "groupID", groupID, "memberCount", num, "maxMemberCount", maxMemberCount)
+	}
+	return nil
+}
+
 func (g *groupDatabase) FindGroupMemberUserID(ctx context.Context, groupID string) ([]string, error) {
 	return g.cache.GetGroupMemberIDs(ctx, groupID)
 }
@@ -343,12 +440,15 @@ func (g *groupDatabase) SearchGroupMember(ctx context.Context, keyword string, g
 	return g.groupMemberDB.SearchMember(ctx, keyword, groupID, pagination)
 }
 
-func (g *groupDatabase) HandlerGroupRequest(ctx context.Context, groupID string, userID string, handledMsg string, handleResult int32, member *model.GroupMember) error {
+func (g *groupDatabase) HandlerGroupRequest(ctx context.Context, groupID string, userID string, handledMsg string, handleResult int32, member *model.GroupMember, maxMemberCount int64) error {
 	return g.ctxTx.Transaction(ctx, func(ctx context.Context) error {
 		if err := g.groupRequestDB.UpdateHandler(ctx, groupID, userID, handledMsg, handleResult); err != nil {
 			return err
 		}
 		if member != nil {
+			if err := g.checkGroupMemberNum(ctx, groupID, 1, maxMemberCount); err != nil {
+				return err
+			}
 			c := g.cache.CloneGroupCache()
 			if err := g.groupMemberDB.Create(ctx, []*model.GroupMember{member}); err != nil {
 				return err
@@ -475,6 +575,10 @@ func (g *groupDatabase) PageGroupRequestUser(ctx context.Context, userID string,
 	return g.groupRequestDB.Page(ctx, userID, pagination)
 }
 
+func (g *groupDatabase) CountCreatedGroup(ctx context.Context, userID string) (int64, error) {
+	return g.groupDB.CountCreated(ctx, userID)
+}
+
 func (g *groupDatabase) CountTotal(ctx context.Context, before *time.Time) (count int64, err error) {
 	return g.groupDB.CountTotal(ctx, before)
 }
@@ -565,3 +669,112 @@ func (g *groupDatabase) MemberGroupIncrVersion(ctx context.Context, groupID stri
 	}
 	return g.cache.DelMaxGroupMemberVersion(groupID).ChainExecDel(ctx)
 }
+
+func (g *groupDatabase) CreateGroupInvite(ctx context.Context, invite *model.GroupInvite) error {
+	return g.groupInviteDB.Create(ctx, []*model.GroupInvite{invite})
+}
+
+func (g *groupDatabase) TakeGroupInvite(ctx context.Context, token string) (*model.GroupInvite, error) {
+	return g.groupInviteDB.Take(ctx, token)
+}
+
+func (g *groupDatabase) PageGroupInvite(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupInvite, error) {
+	return g.groupInviteDB.PageGroup(ctx, groupID, pagination)
+}
+
+func (g *groupDatabase) RevokeGroupInvite(ctx context.Context, groupID string, token string) error {
+	return g.groupInviteDB.Revoke(ctx, groupID, token)
+}
+
+func (g *groupDatabase) RedeemGroupInvite(ctx context.Context, token string, member *model.GroupMember, request *model.GroupRequest, maxMemberCount int64) error {
+	return g.ctxTx.Transaction(ctx, func(ctx context.Context) error {
+		if err := g.groupInviteDB.IncrUsedCount(ctx, token, time.Now()); err != nil {
+			return err
+		}
+		if request != nil {
+			if err := g.groupRequestDB.Delete(ctx, request.GroupID, request.UserID); err != nil {
+				return err
+			}
+			return g.groupRequestDB.Create(ctx, []*model.GroupRequest{request})
+		}
+		if member == nil {
+			return nil
+		}
+		if err := g.checkGroupMemberNum(ctx, member.GroupID, 1, maxMemberCount); err != nil {
+			return err
+		}
+		if err := g.groupMemberDB.Create(ctx, []*model.GroupMember{member}); err != nil {
+			return err
+		}
+		return g.cache.CloneGroupCache().
+			DelGroupMembersHash(member.GroupID).
+			DelGroupsMemberNum(member.GroupID).
+			DelGroupMemberIDs(member.GroupID).
+			DelJoinedGroupID(member.UserID).
+			DelGroupMembersInfo(member.GroupID, member.UserID).
+			DelGroupAllRoleLevel(member.GroupID).
+			DelMaxJoinGroupVersion(member.UserID).
+			DelMaxGroupMemberVersion(member.GroupID).
+			ChainExecDel(ctx)
+	})
+}
+
+func (g *groupDatabase) CreateGroupAnnouncement(ctx context.Context, announcement *model.GroupAnnouncement) error {
+	return g.announcementDB.Create(ctx, []*model.GroupAnnouncement{announcement})
+}
+
+func (g *groupDatabase) PublishGroupAnnouncement(ctx context.Context, announcement *model.GroupAnnouncement) error {
+	return g.ctxTx.Transaction(ctx, func(ctx context.Context) error {
+		data := map[string]any{
+			"notification":             announcement.Content,
+			"notification_update_time": announcement.CreateTime,
+			"notification_user_id":     announcement.CreatorUserID,
+		}
+		if err := g.groupDB.UpdateMap(ctx, announcement.GroupID, data); err != nil {
+			return err
+		}
+		if err := g.groupMemberDB.MemberGroupIncrVersion(ctx, announcement.GroupID, []string{""}, model.VersionStateUpdate); err != nil {
+			return err
+		}
+		if err := g.announcementDB.Create(ctx, []*model.GroupAnnouncement{announcement}); err != nil {
+			return err
+		}
+		return g.cache.CloneGroupCache().DelGroupsInfo(announcement.GroupID).DelMaxGroupMemberVersion(announcement.GroupID).ChainExecDel(ctx)
+	})
+}
+
+func (g *groupDatabase) TakeGroupAnnouncement(ctx context.Context, groupID string, announcementID string) (*model.GroupAnnouncement, error) {
+	return g.announcementDB.Take(ctx, groupID, announcementID)
+}
+
+func (g *groupDatabase) PageGroupAnnouncement(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupAnnouncement, error) {
+	return g.announcementDB.PageGroup(ctx, groupID, pagination)
+}
+
+func (g *groupDatabase) ConfirmGroupAnnouncement(ctx context.Context, confirm *model.GroupAnnouncementConfirm) error {
+	return g.announcementConfirmDB.Confirm(ctx, confirm)
+}
+
+func (g *groupDatabase) FindGroupAnnouncementConfirm(ctx context.Context, announcementID string) ([]*model.GroupAnnouncementConfirm, error) {
+	return g.announcementConfirmDB.Find(ctx, announcementID)
+}
+
+func (g *groupDatabase) FindConfirmedGroupAnnouncementIDs(ctx context.Context, userID string, announcementIDs []string) ([]string, error) {
+	return g.announcementConfirmDB.FindConfirmedIDs(ctx, userID, announcementIDs)
+}
+
+func (g *groupDatabase) CreateGroupImportJob(ctx context.Context, job *model.GroupImportJob) error {
+	return g.importJobDB.Create(ctx, job)
+}
+
+func (g *groupDatabase) TakeGroupImportJob(ctx context.Context, jobID string) (*model.GroupImportJob, error) {
+	return g.importJobDB.Take(ctx, jobID)
+}
+
+func (g *groupDatabase) UpdateGroupImportJob(ctx context.Context, jobID string, data map[string]any) error {
+	return g.importJobDB.Update(ctx, jobID, data)
+}
+
+func (g *groupDatabase) TakeExpiredGroupImportJob(ctx context.Context, now time.Time, leaseExpire time.Time) (*model.GroupImportJob, error) {
+	return g.importJobDB.TakeExpired(ctx, now, leaseExpire)
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/protocol/constant"
 	pbmsg "github.com/openimsdk/protocol/msg"
@@ -92,12 +93,17 @@ type CommonMsgDatabase interface {
 	RangeUserSendCount(ctx context.Context, start time.Time, end time.Time, group bool, ase bool, pageNumber int32, showNumber int32) (msgCount int64, userCount int64, users []*model.UserCount, dateCount map[string]int64, err error)
 	RangeGroupSendCount(ctx context.Context, start time.Time, end time.Time, ase bool, pageNumber int32, showNumber int32) (msgCount int64, userCount int64, groups []*model.GroupCount, dateCount map[string]int64, err error)
 
-	GetRandBeforeMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error)
+	GetRandBeforeMsg(ctx context.Context, ts int64, limit int, include []string, exclude []string) ([]*model.MsgDocModel, error)
+	GetFullDocsBefore(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error)
+	// FindMsgDoc returns the raw doc, including revoked and per-user deleted messages.
+	FindMsgDoc(ctx context.Context, docID string) (*model.MsgDocModel, error)
 
 	SetUserConversationsMaxSeq(ctx context.Context, conversationID string, userID string, seq int64) error
 	SetUserConversationsMinSeq(ctx context.Context, conversationID string, userID string, seq int64) error
 
 	DeleteDoc(ctx context.Context, docID string) error
+	// DeleteArchivedDoc deletes a doc that was archived, unless it was written since it was read.
+	DeleteArchivedDoc(ctx context.Context, doc *model.MsgDocModel) (bool, error)
 
 	GetLastMessageSeqByTime(ctx context.Context, conversationID string, time int64) (int64, error)
 
@@ -132,6 +138,7 @@ type commonMsgDatabase struct {
 }
 
 func (db *commonMsgDatabase) MsgToMQ(ctx context.Context, key string, msg2mq *sdkws.MsgData) error {
+	defer prommetrics.KafkaProduced(db.producer.Topic(), time.Now())
 	_, _, err := db.producer.SendMessage(ctx, key, msg2mq)
 	return err
 }
@@ -693,8 +700,16 @@ func (db *commonMsgDatabase) FindOneByDocIDs(ctx context.Context, conversationID
 	return totalMsgs, nil
 }
 
-func (db *commonMsgDatabase) GetRandBeforeMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error) {
-	return db.msgDocDatabase.GetRandBeforeMsg(ctx, ts, limit)
+func (db *commonMsgDatabase) FindMsgDoc(ctx context.Context, docID string) (*model.MsgDocModel, error) {
+	return db.msgDocDatabase.FindOneByDocID(ctx, docID)
+}
+
+func (db *commonMsgDatabase) GetFullDocsBefore(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error) {
+	return db.msgDocDatabase.GetFullDocsBefore(ctx, ts, limit)
+}
+
+func (db *commonMsgDatabase) GetRandBeforeMsg(ctx context.Context, ts int64, limit int, include []string, exclude []string) ([]*model.MsgDocModel, error) {
+	return db.msgDocDatabase.GetRandBeforeMsg(ctx, ts, limit, include, exclude)
 }
 
 func (db *commonMsgDatabase) SetMinSeq(ctx context.Context, conversationID string, seq int64) error {
@@ -725,24 +740,44 @@ func (db *commonMsgDatabase) GetMaxSeqsWithTime(ctx context.Context, conversatio
 }
 
 func (db *commonMsgDatabase) DeleteDoc(ctx context.Context, docID string) error {
+	conversationID, seqs, err := db.docSeqs(docID)
+	if err != nil {
+		return err
+	}
+	if err := db.msgDocDatabase.DeleteDoc(ctx, docID); err != nil {
+		return err
+	}
+	return db.msgCache.DelMessageBySeqs(ctx, conversationID, seqs)
+}
+
+func (db *commonMsgDatabase) DeleteArchivedDoc(ctx context.Context, doc *model.MsgDocModel) (bool, error) {
+	conversationID, seqs, err := db.docSeqs(doc.DocID)
+	if err != nil {
+		return false, err
+	}
+	deleted, err := db.msgDocDatabase.DeleteUnchangedDoc(ctx, doc)
+	if err != nil || !deleted {
+		return false, err
+	}
+	return true, db.msgCache.DelMessageBySeqs(ctx, conversationID, seqs)
+}
+
+// docSeqs returns the conversation of a doc and every seq it can hold.
+func (db *commonMsgDatabase) docSeqs(docID string) (string, []int64, error) {
 	index := strings.LastIndex(docID, ":")
 	if index <= 0 {
-		return errs.ErrInternalServer.WrapMsg("docID is invalid", "docID", docID)
+		return "", nil, errs.ErrInternalServer.WrapMsg("docID is invalid", "docID", docID)
 	}
 	docIndex, err := strconv.Atoi(docID[index+1:])
 	if err != nil {
-		return errs.WrapMsg(err, "strconv.Atoi", "docID", docID)
+		return "", nil, errs.WrapMsg(err, "strconv.Atoi", "docID", docID)
 	}
-	conversationID := docID[:index]
 	seqs := make([]int64, db.msgTable.GetSingleGocMsgNum())
 	minSeq := db.msgTable.GetMinSeq(docIndex)
 	for i := range seqs {
 		seqs[i] = minSeq + int64(i)
 	}
-	if err := db.msgDocDatabase.DeleteDoc(ctx, docID); err != nil {
-		return err
-	}
-	return db.msgCache.DelMessageBySeqs(ctx, conversationID, seqs)
+	return docID[:index], seqs, nil
 }
 
 func (db *commonMsgDatabase) GetLastMessageSeqByTime(ctx context.Context, conversationID string, time int64) (int64, error) {
@@ -0,0 +1,211 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/localcache/lru"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/s3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	msgArchivePrefix       = "msg-archive/"
+	msgArchiveSignExpire   = time.Minute * 10
+	msgArchiveHttpTimeout  = time.Minute
+	msgArchiveCacheSuccess = time.Minute * 10
+	msgArchiveCacheFailed  = time.Second * 5
+)
+
+type MsgArchiveDatabase interface {
+	database.MsgDocLoader
+	// ArchiveMsgDoc uploads doc as a compressed bundle and records its stub.
+	// The caller deletes the live doc once it returns.
+	ArchiveMsgDoc(ctx context.Context, doc *model.MsgDocModel) error
+	GetRandArchivesBefore(ctx context.Context, ts int64, limit int, include []string, exclude []string) ([]*model.MsgArchive, error)
+	// DeleteArchive removes the bundle and its stub.
+	DeleteArchive(ctx context.Context, archive *model.MsgArchive) error
+}
+
+func NewMsgArchiveDatabase(archive database.MsgArchive, s3 s3.Interface, cacheSize int) MsgArchiveDatabase {
+	return &msgArchiveDatabase{
+		archive: archive,
+		s3:      s3,
+		cache:   lru.NewLayLRU[string, *model.MsgDocModel](cacheSize, msgArchiveCacheSuccess, msgArchiveCacheFailed, localcache.EmptyTarget{}, func(key string, value *model.MsgDocModel) {}),
+	}
+}
+
+type msgArchiveDatabase struct {
+	archive database.MsgArchive
+	s3      s3.Interface
+	cache   lru.LRU[string, *model.MsgDocModel]
+}
+
+func (a *msgArchiveDatabase) objectName(docID string) string {
+	// docID is conversationID:index
+	return msgArchivePrefix + strings.Replace(docID, ":", "/", 1) + ".bson.gz"
+}
+
+// ArchiveMsgDoc replaces an existing archive of the doc, which was restored and written since.
+func (a *msgArchiveDatabase) ArchiveMsgDoc(ctx context.Context, doc *model.MsgDocModel) error {
+	raw, err := bson.Marshal(doc)
+	if err != nil {
+		return errs.WrapMsg(err, "marshal msg doc failed", "docID", doc.DocID)
+	}
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(raw); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := gz.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+	data := buf.Bytes()
+	sum := sha256.Sum256(data)
+	stub := &model.MsgArchive{
+		DocID:      doc.DocID,
+		ObjectName: a.objectName(doc.DocID),
+		Checksum:   hex.EncodeToString(sum[:]),
+		Size:       int64(len(data)),
+		CreateTime: time.Now(),
+	}
+	for _, info := range doc.Msg {
+		if info == nil || info.Msg == nil {
+			continue
+		}
+		if stub.MinSeq == 0 || info.Msg.Seq < stub.MinSeq {
+			stub.MinSeq = info.Msg.Seq
+		}
+		if info.Msg.Seq > stub.MaxSeq {
+			stub.MaxSeq = info.Msg.Seq
+		}
+		if info.Msg.SendTime > stub.MaxSendTime {
+			stub.MaxSendTime = info.Msg.SendTime
+		}
+	}
+	if err := a.upload(ctx, stub.ObjectName, data); err != nil {
+		return err
+	}
+	return a.archive.Set(ctx, stub)
+}
+
+func (a *msgArchiveDatabase) upload(ctx context.Context, name string, data []byte) error {
+	rawURL, err := a.s3.PresignedPutObject(ctx, name, msgArchiveSignExpire)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, msgArchiveHttpTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, rawURL, bytes.NewReader(data))
+	if err != nil {
+		return errs.WrapMsg(err, "invalid msg archive url")
+	}
+	req.ContentLength = int64(len(data))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errs.WrapMsg(err, "upload msg archive failed", "name", name)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errs.New("upload msg archive failed", "name", name, "status", resp.Status).Wrap()
+	}
+	return nil
+}
+
+// LoadMsgDoc caches the downloaded bundles by checksum, so a doc archived again is never read stale.
+func (a *msgArchiveDatabase) LoadMsgDoc(ctx context.Context, docID string) (*model.MsgDocModel, error) {
+	stub, err := a.archive.Take(ctx, docID)
+	if err != nil {
+		if errs.Unwrap(err) == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return a.cache.Get(stub.Checksum, func() (*model.MsgDocModel, error) {
+		return a.loadMsgDoc(ctx, stub)
+	})
+}
+
+func (a *msgArchiveDatabase) loadMsgDoc(ctx context.Context, stub *model.MsgArchive) (*model.MsgDocModel, error) {
+	docID := stub.DocID
+	rawURL, err := a.s3.AccessURL(ctx, stub.ObjectName, msgArchiveSignExpire, nil)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, msgArchiveHttpTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid msg archive url")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "download msg archive failed", "docID", docID)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errs.New("download msg archive failed", "docID", docID, "status", resp.Status).Wrap()
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, stub.Size+1))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "download msg archive failed", "docID", docID)
+	}
+	sum := sha256.Sum256(data)
+	if int64(len(data)) != stub.Size || hex.EncodeToString(sum[:]) != stub.Checksum {
+		return nil, errs.New("msg archive checksum mismatch", "docID", docID, "objectName", stub.ObjectName).Wrap()
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	raw, err := io.ReadAll(gz)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var doc model.MsgDocModel
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, errs.WrapMsg(err, "unmarshal msg archive failed", "docID", docID)
+	}
+	return &doc, nil
+}
+
+func (a *msgArchiveDatabase) GetRandArchivesBefore(ctx context.Context, ts int64, limit int, include []string, exclude []string) ([]*model.MsgArchive, error) {
+	return a.archive.GetRandBefore(ctx, ts, limit, include, exclude)
+}
+
+func (a *msgArchiveDatabase) DeleteArchive(ctx context.Context, archive *model.MsgArchive) error {
+	if err := a.s3.DeleteObject(ctx, archive.ObjectName); err != nil {
+		return err
+	}
+	if err := a.archive.Delete(ctx, archive.DocID); err != nil {
+		return err
+	}
+	a.cache.Del(archive.Checksum)
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type MsgRetentionDatabase interface {
+	CreatePolicy(ctx context.Context, policy *model.MsgRetentionPolicy) error
+	UpdatePolicy(ctx context.Context, policyID string, data map[string]any) error
+	DeletePolicies(ctx context.Context, policyIDs []string) error
+	TakePolicy(ctx context.Context, policyID string) (*model.MsgRetentionPolicy, error)
+	// FindAllPolicies returns every policy, used to resolve which policy governs a conversation.
+	FindAllPolicies(ctx context.Context) ([]*model.MsgRetentionPolicy, error)
+	PagePolicies(ctx context.Context, scope int32, pagination pagination.Pagination) (int64, []*model.MsgRetentionPolicy, error)
+}
+
+func NewMsgRetentionDatabase(policy database.MsgRetentionPolicy) MsgRetentionDatabase {
+	return &msgRetentionDatabase{policy: policy}
+}
+
+type msgRetentionDatabase struct {
+	policy database.MsgRetentionPolicy
+}
+
+func (m *msgRetentionDatabase) CreatePolicy(ctx context.Context, policy *model.MsgRetentionPolicy) error {
+	return m.policy.Create(ctx, policy)
+}
+
+func (m *msgRetentionDatabase) UpdatePolicy(ctx context.Context, policyID string, data map[string]any) error {
+	return m.policy.Update(ctx, policyID, data)
+}
+
+func (m *msgRetentionDatabase) DeletePolicies(ctx context.Context, policyIDs []string) error {
+	return m.policy.Delete(ctx, policyIDs)
+}
+
+func (m *msgRetentionDatabase) TakePolicy(ctx context.Context, policyID string) (*model.MsgRetentionPolicy, error) {
+	return m.policy.Take(ctx, policyID)
+}
+
+func (m *msgRetentionDatabase) FindAllPolicies(ctx context.Context) ([]*model.MsgRetentionPolicy, error) {
+	return m.policy.FindAll(ctx)
+}
+
+func (m *msgRetentionDatabase) PagePolicies(ctx context.Context, scope int32, pagination pagination.Pagination) (int64, []*model.MsgRetentionPolicy, error) {
+	return m.policy.Page(ctx, scope, pagination)
+}
@@ -19,11 +19,13 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	redisCache "github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/s3"
 	"github.com/openimsdk/tools/s3/cont"
 	"github.com/redis/go-redis/v9"
@@ -37,28 +39,41 @@ type S3Database interface {
 	CompleteMultipartUpload(ctx context.Context, uploadID string, parts []string) (*cont.UploadResult, error)
 	AccessURL(ctx context.Context, name string, expire time.Duration, opt *s3.AccessURLOption) (time.Time, string, error)
 	SetObject(ctx context.Context, info *model.Object) error
+	TakeObject(ctx context.Context, name string) (*model.Object, error)
 	StatObject(ctx context.Context, name string) (*s3.ObjectInfo, error)
 	FormData(ctx context.Context, name string, size int64, contentType string, duration time.Duration) (*s3.FormData, error)
-	FindExpirationObject(ctx context.Context, engine string, expiration time.Time, needDelType []string, count int64) ([]*model.Object, error)
+	FindExpirationObject(ctx context.Context, engine string, expiration time.Time, needDelType []string, excludeUserIDs []string, excludeNames []string, count int64) ([]*model.Object, error)
 	DeleteSpecifiedData(ctx context.Context, engine string, name []string) error
 	DelS3Key(ctx context.Context, engine string, keys ...string) error
 	GetKeyCount(ctx context.Context, engine string, key string) (int64, error)
+	// TakeObjectBlob returns the stored file with the content hash and size, for uploads of the
+	// same content to point to it instead of storing it again.
+	TakeObjectBlob(ctx context.Context, hash string, size int64) (*model.ObjectBlob, error)
+	// ReleaseObjectKey drops the reference of a deleted object to the file of its key.
+	ReleaseObjectKey(ctx context.Context, engine string, key string) error
+	FindUnreferencedBlob(ctx context.Context, engine string, count int64) ([]*model.ObjectBlob, error)
+	MarkBlobDeleting(ctx context.Context, engine string, key string) (bool, error)
+	DeleteBlob(ctx context.Context, engine string, key string) error
 }
 
-func NewS3Database(rdb redis.UniversalClient, s3 s3.Interface, obj database.ObjectInfo) S3Database {
+func NewS3Database(rdb redis.UniversalClient, s3 s3.Interface, obj database.ObjectInfo, blob database.ObjectBlob) S3Database {
 	return &s3Database{
 		s3:      cont.New(redisCache.NewS3Cache(rdb, s3), s3),
+		impl:    s3,
 		cache:   redisCache.NewObjectCacheRedis(rdb, obj),
 		s3cache: redisCache.NewS3Cache(rdb, s3),
 		db:      obj,
+		blob:    blob,
 	}
 }
 
 type s3Database struct {
 	s3      *cont.Controller
+	impl    s3.Interface
 	cache   cache.ObjectCache
 	s3cache cont.S3Cache
 	db      database.ObjectInfo
+	blob    database.ObjectBlob
 }
 
 func (s *s3Database) PartSize(ctx context.Context, size int64) (int64, error) {
@@ -83,12 +98,90 @@ func (s *s3Database) CompleteMultipartUpload(ctx context.Context, uploadID strin
 
 func (s *s3Database) SetObject(ctx context.Context, info *model.Object) error {
 	info.Engine = s.s3.Engine()
-	if err := s.db.SetObject(ctx, info); err != nil {
+	// the file is referenced before the name points to it, so it is never unreferenced in use
+	key, err := s.referBlob(ctx, info.Engine, info.Key, info.Hash, info.Size)
+	if err != nil {
 		return err
 	}
+	info.Key = key
+	old, err := s.db.SwapObject(ctx, info)
+	if err != nil {
+		if _, err := s.incrBlobRef(ctx, info.Engine, info.Key, info.Hash, info.Size, -1); err != nil {
+			log.ZError(ctx, "release blob reference failed", err, "key", info.Key)
+		}
+		return err
+	}
+	// the replaced object held its own reference, also when it pointed to the same file; the
+	// file is deleted later if nothing else points to it
+	if old != nil {
+		if _, err := s.incrBlobRef(ctx, old.Engine, old.Key, old.Hash, old.Size, -1); err != nil {
+			return err
+		}
+	}
 	return s.cache.DelObjectName(info.Engine, info.Name).ChainExecDel(ctx)
 }
 
+// referBlob adds a reference to the file of key and returns the key to store. A file being
+// deleted is copied to a new key first, the deletion does not touch the copy.
+func (s *s3Database) referBlob(ctx context.Context, engine string, key string, hash string, size int64) (string, error) {
+	if ok, err := s.incrBlobRef(ctx, engine, key, hash, size, 1); err != nil || ok {
+		return key, err
+	}
+	newKey := key + "." + s.s3.UUID()
+	if _, err := s.impl.CopyObject(ctx, key, newKey); err != nil {
+		if s.s3.IsNotFound(err) {
+			return "", servererrs.ErrFileUploadedExpired.WrapMsg("the stored file was deleted, upload it again", "key", key)
+		}
+		return "", err
+	}
+	now := time.Now()
+	blob := &model.ObjectBlob{
+		Engine:     engine,
+		Key:        newKey,
+		Hash:       hash,
+		Size:       size,
+		RefCount:   1,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	if err := s.blob.Create(ctx, blob); err != nil {
+		return "", err
+	}
+	log.ZDebug(ctx, "copied file being deleted", "key", key, "newKey", newKey)
+	return newKey, nil
+}
+
+// incrBlobRef adds delta references to the file of key, and reports false if the file is being
+// deleted. Files stored before references were counted have no blob yet, it starts from the
+// number of objects pointing to the key then.
+func (s *s3Database) incrBlobRef(ctx context.Context, engine string, key string, hash string, size int64, delta int64) (bool, error) {
+	if ok, err := s.blob.IncrRef(ctx, engine, key, delta); err != nil || ok {
+		return ok, err
+	}
+	count, err := s.db.GetKeyCount(ctx, engine, key)
+	if err != nil {
+		return false, err
+	}
+	// an added reference is taken before the object points to the key, a removed one after
+	if delta < 0 {
+		count -= delta
+	}
+	now := time.Now()
+	blob := &model.ObjectBlob{
+		Engine:     engine,
+		Key:        key,
+		Hash:       hash,
+		Size:       size,
+		RefCount:   count,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	if err := s.blob.Create(ctx, blob); err != nil {
+		return false, err
+	}
+	return s.blob.IncrRef(ctx, engine, key, delta)
+}
+
 func (s *s3Database) AccessURL(ctx context.Context, name string, expire time.Duration, opt *s3.AccessURLOption) (time.Time, string, error) {
 	obj, err := s.cache.GetName(ctx, s.s3.Engine(), name)
 	if err != nil {
@@ -111,6 +204,10 @@ func (s *s3Database) AccessURL(ctx context.Context, name string, expire time.Dur
 	return expireTime, rawURL, nil
 }
 
+func (s *s3Database) TakeObject(ctx context.Context, name string) (*model.Object, error) {
+	return s.cache.GetName(ctx, s.s3.Engine(), name)
+}
+
 func (s *s3Database) StatObject(ctx context.Context, name string) (*s3.ObjectInfo, error) {
 	return s.s3.StatObject(ctx, name)
 }
@@ -119,8 +216,8 @@ func (s *s3Database) FormData(ctx context.Context, name string, size int64, cont
 	return s.s3.FormData(ctx, name, size, contentType, duration)
 }
 
-func (s *s3Database) FindExpirationObject(ctx context.Context, engine string, expiration time.Time, needDelType []string, count int64) ([]*model.Object, error) {
-	return s.db.FindExpirationObject(ctx, engine, expiration, needDelType, count)
+func (s *s3Database) FindExpirationObject(ctx context.Context, engine string, expiration time.Time, needDelType []string, excludeUserIDs []string, excludeNames []string, count int64) ([]*model.Object, error) {
+	return s.db.FindExpirationObject(ctx, engine, expiration, needDelType, excludeUserIDs, excludeNames, count)
 }
 
 func (s *s3Database) GetKeyCount(ctx context.Context, engine string, key string) (int64, error) {
@@ -134,3 +231,28 @@ func (s *s3Database) DeleteSpecifiedData(ctx context.Context, engine string, nam
 func (s *s3Database) DelS3Key(ctx context.Context, engine string, keys ...string) error {
 	return s.s3cache.DelS3Key(ctx, engine, keys...)
 }
+
+func (s *s3Database) TakeObjectBlob(ctx context.Context, hash string, size int64) (*model.ObjectBlob, error) {
+	return s.blob.TakeHash(ctx, s.s3.Engine(), hash, size)
+}
+
+func (s *s3Database) ReleaseObjectKey(ctx context.Context, engine string, key string) error {
+	// a file being deleted has no references left to drop
+	_, err := s.incrBlobRef(ctx, engine, key, "", 0, -1)
+	return err
+}
+
+func (s *s3Database) FindUnreferencedBlob(ctx context.Context, engine string, count int64) ([]*model.ObjectBlob, error) {
+	return s.blob.FindUnreferenced(ctx, engine, count)
+}
+
+func (s *s3Database) MarkBlobDeleting(ctx context.Context, engine string, key string) (bool, error) {
+	return s.blob.MarkDeleting(ctx, engine, key)
+}
+
+func (s *s3Database) DeleteBlob(ctx context.Context, engine string, key string) error {
+	if err := s.DelS3Key(ctx, engine, key); err != nil {
+		return err
+	}
+	return s.blob.Delete(ctx, engine, key)
+}
@@ -0,0 +1,259 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/s3"
+	"github.com/openimsdk/tools/s3/cont"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/stretchr/testify/assert"
+)
+
+type memObjectInfo struct {
+	database.ObjectInfo
+	mu      sync.Mutex
+	objects map[string]*model.Object
+}
+
+func (m *memObjectInfo) SwapObject(ctx context.Context, obj *model.Object) (*model.Object, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	old := m.objects[obj.Name]
+	cp := *obj
+	m.objects[obj.Name] = &cp
+	return old, nil
+}
+
+func (m *memObjectInfo) GetKeyCount(ctx context.Context, engine string, key string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var count int64
+	for _, obj := range m.objects {
+		if obj.Key == key {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *memObjectInfo) FindExpirationObject(ctx context.Context, engine string, expiration time.Time, needDelType []string, excludeUserIDs []string, excludeNames []string, count int64) ([]*model.Object, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var res []*model.Object
+	for _, obj := range m.objects {
+		if obj.CreateTime.Before(expiration) && datautil.Contain(obj.Group, needDelType...) {
+			res = append(res, obj)
+		}
+	}
+	return res, nil
+}
+
+func (m *memObjectInfo) Delete(ctx context.Context, engine string, names []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, name := range names {
+		delete(m.objects, name)
+	}
+	return nil
+}
+
+type memObjectBlob struct {
+	database.ObjectBlob
+	mu    sync.Mutex
+	blobs map[string]*model.ObjectBlob
+}
+
+func (m *memObjectBlob) Create(ctx context.Context, blob *model.ObjectBlob) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.blobs[blob.Key]; !ok {
+		cp := *blob
+		m.blobs[blob.Key] = &cp
+	}
+	return nil
+}
+
+func (m *memObjectBlob) IncrRef(ctx context.Context, engine string, key string, delta int64) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	blob, ok := m.blobs[key]
+	if !ok || blob.Deleting {
+		return false, nil
+	}
+	blob.RefCount += delta
+	return true, nil
+}
+
+func (m *memObjectBlob) FindUnreferenced(ctx context.Context, engine string, count int64) ([]*model.ObjectBlob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var res []*model.ObjectBlob
+	for _, blob := range m.blobs {
+		if blob.RefCount <= 0 {
+			res = append(res, blob)
+		}
+	}
+	return res, nil
+}
+
+func (m *memObjectBlob) refCount(key string) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if blob, ok := m.blobs[key]; ok {
+		return blob.RefCount
+	}
+	return -1
+}
+
+type memS3 struct {
+	s3.Interface
+	files map[string]bool
+}
+
+func (m *memS3) Engine() string {
+	return "minio"
+}
+
+func (m *memS3) IsNotFound(err error) bool {
+	return err == errMemS3NotFound
+}
+
+var errMemS3NotFound = assert.AnError
+
+func (m *memS3) CopyObject(ctx context.Context, src string, dst string) (*s3.CopyObjectInfo, error) {
+	if !m.files[src] {
+		return nil, errMemS3NotFound
+	}
+	m.files[dst] = true
+	return &s3.CopyObjectInfo{Key: dst}, nil
+}
+
+type nopObjectCache struct {
+	cache.ObjectCache
+}
+
+func (n nopObjectCache) DelObjectName(engine string, names ...string) cache.ObjectCache {
+	return n
+}
+
+func (n nopObjectCache) ChainExecDel(ctx context.Context) error {
+	return nil
+}
+
+func newMemS3Database() (*s3Database, *memObjectInfo, *memObjectBlob, *memS3) {
+	objects := &memObjectInfo{objects: make(map[string]*model.Object)}
+	blobs := &memObjectBlob{blobs: make(map[string]*model.ObjectBlob)}
+	impl := &memS3{files: map[string]bool{"k1": true, "k2": true}}
+	return &s3Database{
+		s3:    cont.New(nil, impl),
+		impl:  impl,
+		cache: nopObjectCache{},
+		db:    objects,
+		blob:  blobs,
+	}, objects, blobs, impl
+}
+
+func TestSetObjectConcurrent(t *testing.T) {
+	s, _, blobs, _ := newMemS3Database()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, s.SetObject(context.Background(), &model.Object{Name: "a", Key: "k1"}))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(1), blobs.refCount("k1"))
+}
+
+func TestSetObjectReplace(t *testing.T) {
+	ctx := context.Background()
+	s, objects, blobs, _ := newMemS3Database()
+	assert.NoError(t, s.SetObject(ctx, &model.Object{Name: "a", Key: "k1"}))
+	assert.NoError(t, s.SetObject(ctx, &model.Object{Name: "b", Key: "k1"}))
+	assert.NoError(t, s.SetObject(ctx, &model.Object{Name: "a", Key: "k2"}))
+	assert.Equal(t, int64(1), blobs.refCount("k1"))
+	assert.Equal(t, int64(1), blobs.refCount("k2"))
+	assert.Equal(t, "k2", objects.objects["a"].Key)
+
+	// objects stored before references were counted
+	objects.objects["c"] = &model.Object{Engine: "minio", Name: "c", Key: "k3"}
+	objects.objects["d"] = &model.Object{Engine: "minio", Name: "d", Key: "k3"}
+	assert.NoError(t, s.SetObject(ctx, &model.Object{Name: "c", Key: "k2"}))
+	assert.Equal(t, int64(1), blobs.refCount("k3"))
+	assert.Equal(t, int64(2), blobs.refCount("k2"))
+}
+
+func TestSetObjectDeletingBlob(t *testing.T) {
+	ctx := context.Background()
+	s, objects, blobs, impl := newMemS3Database()
+	blobs.blobs["k1"] = &model.ObjectBlob{Engine: "minio", Key: "k1", Deleting: true}
+
+	assert.NoError(t, s.SetObject(ctx, &model.Object{Name: "a", Key: "k1"}))
+	key := objects.objects["a"].Key
+	assert.NotEqual(t, "k1", key)
+	assert.True(t, impl.files[key])
+	assert.Equal(t, int64(1), blobs.refCount(key))
+	assert.Equal(t, int64(0), blobs.refCount("k1"))
+
+	// the file is gone already
+	delete(impl.files, "k1")
+	err := s.SetObject(ctx, &model.Object{Name: "b", Key: "k1"})
+	assert.Error(t, err)
+	_, ok := objects.objects["b"]
+	assert.False(t, ok)
+}
+
+func TestFavoriteCopyOutlivesSource(t *testing.T) {
+	ctx := context.Background()
+	s, objects, blobs, _ := newMemS3Database()
+	uploaded := time.Now().Add(-time.Hour)
+	assert.NoError(t, s.SetObject(ctx, &model.Object{Name: "u1/a.png", Key: "k1", Group: "msg-picture", CreateTime: uploaded}))
+	// the copy made by CopyObject when the message is added to the favorites
+	copyName := "favorite/u1/f1/c1/u1/a.png"
+	assert.NoError(t, s.SetObject(ctx, &model.Object{Name: copyName, Key: "k1", Group: "favorite", CreateTime: uploaded}))
+
+	// what DeleteOutdatedData does with the deleteObjectType of the default cron task config
+	deleteObjectType := []string{"msg-picture", "msg-file", "msg-voice", "msg-video", "msg-video-snapshot", "sdklog"}
+	expired, err := s.FindExpirationObject(ctx, "minio", time.Now(), deleteObjectType, nil, nil, 100)
+	assert.NoError(t, err)
+	assert.Len(t, expired, 1)
+	for _, obj := range expired {
+		assert.NoError(t, s.DeleteSpecifiedData(ctx, obj.Engine, []string{obj.Name}))
+		assert.NoError(t, s.ReleaseObjectKey(ctx, obj.Engine, obj.Key))
+	}
+	unreferenced, err := s.FindUnreferencedBlob(ctx, "minio", 100)
+	assert.NoError(t, err)
+	assert.Empty(t, unreferenced, "the file of the favorite copy is not deleted")
+	assert.Equal(t, int64(1), blobs.refCount("k1"))
+	assert.NotContains(t, objects.objects, "u1/a.png")
+	assert.Equal(t, "k1", objects.objects[copyName].Key)
+
+	// deleting the favorite releases the last reference
+	assert.NoError(t, s.DeleteSpecifiedData(ctx, "minio", []string{copyName}))
+	assert.NoError(t, s.ReleaseObjectKey(ctx, "minio", "k1"))
+	unreferenced, err = s.FindUnreferencedBlob(ctx, "minio", 100)
+	assert.NoError(t, err)
+	assert.Len(t, unreferenced, 1)
+}
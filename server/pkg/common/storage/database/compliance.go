@@ -0,0 +1,40 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type LegalHold interface {
+	// Set creates the holds, keeping the existing ones unchanged.
+	Set(ctx context.Context, holds []*model.LegalHold) error
+	Delete(ctx context.Context, targetType int32, targetIDs []string) error
+	FindAll(ctx context.Context) ([]*model.LegalHold, error)
+	Page(ctx context.Context, targetType int32, pagination pagination.Pagination) (int64, []*model.LegalHold, error)
+}
+
+type ComplianceExportJob interface {
+	Create(ctx context.Context, job *model.ComplianceExportJob) error
+	Take(ctx context.Context, jobID string) (*model.ComplianceExportJob, error)
+	Update(ctx context.Context, jobID string, data map[string]any) error
+	// TakeExpired takes over one running job whose lease expired before now, giving it a new
+	// lease and counting the attempt. It returns nil when there is none.
+	TakeExpired(ctx context.Context, now time.Time, leaseExpire time.Time) (*model.ComplianceExportJob, error)
+}
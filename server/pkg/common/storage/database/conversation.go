@@ -43,4 +43,7 @@ type Conversation interface {
 	GetConversationNotReceiveMessageUserIDs(ctx context.Context, conversationID string) ([]string, error)
 	FindConversationUserVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error)
 	FindRandConversation(ctx context.Context, ts int64, limit int) ([]*model.Conversation, error)
+	AddFolder(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+	RemoveFolder(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+	FindFolderConversationIDs(ctx context.Context, ownerUserID string, folderID string) ([]string, error)
 }
@@ -0,0 +1,31 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type ConversationFolder interface {
+	// Create stores the folder in a free slot below maxFolders, it fails with ErrArgs when the
+	// owner has maxFolders folders already.
+	Create(ctx context.Context, folder *model.ConversationFolder, maxFolders int) error
+	Update(ctx context.Context, ownerUserID string, folderID string, data map[string]any) error
+	Delete(ctx context.Context, ownerUserID string, folderID string) error
+	Take(ctx context.Context, ownerUserID string, folderID string) (*model.ConversationFolder, error)
+	Find(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error)
+}
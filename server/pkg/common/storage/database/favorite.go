@@ -0,0 +1,33 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type Favorite interface {
+	Create(ctx context.Context, favorite *model.Favorite) error
+	Update(ctx context.Context, userID string, favoriteID string, data map[string]any) error
+	Delete(ctx context.Context, userID string, favoriteIDs []string) error
+	Take(ctx context.Context, userID string, favoriteID string) (*model.Favorite, error)
+	Find(ctx context.Context, userID string, favoriteIDs []string) ([]*model.Favorite, error)
+	FindFavoriteIDs(ctx context.Context, userID string) ([]string, error)
+	Search(ctx context.Context, userID string, keyword string, tags []string, pagination pagination.Pagination) (int64, []*model.Favorite, error)
+	FindFavoriteVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error)
+}
@@ -28,6 +28,8 @@ type Group interface {
 	Find(ctx context.Context, groupIDs []string) (groups []*model.Group, err error)
 	Take(ctx context.Context, groupID string) (group *model.Group, err error)
 	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (total int64, groups []*model.Group, err error)
+	// Get the number of groups created by a user that are not dismissed
+	CountCreated(ctx context.Context, creatorUserID string) (count int64, err error)
 	// Get Group total quantity
 	CountTotal(ctx context.Context, before *time.Time) (count int64, err error)
 	// Get Group total quantity every day
@@ -0,0 +1,36 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type GroupAnnouncement interface {
+	Create(ctx context.Context, announcements []*model.GroupAnnouncement) error
+	Take(ctx context.Context, groupID string, announcementID string) (*model.GroupAnnouncement, error)
+	PageGroup(ctx context.Context, groupID string, pagination pagination.Pagination) (total int64, announcements []*model.GroupAnnouncement, err error)
+}
+
+type GroupAnnouncementConfirm interface {
+	// Confirm records the confirmation, keeping the first confirm time if it already exists.
+	Confirm(ctx context.Context, confirm *model.GroupAnnouncementConfirm) error
+	Find(ctx context.Context, announcementID string) ([]*model.GroupAnnouncementConfirm, error)
+	// FindConfirmedIDs returns which of announcementIDs the user has confirmed.
+	FindConfirmedIDs(ctx context.Context, userID string, announcementIDs []string) ([]string, error)
+}
@@ -0,0 +1,31 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type GroupImportJob interface {
+	Create(ctx context.Context, job *model.GroupImportJob) error
+	Take(ctx context.Context, jobID string) (*model.GroupImportJob, error)
+	Update(ctx context.Context, jobID string, data map[string]any) error
+	// TakeExpired takes over one running job whose lease expired before now, giving it a new
+	// lease and counting the attempt. It returns nil when there is none.
+	TakeExpired(ctx context.Context, now time.Time, leaseExpire time.Time) (*model.GroupImportJob, error)
+}
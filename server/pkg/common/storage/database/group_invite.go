@@ -0,0 +1,32 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type GroupInvite interface {
+	Create(ctx context.Context, invites []*model.GroupInvite) error
+	Take(ctx context.Context, token string) (*model.GroupInvite, error)
+	PageGroup(ctx context.Context, groupID string, pagination pagination.Pagination) (total int64, invites []*model.GroupInvite, err error)
+	Revoke(ctx context.Context, groupID string, token string) error
+	// IncrUsedCount consumes one use of a valid (not revoked, not expired, not exhausted) invite.
+	IncrUsedCount(ctx context.Context, token string, now time.Time) error
+}
@@ -0,0 +1,136 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewLegalHoldMgo(db *mongo.Database) (database.LegalHold, error) {
+	coll := db.Collection(database.LegalHoldName)
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "target_type", Value: 1},
+			{Key: "target_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &LegalHoldMgo{coll: coll}, nil
+}
+
+type LegalHoldMgo struct {
+	coll *mongo.Collection
+}
+
+func (l *LegalHoldMgo) Set(ctx context.Context, holds []*model.LegalHold) error {
+	if len(holds) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(holds))
+	for _, hold := range holds {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"target_type": hold.TargetType, "target_id": hold.TargetID}).
+			SetUpdate(bson.M{"$setOnInsert": hold}).
+			SetUpsert(true))
+	}
+	_, err := l.coll.BulkWrite(ctx, models)
+	return errs.Wrap(err)
+}
+
+func (l *LegalHoldMgo) Delete(ctx context.Context, targetType int32, targetIDs []string) error {
+	if len(targetIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, l.coll, bson.M{"target_type": targetType, "target_id": bson.M{"$in": targetIDs}})
+}
+
+func (l *LegalHoldMgo) FindAll(ctx context.Context) ([]*model.LegalHold, error) {
+	return mongoutil.Find[*model.LegalHold](ctx, l.coll, bson.M{})
+}
+
+func (l *LegalHoldMgo) Page(ctx context.Context, targetType int32, pagination pagination.Pagination) (int64, []*model.LegalHold, error) {
+	filter := bson.M{}
+	if targetType != 0 {
+		filter["target_type"] = targetType
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*model.LegalHold](ctx, l.coll, filter, pagination, opts)
+}
+
+func NewComplianceExportJobMgo(db *mongo.Database) (database.ComplianceExportJob, error) {
+	coll := db.Collection(database.ComplianceExportJobName)
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "job_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ComplianceExportJobMgo{coll: coll}, nil
+}
+
+type ComplianceExportJobMgo struct {
+	coll *mongo.Collection
+}
+
+func (c *ComplianceExportJobMgo) Create(ctx context.Context, job *model.ComplianceExportJob) error {
+	return mongoutil.InsertMany(ctx, c.coll, []*model.ComplianceExportJob{job})
+}
+
+func (c *ComplianceExportJobMgo) Take(ctx context.Context, jobID string) (*model.ComplianceExportJob, error) {
+	return mongoutil.FindOne[*model.ComplianceExportJob](ctx, c.coll, bson.M{"job_id": jobID})
+}
+
+func (c *ComplianceExportJobMgo) Update(ctx context.Context, jobID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, c.coll, bson.M{"job_id": jobID}, bson.M{"$set": data}, true)
+}
+
+func (c *ComplianceExportJobMgo) TakeExpired(ctx context.Context, now time.Time, leaseExpire time.Time) (*model.ComplianceExportJob, error) {
+	// jobs started before leases were introduced have none
+	filter := bson.M{"status": constant.ComplianceExportRunning, "$or": bson.A{
+		bson.M{"lease_expire": bson.M{"$lt": now}},
+		bson.M{"lease_expire": bson.M{"$exists": false}},
+	}}
+	update := bson.M{"$set": bson.M{"lease_expire": leaseExpire, "update_time": now}, "$inc": bson.M{"attempts": 1}}
+	opt := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	job, err := mongoutil.FindOneAndUpdate[*model.ComplianceExportJob](ctx, c.coll, filter, update, opt)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return job, nil
+}
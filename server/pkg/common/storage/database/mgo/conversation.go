@@ -267,3 +267,33 @@ func (c *ConversationMgo) FindRandConversation(ctx context.Context, ts int64, li
 	}
 	return mongoutil.Aggregate[*model.Conversation](ctx, c.coll, pipeline)
 }
+
+func (c *ConversationMgo) AddFolder(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	if len(conversationIDs) == 0 {
+		return nil
+	}
+	filter := bson.M{"owner_user_id": ownerUserID, "conversation_id": bson.M{"$in": conversationIDs}}
+	return mongoutil.IncrVersion(func() error {
+		_, err := mongoutil.UpdateMany(ctx, c.coll, filter, bson.M{"$addToSet": bson.M{"folder_ids": folderID}})
+		return err
+	}, func() error {
+		return c.version.IncrVersion(ctx, ownerUserID, conversationIDs, model.VersionStateUpdate)
+	})
+}
+
+func (c *ConversationMgo) RemoveFolder(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	if len(conversationIDs) == 0 {
+		return nil
+	}
+	filter := bson.M{"owner_user_id": ownerUserID, "conversation_id": bson.M{"$in": conversationIDs}}
+	return mongoutil.IncrVersion(func() error {
+		_, err := mongoutil.UpdateMany(ctx, c.coll, filter, bson.M{"$pull": bson.M{"folder_ids": folderID}})
+		return err
+	}, func() error {
+		return c.version.IncrVersion(ctx, ownerUserID, conversationIDs, model.VersionStateUpdate)
+	})
+}
+
+func (c *ConversationMgo) FindFolderConversationIDs(ctx context.Context, ownerUserID string, folderID string) ([]string, error) {
+	return mongoutil.Find[string](ctx, c.coll, bson.M{"owner_user_id": ownerUserID, "folder_ids": folderID}, options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1}))
+}
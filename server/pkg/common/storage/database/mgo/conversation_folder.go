@@ -0,0 +1,133 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NewConversationFolderMongo stores the folders next to the conversations, every folder change
+// is logged in the conversation version log of the owner so it syncs with the conversations.
+func NewConversationFolderMongo(db *mongo.Database) (database.ConversationFolder, error) {
+	coll := db.Collection(database.ConversationFolderName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "owner_user_id", Value: 1},
+				{Key: "folder_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "owner_user_id", Value: 1},
+				{Key: "slot", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	version, err := NewVersionLog(db.Collection(database.ConversationVersionName))
+	if err != nil {
+		return nil, err
+	}
+	return &ConversationFolderMgo{version: version, coll: coll}, nil
+}
+
+type ConversationFolderMgo struct {
+	version database.VersionLog
+	coll    *mongo.Collection
+}
+
+func (c *ConversationFolderMgo) incrVersion(ctx context.Context, ownerUserID string) error {
+	return c.version.IncrVersion(ctx, ownerUserID, []string{model.VersionFolderChangeID}, model.VersionStateUpdate)
+}
+
+// Create takes the lowest free slot. Two concurrent creates taking the same slot make the unique
+// index reject one of them, which then retries with the slots left.
+func (c *ConversationFolderMgo) Create(ctx context.Context, folder *model.ConversationFolder, maxFolders int) error {
+	for {
+		slots, err := mongoutil.Find[int](ctx, c.coll, bson.M{"owner_user_id": folder.OwnerUserID}, options.Find().SetProjection(bson.M{"_id": 0, "slot": 1}))
+		if err != nil {
+			return err
+		}
+		slot := freeFolderSlot(slots, maxFolders)
+		if slot < 0 {
+			return errs.ErrArgs.WrapMsg("too many conversation folders", "max", maxFolders)
+		}
+		folder.Slot = slot
+		err = mongoutil.IncrVersion(func() error {
+			return mongoutil.InsertMany(ctx, c.coll, []*model.ConversationFolder{folder})
+		}, func() error {
+			return c.incrVersion(ctx, folder.OwnerUserID)
+		})
+		if err == nil || !mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+			return err
+		}
+	}
+}
+
+// freeFolderSlot returns the lowest slot below maxFolders not in slots, or -1.
+func freeFolderSlot(slots []int, maxFolders int) int {
+	used := make(map[int]struct{}, len(slots))
+	for _, slot := range slots {
+		used[slot] = struct{}{}
+	}
+	for slot := 0; slot < maxFolders; slot++ {
+		if _, ok := used[slot]; !ok {
+			return slot
+		}
+	}
+	return -1
+}
+
+func (c *ConversationFolderMgo) Update(ctx context.Context, ownerUserID string, folderID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.IncrVersion(func() error {
+		return mongoutil.UpdateOne(ctx, c.coll, bson.M{"owner_user_id": ownerUserID, "folder_id": folderID}, bson.M{"$set": data}, true)
+	}, func() error {
+		return c.incrVersion(ctx, ownerUserID)
+	})
+}
+
+func (c *ConversationFolderMgo) Delete(ctx context.Context, ownerUserID string, folderID string) error {
+	return mongoutil.IncrVersion(func() error {
+		return mongoutil.DeleteOne(ctx, c.coll, bson.M{"owner_user_id": ownerUserID, "folder_id": folderID})
+	}, func() error {
+		return c.incrVersion(ctx, ownerUserID)
+	})
+}
+
+func (c *ConversationFolderMgo) Take(ctx context.Context, ownerUserID string, folderID string) (*model.ConversationFolder, error) {
+	return mongoutil.FindOne[*model.ConversationFolder](ctx, c.coll, bson.M{"owner_user_id": ownerUserID, "folder_id": folderID})
+}
+
+func (c *ConversationFolderMgo) Find(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "sort", Value: 1}, {Key: "create_time", Value: 1}})
+	return mongoutil.Find[*model.ConversationFolder](ctx, c.coll, bson.M{"owner_user_id": ownerUserID}, opts)
+}
@@ -0,0 +1,69 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func slotsCursor(slots ...int) bson.D {
+	docs := make([]bson.D, 0, len(slots))
+	for _, slot := range slots {
+		docs = append(docs, bson.D{{Key: "slot", Value: slot}})
+	}
+	return mtest.CreateCursorResponse(0, "db.conversation_folder", mtest.FirstBatch, docs...)
+}
+
+func TestFreeFolderSlot(t *testing.T) {
+	assert.Equal(t, 0, freeFolderSlot(nil, 3))
+	assert.Equal(t, 1, freeFolderSlot([]int{0, 2}, 3))
+	assert.Equal(t, -1, freeFolderSlot([]int{2, 0, 1}, 3))
+}
+
+func TestConversationFolderCreate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("slot taken concurrently", func(mt *mtest.T) {
+		version := &fakeVersionLog{}
+		c := &ConversationFolderMgo{version: version, coll: mt.Coll}
+		mt.AddMockResponses(
+			slotsCursor(0),
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key"}),
+			slotsCursor(0, 1),
+			mtest.CreateSuccessResponse(),
+		)
+		folder := &model.ConversationFolder{FolderID: "f1", OwnerUserID: "u1"}
+		assert.NoError(t, c.Create(context.Background(), folder, 3))
+		assert.Equal(t, 2, folder.Slot)
+		assert.Equal(t, []string{"find", "insert", "find", "insert"}, startedCommands(mt))
+		assert.Equal(t, []versionChange{{dID: "u1", eIDs: []string{model.VersionFolderChangeID}, state: model.VersionStateUpdate}}, version.changes)
+	})
+
+	mt.Run("limit reached", func(mt *mtest.T) {
+		version := &fakeVersionLog{}
+		c := &ConversationFolderMgo{version: version, coll: mt.Coll}
+		mt.AddMockResponses(slotsCursor(0, 1))
+		err := c.Create(context.Background(), &model.ConversationFolder{FolderID: "f1", OwnerUserID: "u1"}, 2)
+		assert.Error(t, err)
+		assert.Equal(t, []string{"find"}, startedCommands(mt))
+		assert.Empty(t, version.changes)
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewFavoriteMongo(db *mongo.Database) (database.Favorite, error) {
+	coll := db.Collection(database.FavoriteName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "favorite_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	version, err := NewVersionLog(db.Collection(database.FavoriteVersionName))
+	if err != nil {
+		return nil, err
+	}
+	return &FavoriteMgo{version: version, coll: coll}, nil
+}
+
+type FavoriteMgo struct {
+	version database.VersionLog
+	coll    *mongo.Collection
+}
+
+func (f *FavoriteMgo) Create(ctx context.Context, favorite *model.Favorite) error {
+	return mongoutil.IncrVersion(func() error {
+		return mongoutil.InsertMany(ctx, f.coll, []*model.Favorite{favorite})
+	}, func() error {
+		return f.version.IncrVersion(ctx, favorite.UserID, []string{favorite.FavoriteID}, model.VersionStateInsert)
+	})
+}
+
+func (f *FavoriteMgo) Update(ctx context.Context, userID string, favoriteID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.IncrVersion(func() error {
+		return mongoutil.UpdateOne(ctx, f.coll, bson.M{"user_id": userID, "favorite_id": favoriteID}, bson.M{"$set": data}, true)
+	}, func() error {
+		return f.version.IncrVersion(ctx, userID, []string{favoriteID}, model.VersionStateUpdate)
+	})
+}
+
+func (f *FavoriteMgo) Delete(ctx context.Context, userID string, favoriteIDs []string) error {
+	if len(favoriteIDs) == 0 {
+		return nil
+	}
+	return mongoutil.IncrVersion(func() error {
+		return mongoutil.DeleteMany(ctx, f.coll, bson.M{"user_id": userID, "favorite_id": bson.M{"$in": favoriteIDs}})
+	}, func() error {
+		return f.version.IncrVersion(ctx, userID, favoriteIDs, model.VersionStateDelete)
+	})
+}
+
+func (f *FavoriteMgo) Take(ctx context.Context, userID string, favoriteID string) (*model.Favorite, error) {
+	return mongoutil.FindOne[*model.Favorite](ctx, f.coll, bson.M{"user_id": userID, "favorite_id": favoriteID})
+}
+
+func (f *FavoriteMgo) Find(ctx context.Context, userID string, favoriteIDs []string) ([]*model.Favorite, error) {
+	if len(favoriteIDs) == 0 {
+		return nil, nil
+	}
+	return mongoutil.Find[*model.Favorite](ctx, f.coll, bson.M{"user_id": userID, "favorite_id": bson.M{"$in": favoriteIDs}})
+}
+
+func (f *FavoriteMgo) FindFavoriteIDs(ctx context.Context, userID string) ([]string, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 0, "favorite_id": 1}).SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.Find[string](ctx, f.coll, bson.M{"user_id": userID}, opts)
+}
+
+// Search matches the keyword against the text of the message content and the note, and
+// requires every one of tags.
+func (f *FavoriteMgo) Search(ctx context.Context, userID string, keyword string, tags []string, pagination pagination.Pagination) (int64, []*model.Favorite, error) {
+	filter := bson.M{"user_id": userID}
+	if keyword != "" {
+		pattern := bson.M{"$regex": regexp.QuoteMeta(keyword), "$options": "i"}
+		filter["$or"] = bson.A{
+			bson.M{"msg.content": pattern},
+			bson.M{"note": pattern},
+		}
+	}
+	if len(tags) > 0 {
+		filter["tags"] = bson.M{"$all": tags}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*model.Favorite](ctx, f.coll, filter, pagination, opts)
+}
+
+func (f *FavoriteMgo) FindFavoriteVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error) {
+	return f.version.FindChangeLog(ctx, userID, version, limit)
+}
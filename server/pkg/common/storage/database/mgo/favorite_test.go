@@ -0,0 +1,75 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type versionChange struct {
+	dID   string
+	eIDs  []string
+	state int32
+}
+
+type fakeVersionLog struct {
+	database.VersionLog
+	changes []versionChange
+}
+
+func (f *fakeVersionLog) IncrVersion(ctx context.Context, dID string, eIDs []string, state int32) error {
+	f.changes = append(f.changes, versionChange{dID: dID, eIDs: eIDs, state: state})
+	return nil
+}
+
+func TestFavoriteVersion(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("delete is recorded", func(mt *mtest.T) {
+		version := &fakeVersionLog{}
+		f := &FavoriteMgo{version: version, coll: mt.Coll}
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}))
+		assert.NoError(t, f.Delete(context.Background(), "u1", []string{"f1", "f2"}))
+		assert.Equal(t, []string{"delete"}, startedCommands(mt))
+		assert.Equal(t, []versionChange{{dID: "u1", eIDs: []string{"f1", "f2"}, state: model.VersionStateDelete}}, version.changes)
+	})
+
+	mt.Run("failed delete is not recorded", func(mt *mtest.T) {
+		version := &fakeVersionLog{}
+		f := &FavoriteMgo{version: version, coll: mt.Coll}
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "fail"}))
+		assert.Error(t, f.Delete(context.Background(), "u1", []string{"f1"}))
+		assert.Empty(t, version.changes)
+	})
+
+	mt.Run("insert and update are recorded", func(mt *mtest.T) {
+		version := &fakeVersionLog{}
+		f := &FavoriteMgo{version: version, coll: mt.Coll}
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), updateReply(1))
+		assert.NoError(t, f.Create(context.Background(), &model.Favorite{FavoriteID: "f1", UserID: "u1"}))
+		assert.NoError(t, f.Update(context.Background(), "u1", "f1", map[string]any{"note": "n"}))
+		assert.Equal(t, []versionChange{
+			{dID: "u1", eIDs: []string{"f1"}, state: model.VersionStateInsert},
+			{dID: "u1", eIDs: []string{"f1"}, state: model.VersionStateUpdate},
+		}, version.changes)
+	})
+}
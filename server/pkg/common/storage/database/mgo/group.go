@@ -31,11 +31,18 @@ import (
 
 func NewGroupMongo(db *mongo.Database) (database.Group, error) {
 	coll := db.Collection(database.GroupName)
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "group_id", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "creator_user_id", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -85,6 +92,10 @@ func (g *GroupMgo) Search(ctx context.Context, keyword string, pagination pagina
 	}, pagination, opts)
 }
 
+func (g *GroupMgo) CountCreated(ctx context.Context, creatorUserID string) (count int64, err error) {
+	return mongoutil.Count(ctx, g.coll, bson.M{"creator_user_id": creatorUserID, "status": bson.M{"$ne": constant.GroupStatusDismissed}})
+}
+
 func (g *GroupMgo) CountTotal(ctx context.Context, before *time.Time) (count int64, err error) {
 	if before == nil {
 		return mongoutil.Count(ctx, g.coll, bson.M{})
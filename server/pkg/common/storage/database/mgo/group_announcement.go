@@ -0,0 +1,110 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupAnnouncementMgo(db *mongo.Database) (database.GroupAnnouncement, error) {
+	coll := db.Collection(database.GroupAnnouncementName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "announcement_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GroupAnnouncementMgo{coll: coll}, nil
+}
+
+type GroupAnnouncementMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *GroupAnnouncementMgo) Create(ctx context.Context, announcements []*model.GroupAnnouncement) error {
+	return mongoutil.InsertMany(ctx, g.coll, announcements)
+}
+
+func (g *GroupAnnouncementMgo) Take(ctx context.Context, groupID string, announcementID string) (*model.GroupAnnouncement, error) {
+	return mongoutil.FindOne[*model.GroupAnnouncement](ctx, g.coll, bson.M{"group_id": groupID, "announcement_id": announcementID})
+}
+
+func (g *GroupAnnouncementMgo) PageGroup(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupAnnouncement, error) {
+	return mongoutil.FindPage[*model.GroupAnnouncement](ctx, g.coll, bson.M{"group_id": groupID}, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+func NewGroupAnnouncementConfirmMgo(db *mongo.Database) (database.GroupAnnouncementConfirm, error) {
+	coll := db.Collection(database.GroupAnnouncementConfirmName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "announcement_id", Value: 1},
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GroupAnnouncementConfirmMgo{coll: coll}, nil
+}
+
+type GroupAnnouncementConfirmMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *GroupAnnouncementConfirmMgo) Confirm(ctx context.Context, confirm *model.GroupAnnouncementConfirm) error {
+	filter := bson.M{"announcement_id": confirm.AnnouncementID, "user_id": confirm.UserID}
+	return mongoutil.UpdateOne(ctx, g.coll, filter, bson.M{"$setOnInsert": confirm}, false, options.Update().SetUpsert(true))
+}
+
+func (g *GroupAnnouncementConfirmMgo) Find(ctx context.Context, announcementID string) ([]*model.GroupAnnouncementConfirm, error) {
+	return mongoutil.Find[*model.GroupAnnouncementConfirm](ctx, g.coll, bson.M{"announcement_id": announcementID}, options.Find().SetSort(bson.M{"confirm_time": 1}))
+}
+
+func (g *GroupAnnouncementConfirmMgo) FindConfirmedIDs(ctx context.Context, userID string, announcementIDs []string) ([]string, error) {
+	if len(announcementIDs) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{"user_id": userID, "announcement_id": bson.M{"$in": announcementIDs}}
+	return mongoutil.Find[string](ctx, g.coll, filter, options.Find().SetProjection(bson.M{"_id": 0, "announcement_id": 1}))
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupImportJobMgo(db *mongo.Database) (database.GroupImportJob, error) {
+	coll := db.Collection(database.GroupImportJobName)
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "job_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GroupImportJobMgo{coll: coll}, nil
+}
+
+type GroupImportJobMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *GroupImportJobMgo) Create(ctx context.Context, job *model.GroupImportJob) error {
+	return mongoutil.InsertMany(ctx, g.coll, []*model.GroupImportJob{job})
+}
+
+func (g *GroupImportJobMgo) Take(ctx context.Context, jobID string) (*model.GroupImportJob, error) {
+	return mongoutil.FindOne[*model.GroupImportJob](ctx, g.coll, bson.M{"job_id": jobID})
+}
+
+func (g *GroupImportJobMgo) Update(ctx context.Context, jobID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, g.coll, bson.M{"job_id": jobID}, bson.M{"$set": data}, true)
+}
+
+func (g *GroupImportJobMgo) TakeExpired(ctx context.Context, now time.Time, leaseExpire time.Time) (*model.GroupImportJob, error) {
+	// jobs started before leases were introduced have none
+	filter := bson.M{"status": constant.GroupImportJobRunning, "$or": bson.A{
+		bson.M{"lease_expire": bson.M{"$lt": now}},
+		bson.M{"lease_expire": bson.M{"$exists": false}},
+	}}
+	update := bson.M{"$set": bson.M{"lease_expire": leaseExpire, "update_time": now}, "$inc": bson.M{"attempts": 1}}
+	opt := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	job, err := mongoutil.FindOneAndUpdate[*model.GroupImportJob](ctx, g.coll, filter, update, opt)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupInviteMgo(db *mongo.Database) (database.GroupInvite, error) {
+	coll := db.Collection(database.GroupInviteName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "token", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GroupInviteMgo{coll: coll}, nil
+}
+
+type GroupInviteMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *GroupInviteMgo) Create(ctx context.Context, invites []*model.GroupInvite) error {
+	return mongoutil.InsertMany(ctx, g.coll, invites)
+}
+
+func (g *GroupInviteMgo) Take(ctx context.Context, token string) (*model.GroupInvite, error) {
+	return mongoutil.FindOne[*model.GroupInvite](ctx, g.coll, bson.M{"token": token})
+}
+
+func (g *GroupInviteMgo) PageGroup(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupInvite, error) {
+	return mongoutil.FindPage[*model.GroupInvite](ctx, g.coll, bson.M{"group_id": groupID}, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+func (g *GroupInviteMgo) Revoke(ctx context.Context, groupID string, token string) error {
+	return mongoutil.UpdateOne(ctx, g.coll, bson.M{"group_id": groupID, "token": token}, bson.M{"$set": bson.M{"revoked": true}}, true)
+}
+
+func (g *GroupInviteMgo) IncrUsedCount(ctx context.Context, token string, now time.Time) error {
+	filter := bson.M{
+		"token":   token,
+		"revoked": false,
+		"$and": []bson.M{
+			{"$or": []bson.M{
+				{"max_uses": bson.M{"$lte": 0}},
+				{"$expr": bson.M{"$lt": []string{"$used_count", "$max_uses"}}},
+			}},
+			{"$or": []bson.M{
+				{"expire_time": bson.M{"$lte": time.UnixMilli(0)}},
+				{"expire_time": bson.M{"$gt": now}},
+			}},
+		},
+	}
+	return mongoutil.UpdateOne(ctx, g.coll, filter, bson.M{"$inc": bson.M{"used_count": 1}}, true)
+}
@@ -42,6 +42,13 @@ func NewGroupMember(db *mongo.Database) (database.GroupMember, error) {
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
+	// admins stored before the permission bitmask existed keep every permission
+	_, err = coll.UpdateMany(context.Background(),
+		bson.M{"role_level": constant.GroupAdmin, "permissions": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"permissions": constant.GroupPermissionAll}})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
 	member, err := NewVersionLog(db.Collection(database.GroupMemberVersionName))
 	if err != nil {
 		return nil, err
@@ -117,7 +124,7 @@ func (g *GroupMemberMgo) Delete(ctx context.Context, groupID string, userIDs []s
 func (g *GroupMemberMgo) UpdateRoleLevel(ctx context.Context, groupID string, userID string, roleLevel int32) error {
 	return mongoutil.IncrVersion(func() error {
 		return mongoutil.UpdateOne(ctx, g.coll, bson.M{"group_id": groupID, "user_id": userID},
-			bson.M{"$set": bson.M{"role_level": roleLevel}}, true)
+			bson.M{"$set": bson.M{"role_level": roleLevel, "permissions": model.DefaultGroupPermissions(roleLevel)}}, true)
 	}, func() error {
 		return g.member.IncrVersion(ctx, groupID, []string{model.VersionSortChangeID, userID}, model.VersionStateUpdate)
 	})
@@ -125,11 +132,11 @@ func (g *GroupMemberMgo) UpdateRoleLevel(ctx context.Context, groupID string, us
 func (g *GroupMemberMgo) UpdateUserRoleLevels(ctx context.Context, groupID string, firstUserID string, firstUserRoleLevel int32, secondUserID string, secondUserRoleLevel int32) error {
 	return mongoutil.IncrVersion(func() error {
 		if err := mongoutil.UpdateOne(ctx, g.coll, bson.M{"group_id": groupID, "user_id": firstUserID},
-			bson.M{"$set": bson.M{"role_level": firstUserRoleLevel}}, true); err != nil {
+			bson.M{"$set": bson.M{"role_level": firstUserRoleLevel, "permissions": model.DefaultGroupPermissions(firstUserRoleLevel)}}, true); err != nil {
 			return err
 		}
 		if err := mongoutil.UpdateOne(ctx, g.coll, bson.M{"group_id": groupID, "user_id": secondUserID},
-			bson.M{"$set": bson.M{"role_level": secondUserRoleLevel}}, true); err != nil {
+			bson.M{"$set": bson.M{"role_level": secondUserRoleLevel, "permissions": model.DefaultGroupPermissions(secondUserRoleLevel)}}, true); err != nil {
 			return err
 		}
 		return nil
@@ -3,6 +3,8 @@ package mgo
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
@@ -20,6 +22,17 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// NewArchivedMsgMongo is like NewMsgMongo, but reads by seq fall back to loader
+// for docs that were moved to the cold archive.
+func NewArchivedMsgMongo(db *mongo.Database, loader database.MsgDocLoader) (database.Msg, error) {
+	msg, err := NewMsgMongo(db)
+	if err != nil {
+		return nil, err
+	}
+	msg.(*MsgMgo).archive = loader
+	return msg, nil
+}
+
 func NewMsgMongo(db *mongo.Database) (database.Msg, error) {
 	coll := db.Collection(new(model.MsgDocModel).TableName())
 	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
@@ -35,8 +48,9 @@ func NewMsgMongo(db *mongo.Database) (database.Msg, error) {
 }
 
 type MsgMgo struct {
-	coll  *mongo.Collection
-	model model.MsgDocModel
+	coll    *mongo.Collection
+	model   model.MsgDocModel
+	archive database.MsgDocLoader
 }
 
 func (m *MsgMgo) Create(ctx context.Context, msg *model.MsgDocModel) error {
@@ -50,9 +64,7 @@ func (m *MsgMgo) UpdateMsg(ctx context.Context, docID string, index int64, key s
 	} else {
 		field = fmt.Sprintf("msgs.%d.%s", index, key)
 	}
-	filter := bson.M{"doc_id": docID}
-	update := bson.M{"$set": bson.M{field: value}}
-	return mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
+	return m.updateDoc(ctx, docID, bson.M{"$set": bson.M{field: value}})
 }
 
 func (m *MsgMgo) PushUnique(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error) {
@@ -62,17 +74,64 @@ func (m *MsgMgo) PushUnique(ctx context.Context, docID string, index int64, key
 	} else {
 		field = fmt.Sprintf("msgs.%d.%s", index, key)
 	}
-	filter := bson.M{"doc_id": docID}
 	update := bson.M{
 		"$addToSet": bson.M{
 			field: bson.M{"$each": value},
 		},
 	}
+	return m.updateDoc(ctx, docID, update)
+}
+
+// updateDoc applies update to the doc and counts the write in its rev. A doc that was moved
+// to the cold archive is restored first, so the write is not lost.
+func (m *MsgMgo) updateDoc(ctx context.Context, docID string, update bson.M) (*mongo.UpdateResult, error) {
+	filter := bson.M{"doc_id": docID}
+	update["$inc"] = bson.M{"rev": 1}
+	res, err := mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
+	if err != nil || res.MatchedCount > 0 {
+		return res, err
+	}
+	restored, err := m.restoreArchivedDoc(ctx, docID)
+	if err != nil || !restored {
+		return res, err
+	}
 	return mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
 }
 
+// restoreArchivedDoc copies an archived doc back into the msg collection. It returns false if
+// the doc is not archived. The archive is kept, archiving the doc again replaces it.
+func (m *MsgMgo) restoreArchivedDoc(ctx context.Context, docID string) (bool, error) {
+	if m.archive == nil {
+		return false, nil
+	}
+	doc, err := m.archive.LoadMsgDoc(ctx, docID)
+	if err != nil || doc == nil {
+		return false, err
+	}
+	if err := mongoutil.InsertMany(ctx, m.coll, []*model.MsgDocModel{doc}); err != nil && !mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+		return false, err
+	}
+	return true, nil
+}
+
 func (m *MsgMgo) FindOneByDocID(ctx context.Context, docID string) (*model.MsgDocModel, error) {
-	return mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll, bson.M{"doc_id": docID})
+	doc, err := mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll, bson.M{"doc_id": docID})
+	if err == nil || m.archive == nil || !IsNotFound(err) {
+		return doc, err
+	}
+	archived, loadErr := m.archive.LoadMsgDoc(ctx, docID)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	if archived == nil {
+		return nil, err
+	}
+	// the loaded doc is shared through the cache, callers rewrite the returned msgs
+	doc = &model.MsgDocModel{DocID: archived.DocID, Msg: make([]*model.MsgInfoModel, 0, len(archived.Msg)), Rev: archived.Rev}
+	for _, info := range archived.Msg {
+		doc.Msg = append(doc.Msg, cloneMsgInfo(info))
+	}
+	return doc, nil
 }
 
 func (m *MsgMgo) GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*model.MsgInfoModel, error) {
@@ -126,7 +185,14 @@ func (m *MsgMgo) getMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID strin
 		return nil, err
 	}
 	if len(msgDocModel) == 0 {
-		return nil, errs.Wrap(mongo.ErrNoDocuments)
+		doc, err := m.loadArchivedDoc(ctx, docID, indexes)
+		if err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			return nil, errs.Wrap(mongo.ErrNoDocuments)
+		}
+		msgDocModel = append(msgDocModel, doc)
 	}
 	msgs := make([]*model.MsgInfoModel, 0, len(msgDocModel[0].Msg))
 	for i := range msgDocModel[0].Msg {
@@ -224,7 +290,7 @@ func (m *MsgMgo) DeleteMsgsInOneDocByIndex(ctx context.Context, docID string, in
 			"msg": nil,
 		}
 	}
-	_, err := mongoutil.UpdateMany(ctx, m.coll, bson.M{"doc_id": docID}, update)
+	_, err := m.updateDoc(ctx, docID, update)
 	return err
 }
 
@@ -241,12 +307,23 @@ func (m *MsgMgo) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, do
 			"$set": bson.M{
 				fmt.Sprintf("msgs.%d.is_read", index): true,
 			},
+			"$inc": bson.M{"rev": 1},
 		}
 		updateModel := mongo.NewUpdateManyModel().
 			SetFilter(filter).
 			SetUpdate(update)
 		updates = append(updates, updateModel)
 	}
+	res, err := m.coll.BulkWrite(ctx, updates)
+	if err != nil {
+		return errs.WrapMsg(err, fmt.Sprintf("docID is %s, indexes is %v", docID, indexes))
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+	if restored, err := m.restoreArchivedDoc(ctx, docID); err != nil || !restored {
+		return err
+	}
 	if _, err := m.coll.BulkWrite(ctx, updates); err != nil {
 		return errs.WrapMsg(err, fmt.Sprintf("docID is %s, indexes is %v", docID, indexes))
 	}
@@ -910,21 +987,28 @@ func (m *MsgMgo) RangeGroupSendCount(ctx context.Context, start time.Time, end t
 	return result[0].MsgCount, result[0].UserCount, groups, dateCount, nil
 }
 
-func (m *MsgMgo) GetRandBeforeMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error) {
-	return mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll, []bson.M{
-		{
-			"$match": bson.M{
-				"msgs": bson.M{
-					"$not": bson.M{
-						"$elemMatch": bson.M{
-							"msg.send_time": bson.M{
-								"$gt": ts,
-							},
-						},
+func (m *MsgMgo) GetRandBeforeMsg(ctx context.Context, ts int64, limit int, include []string, exclude []string) ([]*model.MsgDocModel, error) {
+	match := bson.M{
+		"msgs": bson.M{
+			"$not": bson.M{
+				"$elemMatch": bson.M{
+					"msg.send_time": bson.M{
+						"$gt": ts,
 					},
 				},
 			},
 		},
+	}
+	if len(include) > 0 {
+		match["$or"] = docIDRegex(include)
+	}
+	if len(exclude) > 0 {
+		match["$nor"] = docIDRegex(exclude)
+	}
+	return mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll, []bson.M{
+		{
+			"$match": match,
+		},
 		{
 			"$project": bson.M{
 				"_id":                0,
@@ -941,10 +1025,48 @@ func (m *MsgMgo) GetRandBeforeMsg(ctx context.Context, ts int64, limit int) ([]*
 	})
 }
 
+func docIDRegex(patterns []string) []bson.M {
+	res := make([]bson.M, 0, len(patterns))
+	for _, pattern := range patterns {
+		res = append(res, bson.M{"doc_id": bson.M{"$regex": pattern}})
+	}
+	return res
+}
+
+func (m *MsgMgo) GetFullDocsBefore(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error) {
+	filter := bson.M{
+		fmt.Sprintf("msgs.%d.msg", m.model.GetSingleGocMsgNum()-1): bson.M{"$ne": nil},
+		"msgs": bson.M{
+			"$not": bson.M{
+				"$elemMatch": bson.M{
+					"msg.send_time": bson.M{
+						"$gt": ts,
+					},
+				},
+			},
+		},
+	}
+	return mongoutil.Find[*model.MsgDocModel](ctx, m.coll, filter, options.Find().SetLimit(int64(limit)))
+}
+
 func (m *MsgMgo) DeleteDoc(ctx context.Context, docID string) error {
 	return mongoutil.DeleteOne(ctx, m.coll, bson.M{"doc_id": docID})
 }
 
+func (m *MsgMgo) DeleteUnchangedDoc(ctx context.Context, doc *model.MsgDocModel) (bool, error) {
+	filter := bson.M{"doc_id": doc.DocID}
+	if doc.Rev == 0 {
+		filter["rev"] = bson.M{"$exists": false}
+	} else {
+		filter["rev"] = doc.Rev
+	}
+	res, err := mongoutil.DeleteOneResult(ctx, m.coll, filter)
+	if err != nil {
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
 func (m *MsgMgo) GetLastMessageSeqByTime(ctx context.Context, conversationID string, time int64) (int64, error) {
 	pipeline := []bson.M{
 		{
@@ -1086,11 +1208,61 @@ func (m *MsgMgo) onlyFindDocIndex(ctx context.Context, docID string, indexes []i
 		return nil, err
 	}
 	if len(msgDocModel) == 0 {
-		return nil, nil
+		doc, err := m.loadArchivedDoc(ctx, docID, indexes)
+		if err != nil || doc == nil {
+			return nil, err
+		}
+		return doc.Msg, nil
 	}
 	return msgDocModel[0].Msg, nil
 }
 
+// loadArchivedDoc returns the messages at indexes of an archived doc, shaped like the
+// $arrayElemAt projection of the live doc. It returns nil if the doc is not archived.
+func (m *MsgMgo) loadArchivedDoc(ctx context.Context, docID string, indexes []int64) (*model.MsgDocModel, error) {
+	if m.archive == nil {
+		return nil, nil
+	}
+	doc, err := m.archive.LoadMsgDoc(ctx, docID)
+	if err != nil || doc == nil {
+		return nil, err
+	}
+	res := &model.MsgDocModel{DocID: docID, Msg: make([]*model.MsgInfoModel, 0, len(indexes))}
+	for _, index := range indexes {
+		if index < 0 || index >= int64(len(doc.Msg)) || doc.Msg[index] == nil {
+			res.Msg = append(res.Msg, nil)
+			continue
+		}
+		// the loaded doc is shared through the cache, callers rewrite the returned msgs
+		res.Msg = append(res.Msg, cloneMsgInfo(doc.Msg[index]))
+	}
+	return res, nil
+}
+
+// cloneMsgInfo copies a message of an archived doc down to its slices and maps.
+func cloneMsgInfo(info *model.MsgInfoModel) *model.MsgInfoModel {
+	if info == nil {
+		return nil
+	}
+	res := *info
+	res.DelList = slices.Clone(info.DelList)
+	if info.Revoke != nil {
+		revoke := *info.Revoke
+		res.Revoke = &revoke
+	}
+	if info.Msg != nil {
+		data := *info.Msg
+		data.Options = maps.Clone(info.Msg.Options)
+		data.AtUserIDList = slices.Clone(info.Msg.AtUserIDList)
+		if info.Msg.OfflinePush != nil {
+			push := *info.Msg.OfflinePush
+			data.OfflinePush = &push
+		}
+		res.Msg = &data
+	}
+	return &res
+}
+
 //func (m *MsgMgo) FindSeqs(ctx context.Context, conversationID string, seqs []int64) ([]*model.MsgInfoModel, error) {
 //	if len(seqs) == 0 {
 //		return nil, nil
@@ -0,0 +1,83 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMsgArchiveMgo(db *mongo.Database) (database.MsgArchive, error) {
+	coll := db.Collection(database.MsgArchiveName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "doc_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "max_send_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MsgArchiveMgo{coll: coll}, nil
+}
+
+type MsgArchiveMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgArchiveMgo) Set(ctx context.Context, archive *model.MsgArchive) error {
+	opt := options.Replace().SetUpsert(true)
+	if _, err := m.coll.ReplaceOne(ctx, bson.M{"doc_id": archive.DocID}, archive, opt); err != nil {
+		return errs.WrapMsg(err, "mongo replace one")
+	}
+	return nil
+}
+
+func (m *MsgArchiveMgo) Take(ctx context.Context, docID string) (*model.MsgArchive, error) {
+	return mongoutil.FindOne[*model.MsgArchive](ctx, m.coll, bson.M{"doc_id": docID})
+}
+
+func (m *MsgArchiveMgo) Delete(ctx context.Context, docID string) error {
+	return mongoutil.DeleteOne(ctx, m.coll, bson.M{"doc_id": docID})
+}
+
+func (m *MsgArchiveMgo) GetRandBefore(ctx context.Context, ts int64, limit int, include []string, exclude []string) ([]*model.MsgArchive, error) {
+	match := bson.M{"max_send_time": bson.M{"$lte": ts}}
+	if len(include) > 0 {
+		match["$or"] = docIDRegex(include)
+	}
+	if len(exclude) > 0 {
+		match["$nor"] = docIDRegex(exclude)
+	}
+	return mongoutil.Aggregate[*model.MsgArchive](ctx, m.coll, []bson.M{
+		{"$match": match},
+		{"$sample": bson.M{"size": limit}},
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type fakeMsgDocLoader struct {
+	docs  map[string]*model.MsgDocModel
+	loads int
+}
+
+func (f *fakeMsgDocLoader) LoadMsgDoc(ctx context.Context, docID string) (*model.MsgDocModel, error) {
+	f.loads++
+	return f.docs[docID], nil
+}
+
+func archivedMsgDoc(docID string) *model.MsgDocModel {
+	return &model.MsgDocModel{
+		DocID: docID,
+		Msg: []*model.MsgInfoModel{{
+			Msg:     &model.MsgDataModel{Seq: 1, Content: "archived", AtUserIDList: []string{"u1"}},
+			DelList: []string{"u2"},
+		}},
+		Rev: 2,
+	}
+}
+
+func updateReply(n int32) bson.D {
+	return mtest.CreateSuccessResponse(bson.E{Key: "n", Value: n}, bson.E{Key: "nModified", Value: n})
+}
+
+func startedCommands(mt *mtest.T) []string {
+	var names []string
+	for _, event := range mt.GetAllStartedEvents() {
+		names = append(names, event.CommandName)
+	}
+	return names
+}
+
+func TestMsgArchive(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("read back archived doc", func(mt *mtest.T) {
+		loader := &fakeMsgDocLoader{docs: map[string]*model.MsgDocModel{"si_a_b:0": archivedMsgDoc("si_a_b:0")}}
+		m := &MsgMgo{coll: mt.Coll, archive: loader}
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "db.msg", mtest.FirstBatch))
+		doc, err := m.FindOneByDocID(context.Background(), "si_a_b:0")
+		assert.NoError(t, err)
+		assert.Equal(t, "archived", doc.Msg[0].Msg.Content)
+		// the loaded doc is shared through the cache and stays as archived
+		doc.Msg[0].Msg.Content = "rewritten"
+		doc.Msg[0].Msg.AtUserIDList[0] = "rewritten"
+		doc.Msg[0].DelList = append(doc.Msg[0].DelList[:0], "rewritten")
+		archived := loader.docs["si_a_b:0"]
+		assert.Equal(t, "archived", archived.Msg[0].Msg.Content)
+		assert.Equal(t, []string{"u1"}, archived.Msg[0].Msg.AtUserIDList)
+		assert.Equal(t, []string{"u2"}, archived.Msg[0].DelList)
+	})
+
+	mt.Run("write after archive restores the doc", func(mt *mtest.T) {
+		loader := &fakeMsgDocLoader{docs: map[string]*model.MsgDocModel{"si_a_b:0": archivedMsgDoc("si_a_b:0")}}
+		m := &MsgMgo{coll: mt.Coll, archive: loader}
+		mt.AddMockResponses(updateReply(0), mtest.CreateSuccessResponse(), updateReply(1))
+		res, err := m.UpdateMsg(context.Background(), "si_a_b:0", 0, "is_read", true)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), res.MatchedCount)
+		assert.Equal(t, 1, loader.loads)
+		assert.Equal(t, []string{"update", "insert", "update"}, startedCommands(mt))
+		update := mt.GetAllStartedEvents()[2].Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("u").Document()
+		assert.Equal(t, int32(1), update.Lookup("$inc", "rev").Int32())
+	})
+
+	mt.Run("write after archive races another restore", func(mt *mtest.T) {
+		loader := &fakeMsgDocLoader{docs: map[string]*model.MsgDocModel{"si_a_b:0": archivedMsgDoc("si_a_b:0")}}
+		m := &MsgMgo{coll: mt.Coll, archive: loader}
+		duplicate := mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key"})
+		mt.AddMockResponses(updateReply(0), duplicate, updateReply(1))
+		assert.NoError(t, m.MarkSingleChatMsgsAsRead(context.Background(), "a", "si_a_b:0", []int64{0}))
+		assert.Equal(t, []string{"update", "insert", "update"}, startedCommands(mt))
+	})
+
+	mt.Run("write to a missing doc", func(mt *mtest.T) {
+		loader := &fakeMsgDocLoader{}
+		m := &MsgMgo{coll: mt.Coll, archive: loader}
+		mt.AddMockResponses(updateReply(0))
+		res, err := m.UpdateMsg(context.Background(), "si_a_b:1", 0, "", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), res.MatchedCount)
+		assert.Equal(t, []string{"update"}, startedCommands(mt))
+	})
+
+	mt.Run("delete skips a doc written while archiving", func(mt *mtest.T) {
+		m := &MsgMgo{coll: mt.Coll}
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}))
+		deleted, err := m.DeleteUnchangedDoc(context.Background(), archivedMsgDoc("si_a_b:0"))
+		assert.NoError(t, err)
+		assert.False(t, deleted)
+		filter := mt.GetStartedEvent().Command.Lookup("deletes").Array().Index(0).Value().Document().Lookup("q").Document()
+		assert.Equal(t, int64(2), filter.Lookup("rev").Int64())
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+		deleted, err = m.DeleteUnchangedDoc(context.Background(), &model.MsgDocModel{DocID: "si_a_b:0"})
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+		filter = mt.GetStartedEvent().Command.Lookup("deletes").Array().Index(0).Value().Document().Lookup("q").Document()
+		assert.False(t, filter.Lookup("rev", "$exists").Boolean())
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMsgRetentionPolicyMgo(db *mongo.Database) (database.MsgRetentionPolicy, error) {
+	coll := db.Collection(database.MsgRetentionPolicyName)
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "policy_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MsgRetentionPolicyMgo{coll: coll}, nil
+}
+
+type MsgRetentionPolicyMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgRetentionPolicyMgo) Create(ctx context.Context, policy *model.MsgRetentionPolicy) error {
+	return mongoutil.InsertMany(ctx, m.coll, []*model.MsgRetentionPolicy{policy})
+}
+
+func (m *MsgRetentionPolicyMgo) Update(ctx context.Context, policyID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, m.coll, bson.M{"policy_id": policyID}, bson.M{"$set": data}, true)
+}
+
+func (m *MsgRetentionPolicyMgo) Delete(ctx context.Context, policyIDs []string) error {
+	if len(policyIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, m.coll, bson.M{"policy_id": bson.M{"$in": policyIDs}})
+}
+
+func (m *MsgRetentionPolicyMgo) Take(ctx context.Context, policyID string) (*model.MsgRetentionPolicy, error) {
+	return mongoutil.FindOne[*model.MsgRetentionPolicy](ctx, m.coll, bson.M{"policy_id": policyID})
+}
+
+func (m *MsgRetentionPolicyMgo) FindAll(ctx context.Context) ([]*model.MsgRetentionPolicy, error) {
+	return mongoutil.Find[*model.MsgRetentionPolicy](ctx, m.coll, bson.M{})
+}
+
+func (m *MsgRetentionPolicyMgo) Page(ctx context.Context, scope int32, pagination pagination.Pagination) (int64, []*model.MsgRetentionPolicy, error) {
+	filter := bson.M{}
+	if scope != 0 {
+		filter["scope"] = scope
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*model.MsgRetentionPolicy](ctx, m.coll, filter, pagination, opts)
+}
@@ -71,8 +71,27 @@ type S3Mongo struct {
 
 func (o *S3Mongo) SetObject(ctx context.Context, obj *model.Object) error {
 	filter := bson.M{"name": obj.Name, "engine": obj.Engine}
-	update := bson.M{
+	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": objectUpdate(obj)}, false, options.Update().SetUpsert(true))
+}
+
+func (o *S3Mongo) SwapObject(ctx context.Context, obj *model.Object) (*model.Object, error) {
+	filter := bson.M{"name": obj.Name, "engine": obj.Engine}
+	opt := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)
+	old, err := mongoutil.FindOneAndUpdate[*model.Object](ctx, o.coll, filter, bson.M{"$set": objectUpdate(obj)}, opt)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return old, nil
+}
+
+func objectUpdate(obj *model.Object) bson.M {
+	return bson.M{
 		"name":         obj.Name,
+		"user_id":      obj.UserID,
+		"hash":         obj.Hash,
 		"engine":       obj.Engine,
 		"key":          obj.Key,
 		"size":         obj.Size,
@@ -80,7 +99,6 @@ func (o *S3Mongo) SetObject(ctx context.Context, obj *model.Object) error {
 		"group":        obj.Group,
 		"create_time":  obj.CreateTime,
 	}
-	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": update}, false, options.Update().SetUpsert(true))
 }
 
 func (o *S3Mongo) Take(ctx context.Context, engine string, name string) (*model.Object, error) {
@@ -97,16 +115,23 @@ func (o *S3Mongo) Delete(ctx context.Context, engine string, name []string) erro
 	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"engine": engine, "name": bson.M{"$in": name}})
 }
 
-func (o *S3Mongo) FindExpirationObject(ctx context.Context, engine string, expiration time.Time, needDelType []string, count int64) ([]*model.Object, error) {
+func (o *S3Mongo) FindExpirationObject(ctx context.Context, engine string, expiration time.Time, needDelType []string, excludeUserIDs []string, excludeNames []string, count int64) ([]*model.Object, error) {
 	opt := options.Find()
 	if count > 0 {
 		opt.SetLimit(count)
 	}
-	return mongoutil.Find[*model.Object](ctx, o.coll, bson.M{
+	filter := bson.M{
 		"engine":      engine,
 		"create_time": bson.M{"$lt": expiration},
 		"group":       bson.M{"$in": needDelType},
-	}, opt)
+	}
+	if len(excludeUserIDs) > 0 {
+		filter["user_id"] = bson.M{"$nin": excludeUserIDs}
+	}
+	if len(excludeNames) > 0 {
+		filter["name"] = bson.M{"$nin": excludeNames}
+	}
+	return mongoutil.Find[*model.Object](ctx, o.coll, filter, opt)
 }
 
 func (o *S3Mongo) GetKeyCount(ctx context.Context, engine string, key string) (int64, error) {
@@ -0,0 +1,122 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewObjectBlobMongo(db *mongo.Database) (database.ObjectBlob, error) {
+	coll := db.Collection(database.ObjectBlobName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "engine", Value: 1},
+				{Key: "key", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "engine", Value: 1},
+				{Key: "hash", Value: 1},
+				{Key: "size", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "engine", Value: 1},
+				{Key: "ref_count", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ObjectBlobMongo{coll: coll}, nil
+}
+
+type ObjectBlobMongo struct {
+	coll *mongo.Collection
+}
+
+func (o *ObjectBlobMongo) Create(ctx context.Context, blob *model.ObjectBlob) error {
+	filter := bson.M{"engine": blob.Engine, "key": blob.Key}
+	update := bson.M{"$setOnInsert": blob}
+	if err := mongoutil.UpdateOne(ctx, o.coll, filter, update, false, options.Update().SetUpsert(true)); err != nil {
+		// a concurrent insert of the same key
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (o *ObjectBlobMongo) TakeHash(ctx context.Context, engine string, hash string, size int64) (*model.ObjectBlob, error) {
+	filter := bson.M{
+		"engine":    engine,
+		"hash":      hash,
+		"size":      size,
+		"ref_count": bson.M{"$gt": 0},
+		"deleting":  false,
+	}
+	return mongoutil.FindOne[*model.ObjectBlob](ctx, o.coll, filter)
+}
+
+func (o *ObjectBlobMongo) IncrRef(ctx context.Context, engine string, key string, delta int64) (bool, error) {
+	filter := bson.M{"engine": engine, "key": key, "deleting": false}
+	update := bson.M{
+		"$inc": bson.M{"ref_count": delta},
+		"$set": bson.M{"update_time": time.Now()},
+	}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (o *ObjectBlobMongo) FindUnreferenced(ctx context.Context, engine string, count int64) ([]*model.ObjectBlob, error) {
+	opt := options.Find()
+	if count > 0 {
+		opt.SetLimit(count)
+	}
+	return mongoutil.Find[*model.ObjectBlob](ctx, o.coll, bson.M{"engine": engine, "ref_count": bson.M{"$lte": 0}}, opt)
+}
+
+func (o *ObjectBlobMongo) MarkDeleting(ctx context.Context, engine string, key string) (bool, error) {
+	filter := bson.M{"engine": engine, "key": key, "ref_count": bson.M{"$lte": 0}}
+	update := bson.M{"$set": bson.M{"deleting": true, "update_time": time.Now()}}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (o *ObjectBlobMongo) Delete(ctx context.Context, engine string, key string) error {
+	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"engine": engine, "key": key, "deleting": true})
+}
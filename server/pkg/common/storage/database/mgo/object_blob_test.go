@@ -0,0 +1,100 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// sentFilter returns the filter of the first update or delete the test sent.
+func sentFilter(mt *mtest.T, array string, field string) bson.Raw {
+	return mt.GetStartedEvent().Command.Lookup(array).Array().Index(0).Value().Document().Lookup(field).Document()
+}
+
+func TestObjectBlob(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("create ignores an existing key", func(mt *mtest.T) {
+		o := &ObjectBlobMongo{coll: mt.Coll}
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Code: 11000, Message: "duplicate key"}))
+		err := o.Create(context.Background(), &model.ObjectBlob{Engine: "minio", Key: "k", RefCount: 1})
+		assert.NoError(t, err)
+	})
+
+	mt.Run("incr ref skips blobs being deleted", func(mt *mtest.T) {
+		o := &ObjectBlobMongo{coll: mt.Coll}
+		mt.AddMockResponses(updateReply(0))
+		ok, err := o.IncrRef(context.Background(), "minio", "k", 1)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		filter := sentFilter(mt, "updates", "q")
+		assert.False(t, filter.Lookup("deleting").Boolean())
+		assert.Equal(t, "k", filter.Lookup("key").StringValue())
+
+		mt.AddMockResponses(updateReply(1))
+		ok, err = o.IncrRef(context.Background(), "minio", "k", 1)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	mt.Run("mark deleting only unreferenced blobs", func(mt *mtest.T) {
+		o := &ObjectBlobMongo{coll: mt.Coll}
+		mt.AddMockResponses(updateReply(0))
+		ok, err := o.MarkDeleting(context.Background(), "minio", "k")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, int32(0), sentFilter(mt, "updates", "q").Lookup("ref_count", "$lte").Int32())
+	})
+
+	mt.Run("delete only blobs being deleted", func(mt *mtest.T) {
+		o := &ObjectBlobMongo{coll: mt.Coll}
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+		assert.NoError(t, o.Delete(context.Background(), "minio", "k"))
+		assert.True(t, sentFilter(mt, "deletes", "q").Lookup("deleting").Boolean())
+	})
+}
+
+func TestSwapObject(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("new name", func(mt *mtest.T) {
+		o := &S3Mongo{coll: mt.Coll}
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}))
+		old, err := o.SwapObject(context.Background(), &model.Object{Engine: "minio", Name: "a", Key: "k2"})
+		assert.NoError(t, err)
+		assert.Nil(t, old)
+		cmd := mt.GetStartedEvent().Command
+		assert.True(t, cmd.Lookup("upsert").Boolean())
+		assert.False(t, cmd.Lookup("new").Boolean())
+	})
+
+	mt.Run("replaced name", func(mt *mtest.T) {
+		o := &S3Mongo{coll: mt.Coll}
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: bson.D{
+			{Key: "engine", Value: "minio"}, {Key: "name", Value: "a"}, {Key: "key", Value: "k1"},
+		}}))
+		old, err := o.SwapObject(context.Background(), &model.Object{Engine: "minio", Name: "a", Key: "k2"})
+		assert.NoError(t, err)
+		if assert.NotNil(t, old) {
+			assert.Equal(t, "k1", old.Key)
+		}
+	})
+}
@@ -37,7 +37,13 @@ type Msg interface {
 	RangeUserSendCount(ctx context.Context, start time.Time, end time.Time, group bool, ase bool, pageNumber int32, showNumber int32) (msgCount int64, userCount int64, users []*model.UserCount, dateCount map[string]int64, err error)
 	RangeGroupSendCount(ctx context.Context, start time.Time, end time.Time, ase bool, pageNumber int32, showNumber int32) (msgCount int64, userCount int64, groups []*model.GroupCount, dateCount map[string]int64, err error)
 	DeleteDoc(ctx context.Context, docID string) error
-	GetRandBeforeMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error)
+	// DeleteUnchangedDoc deletes doc only if it was not written since it was read, it returns false otherwise.
+	DeleteUnchangedDoc(ctx context.Context, doc *model.MsgDocModel) (bool, error)
+	// GetRandBeforeMsg samples docs whose messages were all sent at or before ts.
+	// Non-empty include/exclude are doc_id regexes the docs must / must not match.
+	GetRandBeforeMsg(ctx context.Context, ts int64, limit int, include []string, exclude []string) ([]*model.MsgDocModel, error)
+	// GetFullDocsBefore returns docs with every slot filled whose messages were all sent at or before ts.
+	GetFullDocsBefore(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error)
 	GetLastMessageSeqByTime(ctx context.Context, conversationID string, time int64) (int64, error)
 	GetLastMessage(ctx context.Context, conversationID string) (*model.MsgInfoModel, error)
 	FindSeqs(ctx context.Context, conversationID string, seqs []int64) ([]*model.MsgInfoModel, error)
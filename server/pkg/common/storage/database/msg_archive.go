@@ -0,0 +1,37 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type MsgArchive interface {
+	// Set creates the stub of a doc or replaces the existing one.
+	Set(ctx context.Context, archive *model.MsgArchive) error
+	Take(ctx context.Context, docID string) (*model.MsgArchive, error)
+	Delete(ctx context.Context, docID string) error
+	// GetRandBefore samples stubs whose messages were all sent at or before ts.
+	// Non-empty include/exclude are doc_id regexes the stubs must / must not match.
+	GetRandBefore(ctx context.Context, ts int64, limit int, include []string, exclude []string) ([]*model.MsgArchive, error)
+}
+
+// MsgDocLoader reads msg docs that were moved out of the msg collection.
+type MsgDocLoader interface {
+	// LoadMsgDoc returns nil if the doc has not been archived.
+	LoadMsgDoc(ctx context.Context, docID string) (*model.MsgDocModel, error)
+}
@@ -1,20 +1,32 @@
 package database
 
 const (
-	BlackName               = "black"
-	ConversationName        = "conversation"
-	FriendName              = "friend"
-	FriendVersionName       = "friend_version"
-	FriendRequestName       = "friend_request"
-	GroupName               = "group"
-	GroupMemberName         = "group_member"
-	GroupMemberVersionName  = "group_member_version"
-	GroupJoinVersionName    = "group_join_version"
-	ConversationVersionName = "conversation_version"
-	GroupRequestName        = "group_request"
-	LogName                 = "log"
-	ObjectName              = "s3"
-	UserName                = "user"
-	SeqConversationName     = "seq"
-	SeqUserName             = "seq_user"
+	BlackName                    = "black"
+	ConversationName             = "conversation"
+	FriendName                   = "friend"
+	FriendVersionName            = "friend_version"
+	FriendRequestName            = "friend_request"
+	GroupName                    = "group"
+	GroupMemberName              = "group_member"
+	GroupMemberVersionName       = "group_member_version"
+	GroupJoinVersionName         = "group_join_version"
+	ConversationVersionName      = "conversation_version"
+	ConversationFolderName       = "conversation_folder"
+	GroupRequestName             = "group_request"
+	GroupInviteName              = "group_invite"
+	GroupAnnouncementName        = "group_announcement"
+	GroupAnnouncementConfirmName = "group_announcement_confirm"
+	GroupImportJobName           = "group_import_job"
+	MsgArchiveName               = "msg_archive"
+	MsgRetentionPolicyName       = "msg_retention_policy"
+	LegalHoldName                = "legal_hold"
+	ComplianceExportJobName      = "compliance_export_job"
+	FavoriteName                 = "favorite"
+	FavoriteVersionName          = "favorite_version"
+	LogName                      = "log"
+	ObjectName                   = "s3"
+	ObjectBlobName               = "s3_blob"
+	UserName                     = "user"
+	SeqConversationName          = "seq"
+	SeqUserName                  = "seq_user"
 )
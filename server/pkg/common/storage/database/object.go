@@ -23,12 +23,31 @@ import (
 
 type ObjectInfo interface {
 	SetObject(ctx context.Context, obj *model.Object) error
+	// SwapObject sets the object and returns the one it replaced in the same write, or nil if
+	// the name was new.
+	SwapObject(ctx context.Context, obj *model.Object) (*model.Object, error)
 	Take(ctx context.Context, engine string, name string) (*model.Object, error)
 	Delete(ctx context.Context, engine string, name []string) error
-	FindExpirationObject(ctx context.Context, engine string, expiration time.Time, needDelType []string, count int64) ([]*model.Object, error)
+	FindExpirationObject(ctx context.Context, engine string, expiration time.Time, needDelType []string, excludeUserIDs []string, excludeNames []string, count int64) ([]*model.Object, error)
 	GetKeyCount(ctx context.Context, engine string, key string) (int64, error)
 
 	GetEngineCount(ctx context.Context, engine string) (int64, error)
 	GetEngineInfo(ctx context.Context, engine string, limit int, skip int) ([]*model.Object, error)
 	UpdateEngine(ctx context.Context, oldEngine, oldName string, newEngine string) error
 }
+
+type ObjectBlob interface {
+	// Create inserts a blob unless one with the same key exists.
+	Create(ctx context.Context, blob *model.ObjectBlob) error
+	// TakeHash returns a referenced blob with the content hash and size.
+	TakeHash(ctx context.Context, engine string, hash string, size int64) (*model.ObjectBlob, error)
+	// IncrRef adds delta to the references of a blob not being deleted, and reports whether
+	// there was one.
+	IncrRef(ctx context.Context, engine string, key string, delta int64) (bool, error)
+	FindUnreferenced(ctx context.Context, engine string, count int64) ([]*model.ObjectBlob, error)
+	// MarkDeleting stops a blob from gaining references if it has none, and reports whether it
+	// did.
+	MarkDeleting(ctx context.Context, engine string, key string) (bool, error)
+	// Delete removes a blob marked as deleting.
+	Delete(ctx context.Context, engine string, key string) error
+}
@@ -16,8 +16,13 @@ package kafka
 
 import (
 	"context"
+
 	"github.com/IBM/sarama"
 	"github.com/openimsdk/tools/errs"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -42,8 +47,23 @@ func NewKafkaProducer(config *sarama.Config, addr []string, topic string) (*Prod
 	}, nil
 }
 
+// Topic returns the topic the producer sends to.
+func (p *Producer) Topic() string {
+	return p.topic
+}
+
 // SendMessage sends a message to the Kafka topic configured in the Producer.
-func (p *Producer) SendMessage(ctx context.Context, key string, msg proto.Message) (int32, int64, error) {
+func (p *Producer) SendMessage(ctx context.Context, key string, msg proto.Message) (partition int32, offset int64, err error) {
+	ctx, span := otel.Tracer("github.com/openimsdk/open-im-server/v3/pkg/common/storage/kafka").Start(ctx, "kafka.produce "+p.topic,
+		trace.WithSpanKind(trace.SpanKindProducer), trace.WithAttributes(attribute.String("messaging.destination.name", p.topic)))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	// Marshal the protobuf message
 	bMsg, err := proto.Marshal(msg)
 	if err != nil {
@@ -73,7 +93,7 @@ func (p *Producer) SendMessage(ctx context.Context, key string, msg proto.Messag
 	kMsg.Headers = header
 
 	// Send the message
-	partition, offset, err := p.producer.SendMessage(kMsg)
+	partition, offset, err = p.producer.SendMessage(kMsg)
 	if err != nil {
 		return 0, 0, errs.WrapMsg(err, "p.producer.SendMessage error")
 	}
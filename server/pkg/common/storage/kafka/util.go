@@ -3,32 +3,75 @@ package kafka
 import (
 	"context"
 	"errors"
+
 	"github.com/IBM/sarama"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/mcontext"
+	"go.opentelemetry.io/otel"
 )
 
 var errEmptyMsg = errors.New("kafka binary msg is empty")
 
+// mqHeaderKeys are the context values carried in the headers, in the order mcontext.WithMustInfoCtx expects.
+var mqHeaderKeys = []string{constant.OperationID, constant.OpUserID, constant.OpUserPlatform, constant.ConnID}
+
 // GetMQHeaderWithContext extracts message queue headers from the context.
 func GetMQHeaderWithContext(ctx context.Context) ([]sarama.RecordHeader, error) {
 	operationID, opUserID, platform, connID, err := mcontext.GetCtxInfos(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return []sarama.RecordHeader{
+	header := headerCarrier{
 		{Key: []byte(constant.OperationID), Value: []byte(operationID)},
 		{Key: []byte(constant.OpUserID), Value: []byte(opUserID)},
 		{Key: []byte(constant.OpUserPlatform), Value: []byte(platform)},
 		{Key: []byte(constant.ConnID), Value: []byte(connID)},
-	}, nil
+	}
+	// the trace context follows the fixed headers, and is only written when tracing is enabled
+	otel.GetTextMapPropagator().Inject(ctx, &header)
+	return header, nil
 }
 
 // GetContextWithMQHeader creates a context from message queue headers.
 func GetContextWithMQHeader(header []*sarama.RecordHeader) context.Context {
-	var values []string
+	values := make([]string, 0, len(mqHeaderKeys))
+	carrier := make(headerCarrier, 0, len(header))
 	for _, recordHeader := range header {
-		values = append(values, string(recordHeader.Value))
+		carrier = append(carrier, *recordHeader)
+	}
+	for _, key := range mqHeaderKeys {
+		values = append(values, carrier.Get(key))
+	}
+	ctx := mcontext.WithMustInfoCtx(values) // Attach extracted values to context
+	return otel.GetTextMapPropagator().Extract(ctx, &carrier)
+}
+
+// headerCarrier lets the otel propagator read and write the Kafka record headers.
+type headerCarrier []sarama.RecordHeader
+
+func (h headerCarrier) Get(key string) string {
+	for _, header := range h {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (h *headerCarrier) Set(key string, value string) {
+	for i, header := range *h {
+		if string(header.Key) == key {
+			(*h)[i].Value = []byte(value)
+			return
+		}
+	}
+	*h = append(*h, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for _, header := range h {
+		keys = append(keys, string(header.Key))
 	}
-	return mcontext.WithMustInfoCtx(values) // Attach extracted values to context
+	return keys
 }
@@ -0,0 +1,85 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/mcontext"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestTraceContextThroughHeaders(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	}()
+
+	var headers []sarama.RecordHeader
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	syncProducer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		headers = msg.Headers
+		return nil
+	})
+	producer := &Producer{topic: "toRedis", producer: syncProducer}
+
+	ctx := mcontext.WithMustInfoCtx([]string{"op1", "user1", "1", "conn1"})
+	ctx, parent := provider.Tracer("test").Start(ctx, "send")
+	if _, _, err := producer.SendMessage(ctx, "key", &sdkws.MsgData{SendID: "user1"}); err != nil {
+		t.Fatal(err)
+	}
+	parent.End()
+
+	recordHeaders := make([]*sarama.RecordHeader, len(headers))
+	for i := range headers {
+		recordHeaders[i] = &headers[i]
+	}
+	consumerCtx := GetContextWithMQHeader(recordHeaders)
+	if operationID := consumerCtx.Value(constant.OperationID); operationID != "op1" {
+		t.Fatalf("operationID = %v, want op1", operationID)
+	}
+	if connID := consumerCtx.Value(constant.ConnID); connID != "conn1" {
+		t.Fatalf("connID = %v, want conn1", connID)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want the producer span and its parent", len(spans))
+	}
+	produce := spans[0]
+	if produce.Name != "kafka.produce toRedis" || produce.SpanKind != trace.SpanKindProducer {
+		t.Fatalf("unexpected producer span %q kind %v", produce.Name, produce.SpanKind)
+	}
+	if produce.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Fatal("producer span is not a child of the sending span")
+	}
+	remote := trace.SpanContextFromContext(consumerCtx)
+	if !remote.IsRemote() || remote.TraceID() != produce.SpanContext.TraceID() || remote.SpanID() != produce.SpanContext.SpanID() {
+		t.Fatalf("consumer trace context %v does not continue the producer span", remote)
+	}
+}
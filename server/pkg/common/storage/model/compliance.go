@@ -0,0 +1,48 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// LegalHold keeps a user's or a conversation's data from being deleted.
+type LegalHold struct {
+	TargetType int32     `bson:"target_type"`
+	TargetID   string    `bson:"target_id"`
+	Reason     string    `bson:"reason"`
+	OpUserID   string    `bson:"op_user_id"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+// ComplianceExportJob tracks an asynchronous export of message history.
+// LeaseExpire is renewed while the job runs, a running job past it was interrupted.
+type ComplianceExportJob struct {
+	JobID           string    `bson:"job_id"`
+	UserID          string    `bson:"user_id"`
+	ConversationIDs []string  `bson:"conversation_ids"`
+	Status          int32     `bson:"status"`
+	ErrMsg          string    `bson:"err_msg"`
+	ObjectName      string    `bson:"object_name"`
+	MsgCount        int64     `bson:"msg_count"`
+	ObjectCount     int64     `bson:"object_count"`
+	Size            int64     `bson:"size"`
+	Sha256          string    `bson:"sha256"`
+	OpUserID        string    `bson:"op_user_id"`
+	Attempts        int32     `bson:"attempts"`
+	LeaseExpire     time.Time `bson:"lease_expire"`
+	CreateTime      time.Time `bson:"create_time"`
+	UpdateTime      time.Time `bson:"update_time"`
+}
@@ -37,4 +37,5 @@ type Conversation struct {
 	IsMsgDestruct         bool      `bson:"is_msg_destruct"`
 	MsgDestructTime       int64     `bson:"msg_destruct_time"`
 	LatestMsgDestructTime time.Time `bson:"latest_msg_destruct_time"`
+	FolderIDs             []string  `bson:"folder_ids,omitempty"`
 }
@@ -0,0 +1,42 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// ConversationFolder is a user-defined group of conversations. Regular folders list their
+// conversations through Conversation.FolderIDs, a folder with a Rule is a smart folder whose
+// conversations are picked by the client from the rule. Slot is unique among the folders of
+// the owner and below the folder limit, it keeps concurrent creates within the limit.
+type ConversationFolder struct {
+	FolderID    string                  `bson:"folder_id"`
+	OwnerUserID string                  `bson:"owner_user_id"`
+	Slot        int                     `bson:"slot"`
+	Name        string                  `bson:"name"`
+	Sort        int32                   `bson:"sort"`
+	Rule        *ConversationFolderRule `bson:"rule"`
+	CreateTime  time.Time               `bson:"create_time"`
+	UpdateTime  time.Time               `bson:"update_time"`
+}
+
+// ConversationFolderRule selects conversations by session type, group ID prefix and unread state,
+// all set conditions must match.
+type ConversationFolderRule struct {
+	SessionTypes  []int32 `bson:"session_types"`
+	GroupIDPrefix string  `bson:"group_id_prefix"`
+	Unread        int32   `bson:"unread"`
+}
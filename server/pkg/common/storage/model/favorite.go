@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// FavoriteObjectGroup is the object group of the attachment copies kept for favorites. It is not in
+// the groups deleted by the cron task, so the copies stay until the favorite is deleted, and
+// CopyObject and DeleteObjects only take names under favorite/{userID}/.
+const FavoriteObjectGroup = "favorite"
+
+// Favorite is a message a user starred. It keeps a snapshot of the message and copies of the
+// objects it links to, so it outlives the source message and conversation.
+type Favorite struct {
+	FavoriteID     string                `bson:"favorite_id"`
+	UserID         string                `bson:"user_id"`
+	ConversationID string                `bson:"conversation_id"`
+	Seq            int64                 `bson:"seq"`
+	Msg            *MsgDataModel         `bson:"msg"`
+	Attachments    []*FavoriteAttachment `bson:"attachments"`
+	Tags           []string              `bson:"tags"`
+	Note           string                `bson:"note"`
+	CreateTime     time.Time             `bson:"create_time"`
+	UpdateTime     time.Time             `bson:"update_time"`
+}
+
+// FavoriteAttachment maps an object URL of the source message to the copy kept for the favorite.
+type FavoriteAttachment struct {
+	SourceURL string `bson:"source_url"`
+	URL       string `bson:"url"`
+	Name      string `bson:"name"`
+}
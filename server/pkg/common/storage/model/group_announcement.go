@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// GroupAnnouncement is one entry of a group's announcement history.
+type GroupAnnouncement struct {
+	AnnouncementID string    `bson:"announcement_id"`
+	GroupID        string    `bson:"group_id"`
+	Content        string    `bson:"content"`
+	CreatorUserID  string    `bson:"creator_user_id"`
+	CreateTime     time.Time `bson:"create_time"`
+	RequireConfirm bool      `bson:"require_confirm"`
+	Ex             string    `bson:"ex"`
+}
+
+// GroupAnnouncementConfirm records that a member has confirmed an announcement.
+type GroupAnnouncementConfirm struct {
+	AnnouncementID string    `bson:"announcement_id"`
+	GroupID        string    `bson:"group_id"`
+	UserID         string    `bson:"user_id"`
+	ConfirmTime    time.Time `bson:"confirm_time"`
+}
@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// GroupImportJob tracks an asynchronous import of a group member roster.
+// LeaseExpire is renewed while the job runs, a running job past it was interrupted.
+type GroupImportJob struct {
+	JobID       string                `bson:"job_id"`
+	ObjectName  string                `bson:"object_name"`
+	Format      string                `bson:"format"`
+	Status      int32                 `bson:"status"`
+	ErrMsg      string                `bson:"err_msg"`
+	Total       int32                 `bson:"total"`
+	Processed   int32                 `bson:"processed"`
+	Succeeded   int32                 `bson:"succeeded"`
+	Skipped     int32                 `bson:"skipped"`
+	Failed      int32                 `bson:"failed"`
+	RowErrors   []GroupImportRowError `bson:"row_errors"`
+	OpUserID    string                `bson:"op_user_id"`
+	Attempts    int32                 `bson:"attempts"`
+	LeaseExpire time.Time             `bson:"lease_expire"`
+	CreateTime  time.Time             `bson:"create_time"`
+	UpdateTime  time.Time             `bson:"update_time"`
+}
+
+type GroupImportRowError struct {
+	Row     int32  `bson:"row"`
+	UserID  string `bson:"user_id"`
+	GroupID string `bson:"group_id"`
+	ErrMsg  string `bson:"err_msg"`
+}
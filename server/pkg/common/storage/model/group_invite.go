@@ -0,0 +1,42 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+type GroupInvite struct {
+	Token         string    `bson:"token"`
+	GroupID       string    `bson:"group_id"`
+	CreatorUserID string    `bson:"creator_user_id"`
+	CreateTime    time.Time `bson:"create_time"`
+	ExpireTime    time.Time `bson:"expire_time"`
+	MaxUses       int32     `bson:"max_uses"`
+	UsedCount     int32     `bson:"used_count"`
+	NeedApproval  bool      `bson:"need_approval"`
+	Revoked       bool      `bson:"revoked"`
+	Ex            string    `bson:"ex"`
+}
+
+// IsExpired reports whether the invite has an expiry time and it has passed.
+func (g *GroupInvite) IsExpired(now time.Time) bool {
+	return g.ExpireTime.UnixMilli() > 0 && !g.ExpireTime.After(now)
+}
+
+// IsExhausted reports whether the invite has a usage limit and it has been reached.
+func (g *GroupInvite) IsExhausted() bool {
+	return g.MaxUses > 0 && g.UsedCount >= g.MaxUses
+}
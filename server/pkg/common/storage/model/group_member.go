@@ -16,6 +16,8 @@ package model
 
 import (
 	"time"
+
+	"github.com/openimsdk/protocol/constant"
 )
 
 type GroupMember struct {
@@ -29,5 +31,15 @@ type GroupMember struct {
 	InviterUserID  string    `bson:"inviter_user_id"`
 	OperatorUserID string    `bson:"operator_user_id"`
 	MuteEndTime    time.Time `bson:"mute_end_time"`
+	Permissions    int32     `bson:"permissions"`
 	Ex             string    `bson:"ex"`
 }
+
+// DefaultGroupPermissions is the permission bitmask a member gets with a role level. A new
+// admin holds every admin permission until the owner narrows it, other roles hold none.
+func DefaultGroupPermissions(roleLevel int32) int32 {
+	if roleLevel == constant.GroupAdmin {
+		return constant.GroupPermissionAll
+	}
+	return 0
+}
@@ -34,6 +34,8 @@ var ErrMsgListNotExist = errs.New("user not have msg in mongoDB")
 type MsgDocModel struct {
 	DocID string          `bson:"doc_id"`
 	Msg   []*MsgInfoModel `bson:"msgs"`
+	// Rev counts the writes to an existing doc.
+	Rev int64 `bson:"rev,omitempty"`
 }
 
 type RevokeModel struct {
@@ -0,0 +1,31 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// MsgArchive is the stub left in Mongo for a msg doc moved to object storage.
+type MsgArchive struct {
+	DocID       string    `bson:"doc_id"`
+	ObjectName  string    `bson:"object_name"`
+	Checksum    string    `bson:"checksum"` // sha256 of the compressed bundle
+	Size        int64     `bson:"size"`
+	MinSeq      int64     `bson:"min_seq"`
+	MaxSeq      int64     `bson:"max_seq"`
+	MaxSendTime int64     `bson:"max_send_time"`
+	CreateTime  time.Time `bson:"create_time"`
+}
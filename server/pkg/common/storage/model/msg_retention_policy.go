@@ -0,0 +1,33 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// MsgRetentionPolicy overrides the global chat record retention for the
+// conversations matched by its scope.
+type MsgRetentionPolicy struct {
+	PolicyID      string    `bson:"policy_id"`
+	Name          string    `bson:"name"`
+	Scope         int32     `bson:"scope"`
+	ScopeIDs      []string  `bson:"scope_ids"`
+	RetainDays    int32     `bson:"retain_days"`
+	CreatorUserID string    `bson:"creator_user_id"`
+	CreateTime    time.Time `bson:"create_time"`
+	UpdateTime    time.Time `bson:"update_time"`
+	Ex            string    `bson:"ex"`
+}
@@ -29,3 +29,16 @@ type Object struct {
 	Group       string    `bson:"group"`
 	CreateTime  time.Time `bson:"create_time"`
 }
+
+// ObjectBlob is a stored file shared by all objects with the same content. RefCount is the
+// number of objects whose key points to it; the file is deleted once it drops to zero.
+type ObjectBlob struct {
+	Engine     string    `bson:"engine"`
+	Key        string    `bson:"key"`
+	Hash       string    `bson:"hash"`
+	Size       int64     `bson:"size"`
+	RefCount   int64     `bson:"ref_count"`
+	Deleting   bool      `bson:"deleting"`
+	CreateTime time.Time `bson:"create_time"`
+	UpdateTime time.Time `bson:"update_time"`
+}
@@ -17,6 +17,8 @@ const (
 const (
 	VersionGroupChangeID = ""
 	VersionSortChangeID  = "____S_O_R_T_I_D____"
+	// VersionFolderChangeID marks a change of the user's conversation folders in the conversation version log.
+	VersionFolderChangeID = "____F_O_L_D_E_R____"
 )
 
 type VersionLogElem struct {
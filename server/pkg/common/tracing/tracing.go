@@ -0,0 +1,127 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const instrumentationName = "github.com/openimsdk/open-im-server/v3"
+
+// OperationIDKey is the span attribute holding the operationID, to find the logs of a trace.
+const OperationIDKey = attribute.Key("openim.operation_id")
+
+// Init installs the global tracer provider exporting to the configured collector. When tracing
+// is disabled the global noop provider is kept, nothing is sampled and no context is propagated.
+func Init(ctx context.Context, conf *config.Tracing, serviceName string) (func(ctx context.Context) error, error) {
+	if !conf.Enable {
+		return func(ctx context.Context) error { return nil }, nil
+	}
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(conf.Endpoint)}
+	if conf.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "create otlp trace exporter failed", "endpoint", conf.Endpoint)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "create trace resource failed")
+	}
+	return install(exporter, res, conf.SampleRatio), nil
+}
+
+func install(exporter sdktrace.SpanExporter, res *resource.Resource, sampleRatio float64) func(ctx context.Context) error {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown
+}
+
+// Start starts a span named name, tagged with the operationID of ctx.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+	setOperationID(ctx)
+	return ctx, span
+}
+
+// End records err on span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// GrpcServerOptions returns the server options creating a span for each rpc.
+func GrpcServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		// runs after mw.GrpcServer has read the operationID from the metadata
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			setOperationID(ctx)
+			return handler(ctx, req)
+		}),
+	}
+}
+
+// GrpcDialOption returns the client option creating a span for each rpc and sending the trace context.
+func GrpcDialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler())
+}
+
+// GinMiddleware returns the middlewares creating a span for each api request. The engine must
+// have ContextWithFallback set, so that the rpc calls made with the *gin.Context see the span.
+func GinMiddleware(serviceName string) []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		otelgin.Middleware(serviceName),
+		func(c *gin.Context) {
+			if operationID := c.Request.Header.Get(constant.OperationID); operationID != "" {
+				trace.SpanFromContext(c.Request.Context()).SetAttributes(OperationIDKey.String(operationID))
+			}
+			c.Next()
+		},
+	}
+}
+
+func setOperationID(ctx context.Context) {
+	if operationID := mcontext.GetOperationID(ctx); operationID != "" {
+		trace.SpanFromContext(ctx).SetAttributes(OperationIDKey.String(operationID))
+	}
+}
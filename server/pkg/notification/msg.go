@@ -25,8 +25,10 @@ import (
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mq/memamq"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/idutil"
 	"github.com/openimsdk/tools/utils/jsonutil"
 	"github.com/openimsdk/tools/utils/timeutil"
@@ -55,6 +57,8 @@ func newContentTypeConf(conf *config.Notification) map[int32]config.Notification
 		constant.GroupMemberSetToOrdinaryUserNotification: conf.GroupMemberSetToOrdinary,
 		constant.GroupInfoSetAnnouncementNotification:     conf.GroupInfoSetAnnouncement,
 		constant.GroupInfoSetNameNotification:             conf.GroupInfoSetName,
+		constant.GroupAnnouncementRemindNotification:      conf.GroupAnnouncementRemind,
+		constant.GroupAnnouncementConfirmedNotification:   conf.GroupAnnouncementConfirmed,
 		// user
 		constant.UserInfoUpdatedNotification:  conf.UserInfoUpdated,
 		constant.UserStatusChangeNotification: conf.UserStatusChanged,
@@ -77,6 +81,8 @@ func newContentTypeConf(conf *config.Notification) map[int32]config.Notification
 		constant.MsgRevokeNotification:  {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
 		constant.HasReadReceipt:         {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
 		constant.DeleteMsgsNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		// favorite
+		constant.UserFavoriteChangedNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
 	}
 }
 
@@ -103,6 +109,8 @@ func newSessionTypeConf() map[int32]int32 {
 		constant.GroupMemberSetToOrdinaryUserNotification: constant.ReadGroupChatType,
 		constant.GroupInfoSetAnnouncementNotification:     constant.ReadGroupChatType,
 		constant.GroupInfoSetNameNotification:             constant.ReadGroupChatType,
+		constant.GroupAnnouncementRemindNotification:      constant.SingleChatType,
+		constant.GroupAnnouncementConfirmedNotification:   constant.SingleChatType,
 		// user
 		constant.UserInfoUpdatedNotification:  constant.SingleChatType,
 		constant.UserStatusChangeNotification: constant.SingleChatType,
@@ -123,6 +131,8 @@ func newSessionTypeConf() map[int32]int32 {
 		constant.ConversationPrivateChatNotification: constant.SingleChatType,
 		// delete
 		constant.DeleteMsgsNotification: constant.SingleChatType,
+		// favorite
+		constant.UserFavoriteChangedNotification: constant.SingleChatType,
 	}
 }
 
@@ -130,6 +140,7 @@ type NotificationSender struct {
 	contentTypeConf map[int32]config.NotificationConfig
 	sessionTypeConf map[int32]int32
 	sendMsg         func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error)
+	sendMsgs        func(ctx context.Context, req *msg.SendNotificationsReq) (*msg.SendNotificationsResp, error)
 	getUserInfo     func(ctx context.Context, userID string) (*sdkws.UserInfo, error)
 	queue           *memamq.MemoryQueue
 }
@@ -156,6 +167,13 @@ func WithRpcClient(sendMsg func(ctx context.Context, req *msg.SendMsgReq) (*msg.
 	}
 }
 
+// WithRpcBatchClient lets NotificationToUsers send to all receivers in one call.
+func WithRpcBatchClient(sendMsgs func(ctx context.Context, req *msg.SendNotificationsReq) (*msg.SendNotificationsResp, error)) NotificationSenderOptions {
+	return func(s *NotificationSender) {
+		s.sendMsgs = sendMsgs
+	}
+}
+
 func WithUserRpcClient(getUserInfo func(ctx context.Context, userID string) (*sdkws.UserInfo, error)) NotificationSenderOptions {
 	return func(s *NotificationSender) {
 		s.getUserInfo = getUserInfo
@@ -196,28 +214,87 @@ func WithSendMessage(sendMessage *bool) NotificationOptions {
 	}
 }
 
-func (s *NotificationSender) send(ctx context.Context, sendID, recvID string, contentType, sessionType int32, m proto.Message, opts ...NotificationOptions) {
+func (s *NotificationSender) send(ctx context.Context, sendID, recvID string, contentType, sessionType int32, m proto.Message, opts ...NotificationOptions) error {
 	ctx = context.WithoutCancel(ctx)
 	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(5))
 	defer cancel()
+	msgData, err := s.newMsgData(ctx, sendID, recvID, contentType, sessionType, m, opts...)
+	if err != nil {
+		log.ZWarn(ctx, "build notification failed", err, "sendID", sendID, "recvID", recvID, "contentType", contentType, "msg", jsonutil.StructToJsonString(m))
+		return err
+	}
+	req := msg.SendMsgReq{MsgData: msgData}
+	_, err = s.sendMsg(ctx, &req)
+	if err != nil {
+		log.ZWarn(ctx, "SendMsg failed", err, "req", req.String())
+	}
+	return err
+}
+
+// notificationBatchSize is the number of receivers sent to in one batch call, each batch has
+// its own deadline so a large receiver list is not cut off by a single timeout.
+const notificationBatchSize = 200
+
+// sendToUsers sends the notification to the receivers in batches when a batch client is set,
+// one message per receiver otherwise, and returns the receivers it was sent to.
+func (s *NotificationSender) sendToUsers(ctx context.Context, sendID string, recvIDs []string, contentType, sessionType int32, m proto.Message, opts ...NotificationOptions) []string {
+	sent := make([]string, 0, len(recvIDs))
+	if s.sendMsgs == nil {
+		for _, recvID := range recvIDs {
+			if err := s.send(ctx, sendID, recvID, contentType, sessionType, m, opts...); err == nil {
+				sent = append(sent, recvID)
+			}
+		}
+		return sent
+	}
+	ctx = context.WithoutCancel(ctx)
+	buildCtx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(5))
+	msgData, err := s.newMsgData(buildCtx, sendID, "", contentType, sessionType, m, opts...)
+	cancel()
+	if err != nil {
+		log.ZWarn(ctx, "build notification failed", err, "sendID", sendID, "recvIDs", recvIDs, "contentType", contentType, "msg", jsonutil.StructToJsonString(m))
+		return sent
+	}
+	for i := 0; i < len(recvIDs); i += notificationBatchSize {
+		end := min(i+notificationBatchSize, len(recvIDs))
+		sent = append(sent, s.sendBatch(ctx, msgData, recvIDs[i:end])...)
+	}
+	return sent
+}
+
+func (s *NotificationSender) sendBatch(ctx context.Context, msgData *sdkws.MsgData, recvIDs []string) []string {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(5))
+	defer cancel()
+	resp, err := s.sendMsgs(ctx, &msg.SendNotificationsReq{MsgData: msgData, RecvIDs: recvIDs})
+	if err != nil {
+		log.ZWarn(ctx, "SendNotifications failed", err, "sendID", msgData.SendID, "recvIDs", recvIDs, "contentType", msgData.ContentType)
+		return nil
+	}
+	if len(resp.FailedRecvIDs) == 0 {
+		return recvIDs
+	}
+	log.ZWarn(ctx, "SendNotifications partly failed", nil, "contentType", msgData.ContentType, "failedRecvIDs", resp.FailedRecvIDs)
+	return datautil.Filter(recvIDs, func(recvID string) (string, bool) {
+		return recvID, !datautil.Contain(recvID, resp.FailedRecvIDs...)
+	})
+}
+
+func (s *NotificationSender) newMsgData(ctx context.Context, sendID, recvID string, contentType, sessionType int32, m proto.Message, opts ...NotificationOptions) (*sdkws.MsgData, error) {
 	n := sdkws.NotificationElem{Detail: jsonutil.StructToJsonString(m)}
 	content, err := json.Marshal(&n)
 	if err != nil {
-		log.ZWarn(ctx, "json.Marshal failed", err, "sendID", sendID, "recvID", recvID, "contentType", contentType, "msg", jsonutil.StructToJsonString(m))
-		return
+		return nil, errs.Wrap(err)
 	}
 	notificationOpt := &notificationOpt{}
 	for _, opt := range opts {
 		opt(notificationOpt)
 	}
-	var req msg.SendMsgReq
 	var msg sdkws.MsgData
 	var userInfo *sdkws.UserInfo
 	if notificationOpt.RpcGetUsername && s.getUserInfo != nil {
 		userInfo, err = s.getUserInfo(ctx, sendID)
 		if err != nil {
-			log.ZWarn(ctx, "getUserInfo failed", err, "sendID", sendID)
-			return
+			return nil, err
 		}
 		msg.SenderNickname = userInfo.Nickname
 		msg.SenderFaceURL = userInfo.FaceURL
@@ -246,11 +323,7 @@ func (s *NotificationSender) send(ctx context.Context, sendID, recvID string, co
 	offlineInfo.Desc = optionsConfig.OfflinePush.Desc
 	offlineInfo.Ex = optionsConfig.OfflinePush.Ext
 	msg.OfflinePushInfo = &offlineInfo
-	req.MsgData = &msg
-	_, err = s.sendMsg(ctx, &req)
-	if err != nil {
-		log.ZWarn(ctx, "SendMsg failed", err, "req", req.String())
-	}
+	return &msg, nil
 }
 
 func (s *NotificationSender) NotificationWithSessionType(ctx context.Context, sendID, recvID string, contentType, sessionType int32, m proto.Message, opts ...NotificationOptions) {
@@ -263,6 +336,20 @@ func (s *NotificationSender) Notification(ctx context.Context, sendID, recvID st
 	s.NotificationWithSessionType(ctx, sendID, recvID, contentType, s.sessionTypeConf[contentType], m, opts...)
 }
 
+// NotificationToUsersSync sends the same notification to every receiver before returning, and
+// returns the receivers it was sent to.
+func (s *NotificationSender) NotificationToUsersSync(ctx context.Context, sendID string, recvIDs []string, contentType int32, m proto.Message, opts ...NotificationOptions) []string {
+	return s.sendToUsers(ctx, sendID, recvIDs, contentType, s.sessionTypeConf[contentType], m, opts...)
+}
+
+// NotificationToUsers sends the same notification to every receiver.
+func (s *NotificationSender) NotificationToUsers(ctx context.Context, sendID string, recvIDs []string, contentType int32, m proto.Message, opts ...NotificationOptions) {
+	sessionType := s.sessionTypeConf[contentType]
+	if err := s.queue.Push(func() { s.sendToUsers(ctx, sendID, recvIDs, contentType, sessionType, m, opts...) }); err != nil {
+		log.ZWarn(ctx, "Push to queue failed", err, "sendID", sendID, "recvIDs", recvIDs, "msg", jsonutil.StructToJsonString(m))
+	}
+}
+
 func (s *NotificationSender) SetOptionsByContentType(_ context.Context, options map[string]bool, contentType int32) {
 	switch contentType {
 	case constant.UserStatusChangeNotification:
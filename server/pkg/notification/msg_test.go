@@ -0,0 +1,81 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationToUsers(t *testing.T) {
+	batches := make(chan *msg.SendNotificationsReq, 1)
+	var single int
+	s := NewNotificationSender(&config.Notification{},
+		WithRpcClient(func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+			single++
+			return &msg.SendMsgResp{}, nil
+		}),
+		WithRpcBatchClient(func(ctx context.Context, req *msg.SendNotificationsReq) (*msg.SendNotificationsResp, error) {
+			batches <- req
+			return &msg.SendNotificationsResp{}, nil
+		}),
+	)
+	tips := &sdkws.GroupAnnouncementRemindTips{Announcement: &sdkws.GroupAnnouncement{GroupID: "g1"}}
+	s.NotificationToUsers(context.Background(), "admin", []string{"u1", "u2", "u3"}, constant.GroupAnnouncementRemindNotification, tips)
+	select {
+	case req := <-batches:
+		assert.Equal(t, []string{"u1", "u2", "u3"}, req.RecvIDs)
+		assert.Equal(t, "admin", req.MsgData.SendID)
+		assert.Equal(t, int32(constant.GroupAnnouncementRemindNotification), req.MsgData.ContentType)
+		assert.Equal(t, int32(constant.SingleChatType), req.MsgData.SessionType)
+	case <-time.After(time.Second * 5):
+		t.Fatal("no batch sent")
+	}
+	assert.Equal(t, 0, single)
+}
+
+func TestNotificationToUsersSync(t *testing.T) {
+	var batches [][]string
+	s := NewNotificationSender(&config.Notification{},
+		WithRpcBatchClient(func(ctx context.Context, req *msg.SendNotificationsReq) (*msg.SendNotificationsResp, error) {
+			deadline, ok := ctx.Deadline()
+			assert.True(t, ok)
+			assert.True(t, time.Until(deadline) > time.Second*4)
+			batches = append(batches, req.RecvIDs)
+			if len(batches) == 2 {
+				return &msg.SendNotificationsResp{FailedRecvIDs: []string{req.RecvIDs[0]}}, nil
+			}
+			return &msg.SendNotificationsResp{}, nil
+		}),
+	)
+	recvIDs := make([]string, notificationBatchSize+2)
+	for i := range recvIDs {
+		recvIDs[i] = "u" + strconv.Itoa(i)
+	}
+	tips := &sdkws.GroupAnnouncementRemindTips{Announcement: &sdkws.GroupAnnouncement{GroupID: "g1"}}
+	sent := s.NotificationToUsersSync(context.Background(), "admin", recvIDs, constant.GroupAnnouncementRemindNotification, tips)
+	assert.Len(t, batches, 2)
+	assert.Equal(t, recvIDs[:notificationBatchSize], batches[0])
+	assert.Equal(t, recvIDs[notificationBatchSize:], batches[1])
+	assert.Equal(t, append(recvIDs[:notificationBatchSize:notificationBatchSize], recvIDs[notificationBatchSize+1]), sent)
+}
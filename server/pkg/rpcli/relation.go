@@ -21,3 +21,11 @@ func (x *RelationClient) GetFriendsInfo(ctx context.Context, ownerUserID string,
 	req := &relation.GetFriendInfoReq{OwnerUserID: ownerUserID, FriendUserIDs: friendUserIDs}
 	return extractField(ctx, x.FriendClient.GetFriendInfo, req, (*relation.GetFriendInfoResp).GetFriendInfos)
 }
+
+func (x *RelationClient) GetReversalFriendUserIDs(ctx context.Context, friendUserID string, ownerUserIDs []string) ([]string, error) {
+	if len(ownerUserIDs) == 0 {
+		return nil, nil
+	}
+	req := &relation.GetReversalFriendsReq{FriendUserID: friendUserID, OwnerUserIDs: ownerUserIDs}
+	return extractField(ctx, x.FriendClient.GetReversalFriends, req, (*relation.GetReversalFriendsResp).GetOwnerUserIDs)
+}